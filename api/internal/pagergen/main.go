@@ -0,0 +1,224 @@
+// Command pagergen adds the api.Paginate iterators to each generated API
+// client: All on the List requests taking an offset and a limit, and a
+// ListXxxAll shorthand on their service.
+//
+// Usage:
+//
+//	go run ./internal/pagergen -generated ../generated
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const out = "pager_gen.go"
+
+// pkg is what pagergen found in a generated package.
+type pkg struct {
+	name   string
+	lists  []list
+	models map[string]string
+}
+
+// list is a List operation returning its entries by page.
+type list struct {
+	service, op, request, model string
+}
+
+func main() {
+	generated := flag.String("generated", "../generated", "directory holding the generated API clients")
+	flag.Parse()
+
+	dirs, err := filepath.Glob(filepath.Join(*generated, "*", "client.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, file := range dirs {
+		dir := filepath.Dir(file)
+		p, err := load(dir)
+		if err != nil {
+			log.Fatalf("%s: %s", dir, err)
+		}
+		if err = write(filepath.Join(dir, out), p); err != nil {
+			log.Fatalf("%s: %s", dir, err)
+		}
+	}
+}
+
+// load parses the package in dir.
+func load(dir string) (*pkg, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pkg{}
+	fset := token.NewFileSet()
+	structs := make(map[string]*ast.StructType)
+	methods := make(map[string][]string)
+	executes := make(map[string]*ast.FuncDecl)
+	var ops []*ast.FuncDecl
+	for _, file := range files {
+		if filepath.Base(file) == out || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		p.name = f.Name.Name
+
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						if st, ok := ts.Type.(*ast.StructType); ok {
+							structs[ts.Name.Name] = st
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					continue
+				}
+				recv := typeName(d.Recv.List[0].Type)
+				methods[recv] = append(methods[recv], d.Name.Name)
+				if d.Name.Name == "Execute" {
+					executes[recv] = d
+				}
+				if strings.HasSuffix(recv, "APIService") && strings.HasPrefix(d.Name.Name, "List") {
+					ops = append(ops, d)
+				}
+			}
+		}
+	}
+
+	for _, d := range ops {
+		l := list{service: typeName(d.Recv.List[0].Type), op: d.Name.Name}
+		if d.Type.Results == nil || len(d.Type.Results.List) != 1 {
+			continue
+		}
+		l.request = types.ExprString(d.Type.Results.List[0].Type)
+		if !slices.Contains(methods[l.request], "Offset") || !slices.Contains(methods[l.request], "Limit") {
+			continue
+		}
+		exec := executes[l.request]
+		if exec == nil || exec.Type.Results == nil || len(exec.Type.Results.List) != 3 {
+			continue
+		}
+		resp, ok := exec.Type.Results.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		st := structs[types.ExprString(resp.X)]
+		if st == nil || field(st, "Total") != "int32" || !strings.HasPrefix(field(st, "Data"), "[]") {
+			continue
+		}
+		l.model = strings.TrimPrefix(field(st, "Data"), "[]")
+		if slices.Contains(methods[l.request], "All") || slices.Contains(methods[l.service], l.op+"All") {
+			return nil, fmt.Errorf("%s already has an All method", l.request)
+		}
+		p.lists = append(p.lists, l)
+	}
+	slices.SortFunc(p.lists, func(a, b list) int { return strings.Compare(a.request, b.request) })
+	return p, nil
+}
+
+// typeName returns the name of the receiver type t.
+func typeName(t ast.Expr) string {
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// field returns the type of the field of st with the given name, or an empty
+// string if there is none.
+func field(st *ast.StructType, name string) string {
+	for _, f := range st.Fields.List {
+		for _, n := range f.Names {
+			if n.Name == name {
+				return types.ExprString(f.Type)
+			}
+		}
+	}
+	return ""
+}
+
+// write writes the iterators of p to file, or removes it if p has none.
+func write(file string, p *pkg) error {
+	if len(p.lists) == 0 {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by pagergen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", p.name)
+	fmt.Fprintf(&b, "import (\n")
+	fmt.Fprintf(&b, "\"context\"\n")
+	fmt.Fprintf(&b, "\"iter\"\n\n")
+	fmt.Fprintf(&b, "\"github.com/paloaltonetworks/scm-go/api\"\n")
+	fmt.Fprintf(&b, ")\n\n")
+
+	for _, l := range p.lists {
+		fmt.Fprintf(&b, "// All returns an iterator over every %s object matched by the request.\n", l.model)
+		fmt.Fprintf(&b, "//\n")
+		fmt.Fprintf(&b, "// Pages are fetched lazily using the filters set on the request, so there is\n")
+		fmt.Fprintf(&b, "// no need to manage Offset and Limit by hand. The page size and the number of\n")
+		fmt.Fprintf(&b, "// pages fetched in parallel can be set with the optional api.PageOptions.\n")
+		fmt.Fprintf(&b, "// Iteration stops at the total reported by the API, on the first error, or\n")
+		fmt.Fprintf(&b, "// when the request context is canceled.\n")
+		fmt.Fprintf(&b, "//\n")
+		fmt.Fprintf(&b, "// Example:\n")
+		fmt.Fprintf(&b, "//\n")
+		fmt.Fprintf(&b, "//\tfor obj, err := range api.%s(ctx).All() {\n", l.op)
+		fmt.Fprintf(&b, "//\t    if err != nil {\n")
+		fmt.Fprintf(&b, "//\t        return err\n")
+		fmt.Fprintf(&b, "//\t    }\n")
+		fmt.Fprintf(&b, "//\t    fmt.Printf(\"Found object\\n\")\n")
+		fmt.Fprintf(&b, "//\t}\n")
+		fmt.Fprintf(&b, "func (r %s) All(opts ...api.PageOptions) iter.Seq2[%s, error] {\n", l.request, l.model)
+		fmt.Fprintf(&b, "return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]%s, int32, error) {\n", l.model)
+		fmt.Fprintf(&b, "req := r\n")
+		fmt.Fprintf(&b, "req.ctx = ctx\n")
+		fmt.Fprintf(&b, "response, _, err := req.Offset(offset).Limit(limit).Execute()\n")
+		fmt.Fprintf(&b, "if err != nil || response == nil {\n")
+		fmt.Fprintf(&b, "return nil, 0, err\n")
+		fmt.Fprintf(&b, "}\n")
+		fmt.Fprintf(&b, "return response.Data, response.Total, nil\n")
+		fmt.Fprintf(&b, "}, opts...)\n")
+		fmt.Fprintf(&b, "}\n\n")
+
+		fmt.Fprintf(&b, "// %sAll returns an iterator over every %s object.\n", l.op, l.model)
+		fmt.Fprintf(&b, "//\n")
+		fmt.Fprintf(&b, "// It is a shorthand for %s(ctx).All(opts...), see %s.All.\n", l.op, l.request)
+		fmt.Fprintf(&b, "func (a *%s) %sAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[%s, error] {\n", l.service, l.op, l.model)
+		fmt.Fprintf(&b, "return a.%s(ctx).All(opts...)\n", l.op)
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, src, 0644)
+}
@@ -0,0 +1,245 @@
+// Command scopegen adds the api.Scope helpers to each generated API client:
+// SetScope and GetScope on the models having folder, snippet and device
+// fields, Scope on the requests filtering on them, and a FetchXxxInScope
+// variant of every FetchXxx helper.
+//
+// Usage:
+//
+//	go run ./internal/scopegen -generated ../generated
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const out = "scope_gen.go"
+
+// pkg is what scopegen found in a generated package.
+type pkg struct {
+	name     string
+	models   []string
+	requests []string
+	fetchers []fetcher
+}
+
+// fetcher is a FetchXxx method of an API service.
+type fetcher struct {
+	service, name, model string
+}
+
+func main() {
+	generated := flag.String("generated", "../generated", "directory holding the generated API clients")
+	flag.Parse()
+
+	dirs, err := filepath.Glob(filepath.Join(*generated, "*", "client.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, file := range dirs {
+		dir := filepath.Dir(file)
+		p, err := load(dir)
+		if err != nil {
+			log.Fatalf("%s: %s", dir, err)
+		}
+		if err = write(filepath.Join(dir, out), p); err != nil {
+			log.Fatalf("%s: %s", dir, err)
+		}
+	}
+}
+
+// load parses the package in dir.
+func load(dir string) (*pkg, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pkg{}
+	fset := token.NewFileSet()
+	structs := make(map[string]*ast.StructType)
+	methods := make(map[string][]string)
+	for _, file := range files {
+		if filepath.Base(file) == out || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		p.name = f.Name.Name
+
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						if st, ok := ts.Type.(*ast.StructType); ok {
+							structs[ts.Name.Name] = st
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					continue
+				}
+				recv := typeName(d.Recv.List[0].Type)
+				methods[recv] = append(methods[recv], d.Name.Name)
+				if f, ok := asFetcher(recv, d); ok {
+					p.fetchers = append(p.fetchers, f)
+				}
+			}
+		}
+	}
+
+	for name, st := range structs {
+		switch {
+		case hasStringPointers(st, "Folder", "Snippet", "Device"):
+			if slices.Contains(methods[name], "SetScope") || slices.Contains(methods[name], "GetScope") {
+				return nil, fmt.Errorf("%s already has scope methods", name)
+			}
+			p.models = append(p.models, name)
+		case strings.HasPrefix(name, "Api") && hasStringPointers(st, "folder", "snippet", "device"):
+			if slices.Contains(methods[name], "Scope") {
+				return nil, fmt.Errorf("%s already has a Scope method", name)
+			}
+			p.requests = append(p.requests, name)
+		}
+	}
+	slices.Sort(p.models)
+	slices.Sort(p.requests)
+	slices.SortFunc(p.fetchers, func(a, b fetcher) int { return strings.Compare(a.name, b.name) })
+	return p, nil
+}
+
+// asFetcher returns the fetcher d is, if it is one:
+//
+//	func (a *XxxAPIService) FetchXxx(ctx context.Context, name string, folder *string, snippet *string, device *string) (*Xxx, error)
+func asFetcher(recv string, d *ast.FuncDecl) (fetcher, bool) {
+	if !strings.HasPrefix(d.Name.Name, "Fetch") || !strings.HasSuffix(recv, "APIService") {
+		return fetcher{}, false
+	}
+	var params []string
+	for _, field := range d.Type.Params.List {
+		for _, name := range field.Names {
+			params = append(params, name.Name+" "+types.ExprString(field.Type))
+		}
+	}
+	want := []string{"ctx context.Context", "name string", "folder *string", "snippet *string", "device *string"}
+	results := d.Type.Results
+	if !slices.Equal(params, want) || results == nil || len(results.List) != 2 {
+		return fetcher{}, false
+	}
+	model, ok := results.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return fetcher{}, false
+	}
+	return fetcher{service: recv, name: d.Name.Name, model: types.ExprString(model.X)}, true
+}
+
+// typeName returns the name of the receiver type t.
+func typeName(t ast.Expr) string {
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// hasStringPointers returns whether st has *string fields of all the names.
+func hasStringPointers(st *ast.StructType, names ...string) bool {
+	found := 0
+	for _, field := range st.Fields.List {
+		if types.ExprString(field.Type) != "*string" {
+			continue
+		}
+		for _, name := range field.Names {
+			if slices.Contains(names, name.Name) {
+				found++
+			}
+		}
+	}
+	return found == len(names)
+}
+
+// write writes the helpers of p to file, or removes it if p has none.
+func write(file string, p *pkg) error {
+	if len(p.models)+len(p.requests)+len(p.fetchers) == 0 {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by scopegen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", p.name)
+	fmt.Fprintf(&b, "import (\n")
+	if len(p.fetchers) != 0 {
+		fmt.Fprintf(&b, "\"context\"\n\n")
+	}
+	fmt.Fprintf(&b, "\"github.com/paloaltonetworks/scm-go/api\"\n")
+	fmt.Fprintf(&b, ")\n\n")
+
+	for _, m := range p.models {
+		fmt.Fprintf(&b, "// SetScope sets the folder, snippet or device of o to the one of s,\n")
+		fmt.Fprintf(&b, "// clearing the others.  It fails, leaving o unchanged, if s is the zero\n")
+		fmt.Fprintf(&b, "// Scope.\n")
+		fmt.Fprintf(&b, "func (o *%s) SetScope(s api.Scope) error {\n", m)
+		fmt.Fprintf(&b, "if err := s.Validate(); err != nil {\n")
+		fmt.Fprintf(&b, "return err\n")
+		fmt.Fprintf(&b, "}\n")
+		fmt.Fprintf(&b, "o.Folder, o.Snippet, o.Device = s.Pointers()\n")
+		fmt.Fprintf(&b, "return nil\n")
+		fmt.Fprintf(&b, "}\n\n")
+		fmt.Fprintf(&b, "// GetScope returns the scope of o.  It fails unless exactly one of\n")
+		fmt.Fprintf(&b, "// its folder, snippet and device is set.\n")
+		fmt.Fprintf(&b, "func (o *%s) GetScope() (api.Scope, error) {\n", m)
+		fmt.Fprintf(&b, "return api.NewScope(o.Folder, o.Snippet, o.Device)\n")
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	for _, r := range p.requests {
+		fmt.Fprintf(&b, "// Scope sets the folder, snippet or device of the request to the one of\n")
+		fmt.Fprintf(&b, "// s, clearing the others.  If s is the zero Scope, Execute fails.\n")
+		fmt.Fprintf(&b, "func (r %s) Scope(s api.Scope) %s {\n", r, r)
+		fmt.Fprintf(&b, "if err := s.Validate(); err != nil {\n")
+		fmt.Fprintf(&b, "r.ctx = api.WithError(r.ctx, err)\n")
+		fmt.Fprintf(&b, "return r\n")
+		fmt.Fprintf(&b, "}\n")
+		fmt.Fprintf(&b, "r.folder, r.snippet, r.device = s.Pointers()\n")
+		fmt.Fprintf(&b, "return r\n")
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	for _, f := range p.fetchers {
+		fmt.Fprintf(&b, "// %sInScope is %s for the object named name in scope s.\n", f.name, f.name)
+		fmt.Fprintf(&b, "// It fails if s is the zero Scope.\n")
+		fmt.Fprintf(&b, "func (a *%s) %sInScope(ctx context.Context, name string, s api.Scope) (*%s, error) {\n", f.service, f.name, f.model)
+		fmt.Fprintf(&b, "if err := s.Validate(); err != nil {\n")
+		fmt.Fprintf(&b, "return nil, err\n")
+		fmt.Fprintf(&b, "}\n")
+		fmt.Fprintf(&b, "folder, snippet, device := s.Pointers()\n")
+		fmt.Fprintf(&b, "return a.%s(ctx, name, folder, snippet, device)\n", f.name)
+		fmt.Fprintf(&b, "}\n\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, src, 0644)
+}
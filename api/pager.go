@@ -0,0 +1,159 @@
+package api
+
+//go:generate go run ./internal/pagergen -generated ../generated
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// PageFunc fetches the page of a List operation that starts at offset and
+// holds at most limit entries.  It returns the entries of that page along
+// with the total number of entries reported by the API.
+type PageFunc[T any] func(ctx context.Context, offset, limit int32) ([]T, int32, error)
+
+// PageOptions controls how Paginate walks a List operation.
+type PageOptions struct {
+	// PageSize is the limit requested for each page.  Values outside of
+	// (0, MaxLimit] are replaced with MaxLimit.
+	PageSize int32
+
+	// Concurrency is the number of pages fetched in parallel once the first
+	// page has reported the total.  Entries are still yielded in order.
+	// Values below 2 fetch the pages sequentially.
+	Concurrency int
+}
+
+// Paginate returns an iterator over every entry of a List operation.
+//
+// Pages are only fetched as the iterator is consumed.  Iteration stops once
+// the total reported by the API has been reached, when a page comes back
+// empty, when the context is canceled, or after the first error, which is
+// yielded along with the zero value of T.
+func Paginate[T any](ctx context.Context, fetch PageFunc[T], opts ...PageOptions) iter.Seq2[T, error] {
+	var o PageOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.PageSize <= 0 || o.PageSize > MaxLimit {
+		o.PageSize = MaxLimit
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		var offset, total int32
+
+		for {
+			if ctx.Err() != nil {
+				yield(zero, context.Cause(ctx))
+				return
+			}
+
+			data, t, err := fetch(ctx, offset, o.PageSize)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if t > 0 {
+				total = t
+			}
+
+			for _, v := range data {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			n := int32(len(data))
+			offset += n
+			switch {
+			case n == 0:
+				return
+			case total > 0 && offset >= total:
+				return
+			case total <= 0 && n < o.PageSize:
+				return
+			}
+
+			// The first page tells how many entries the API actually returns
+			// per page, so the remaining pages can be fetched in parallel.
+			if offset == n && total > 0 && o.Concurrency > 1 {
+				paginateConcurrently(ctx, fetch, offset, n, total, o.Concurrency, yield)
+				return
+			}
+		}
+	}
+}
+
+// pageResult is the outcome of fetching a single page.
+type pageResult[T any] struct {
+	data []T
+	err  error
+}
+
+// paginateConcurrently fetches the pages in [offset, total) with the given
+// number of workers, yielding the entries in page order.
+func paginateConcurrently[T any](ctx context.Context, fetch PageFunc[T], offset, stride, total int32, workers int, yield func(T, error) bool) {
+	var zero T
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	pages := int((total - offset + stride - 1) / stride)
+	results := make([]chan pageResult[T], pages)
+	for i := range results {
+		results[i] = make(chan pageResult[T], 1)
+	}
+
+	indexes := make(chan int)
+	go func() {
+		defer close(indexes)
+		for i := 0; i < pages; i++ {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	if workers > pages {
+		workers = pages
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				data, _, err := fetch(ctx, offset+int32(i)*stride, stride)
+				results[i] <- pageResult[T]{data: data, err: err}
+			}
+		}()
+	}
+
+	for i := 0; i < pages; i++ {
+		var res pageResult[T]
+		select {
+		case res = <-results[i]:
+		case <-ctx.Done():
+			yield(zero, context.Cause(ctx))
+			return
+		}
+
+		if res.err != nil {
+			yield(zero, res.err)
+			return
+		}
+		for _, v := range res.data {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
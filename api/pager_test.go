@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePages serves total integers, capping each page at maxPage entries.
+func fakePages(total, maxPage int32, calls *int32) PageFunc[int32] {
+	return func(ctx context.Context, offset, limit int32) ([]int32, int32, error) {
+		atomic.AddInt32(calls, 1)
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		if limit > maxPage {
+			limit = maxPage
+		}
+		var data []int32
+		for i := offset; i < offset+limit && i < total; i++ {
+			data = append(data, i)
+		}
+		return data, total, nil
+	}
+}
+
+func collect(t *testing.T, seq func(func(int32, error) bool)) []int32 {
+	var got []int32
+	for v, err := range seq {
+		require.NoError(t, err)
+		got = append(got, v)
+	}
+	return got
+}
+
+func TestPaginate_Sequential(t *testing.T) {
+	var calls int32
+	got := collect(t, Paginate(context.Background(), fakePages(45, 1000, &calls), PageOptions{PageSize: 10}))
+
+	require.Len(t, got, 45)
+	for i, v := range got {
+		assert.Equal(t, int32(i), v)
+	}
+	assert.Equal(t, int32(5), calls)
+}
+
+func TestPaginate_DefaultsToMaxLimit(t *testing.T) {
+	var limits []int32
+	fetch := func(ctx context.Context, offset, limit int32) ([]int32, int32, error) {
+		limits = append(limits, limit)
+		return nil, 0, nil
+	}
+
+	for range Paginate(context.Background(), PageFunc[int32](fetch), PageOptions{PageSize: 5000}) {
+	}
+	assert.Equal(t, []int32{MaxLimit}, limits)
+}
+
+func TestPaginate_ServerCapsPageSize(t *testing.T) {
+	var calls int32
+	got := collect(t, Paginate(context.Background(), fakePages(25, 10, &calls), PageOptions{PageSize: 100}))
+
+	assert.Len(t, got, 25)
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestPaginate_Concurrent(t *testing.T) {
+	var calls int32
+	got := collect(t, Paginate(context.Background(), fakePages(1234, 1000, &calls), PageOptions{PageSize: 50, Concurrency: 4}))
+
+	require.Len(t, got, 1234)
+	for i, v := range got {
+		assert.Equal(t, int32(i), v)
+	}
+	assert.Equal(t, int32(25), calls)
+}
+
+func TestPaginate_EarlyBreak(t *testing.T) {
+	for _, concurrency := range []int{1, 4} {
+		var calls int32
+		n := 0
+		for range Paginate(context.Background(), fakePages(1000, 1000, &calls), PageOptions{PageSize: 10, Concurrency: concurrency}) {
+			n++
+			if n == 15 {
+				break
+			}
+		}
+		assert.Equal(t, 15, n)
+		if concurrency == 1 {
+			assert.Equal(t, int32(2), calls)
+		}
+	}
+}
+
+func TestPaginate_Error(t *testing.T) {
+	boom := errors.New("boom")
+	fetch := func(ctx context.Context, offset, limit int32) ([]int32, int32, error) {
+		if offset > 0 {
+			return nil, 0, boom
+		}
+		return []int32{1, 2}, 10, nil
+	}
+
+	var got []int32
+	var gotErr error
+	for v, err := range Paginate(context.Background(), PageFunc[int32](fetch), PageOptions{PageSize: 2}) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int32{1, 2}, got)
+	assert.ErrorIs(t, gotErr, boom)
+}
+
+func TestPaginate_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls int32
+
+	var gotErr error
+	n := 0
+	for _, err := range Paginate(ctx, fakePages(100, 1000, &calls), PageOptions{PageSize: 10}) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		n++
+		if n == 10 {
+			cancel()
+		}
+	}
+	assert.Equal(t, 10, n)
+	assert.ErrorIs(t, gotErr, context.Canceled)
+	assert.Equal(t, int32(1), calls)
+}
@@ -0,0 +1,251 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// Redacted replaces sensitive values in logs.
+const Redacted = "****"
+
+// sensitiveNames are names whose values are always redacted.
+var sensitiveNames = map[string]bool{
+	"authorization":       true,
+	"proxy_authorization": true,
+	"cookie":              true,
+	"set_cookie":          true,
+	"x_auth_jwt":          true,
+	"jwt":                 true,
+	"token":               true,
+	"key":                 true,
+	"secret":              true,
+	"password":            true,
+	"passphrase":          true,
+}
+
+// sensitiveSuffixes are name suffixes whose values are always redacted.
+var sensitiveSuffixes = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"secret_key",
+	"_token",
+	"key_file",
+	"keyfile",
+	"private_key",
+	"pre_shared_key",
+	"api_key",
+	"symmetric_key",
+	"authentication_key",
+	"integration_key",
+}
+
+// IsSensitive returns whether values named name (a header, a query
+// parameter, a JSON key or a log attribute) must not be logged.
+func IsSensitive(name string) bool {
+	n := strings.ToLower(strings.ReplaceAll(name, "-", "_"))
+	if sensitiveNames[n] {
+		return true
+	}
+	for _, s := range sensitiveSuffixes {
+		if strings.HasSuffix(n, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactHeader returns a copy of h with sensitive values redacted.
+func RedactHeader(h http.Header) http.Header {
+	ans := h.Clone()
+	for k, v := range ans {
+		if IsSensitive(k) {
+			ans[k] = []string{Redacted}
+		} else {
+			ans[k] = v
+		}
+	}
+	return ans
+}
+
+// RedactURL returns u as a string, with its password and sensitive query
+// parameters redacted.
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	c := *u
+	if _, ok := c.User.Password(); ok {
+		c.User = url.UserPassword(c.User.Username(), Redacted)
+	}
+	if c.RawQuery != "" {
+		c.RawQuery = redactValues(c.Query()).Encode()
+	}
+	return c.String()
+}
+
+// RedactBody returns body with sensitive values redacted.  Bodies that are
+// neither JSON nor form encoded are returned as is.
+func RedactBody(contentType string, body []byte) []byte {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if v, err := url.ParseQuery(string(body)); err == nil {
+			return []byte(redactValues(v).Encode())
+		}
+		return body
+	}
+	return RedactJSON(body)
+}
+
+// RedactJSON returns the JSON document b with the values of sensitive keys
+// redacted.  Invalid JSON is returned as is.
+func RedactJSON(b []byte) []byte {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return b
+	}
+
+	ans, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return b
+	}
+	return ans
+}
+
+// redactValue redacts the sensitive keys of the decoded JSON value v.
+func redactValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, item := range x {
+			if IsSensitive(k) {
+				x[k] = Redacted
+			} else {
+				x[k] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range x {
+			x[i] = redactValue(item)
+		}
+	}
+	return v
+}
+
+// redactValues returns a copy of v with sensitive values redacted.
+func redactValues(v url.Values) url.Values {
+	ans := make(url.Values, len(v))
+	for k, vals := range v {
+		if IsSensitive(k) {
+			ans[k] = []string{Redacted}
+		} else {
+			ans[k] = vals
+		}
+	}
+	return ans
+}
+
+// NewRedactingHandler returns a slog.Handler redacting sensitive attributes
+// before passing records on to h.
+//
+// Attributes with a sensitive key are replaced by Redacted.  Structs, maps
+// and slices (such as the models of the generated clients) are logged as
+// JSON with their sensitive fields redacted.
+func NewRedactingHandler(h slog.Handler) slog.Handler {
+	if _, ok := h.(redactingHandler); ok {
+		return h
+	}
+	return redactingHandler{h}
+}
+
+type redactingHandler struct {
+	next slog.Handler
+}
+
+func (h redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h redactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	ans := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		ans.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, ans)
+}
+
+func (h redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	ans := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		ans = append(ans, redactAttr(a))
+	}
+	return redactingHandler{h.next.WithAttrs(ans)}
+}
+
+func (h redactingHandler) WithGroup(name string) slog.Handler {
+	return redactingHandler{h.next.WithGroup(name)}
+}
+
+// redactAttr returns a with sensitive values redacted.
+func redactAttr(a slog.Attr) slog.Attr {
+	if IsSensitive(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindGroup:
+		attrs := v.Group()
+		ans := make([]slog.Attr, 0, len(attrs))
+		for _, ga := range attrs {
+			ans = append(ans, redactAttr(ga))
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(ans...)}
+	case slog.KindAny:
+		switch x := v.Any().(type) {
+		case error:
+			return a
+		case http.Header:
+			return slog.Any(a.Key, RedactHeader(x))
+		case *url.URL:
+			return slog.String(a.Key, RedactURL(x))
+		default:
+			if redactable(x) {
+				if b, err := json.Marshal(x); err == nil {
+					return slog.Any(a.Key, jsonValue(RedactJSON(b)))
+				}
+			}
+		}
+	}
+	return a
+}
+
+// redactable returns whether v is logged as redacted JSON.
+func redactable(v interface{}) bool {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+// jsonValue is a JSON document logged as is by both text and JSON handlers.
+type jsonValue []byte
+
+func (j jsonValue) MarshalJSON() ([]byte, error) { return j, nil }
+func (j jsonValue) MarshalText() ([]byte, error) { return j, nil }
@@ -0,0 +1,135 @@
+package api
+
+//go:generate go run ./internal/scopegen -generated ../generated
+
+import (
+	"context"
+	"fmt"
+)
+
+// Scope is the container of configuration objects: a folder, a snippet or a
+// device.  Build one with Folder, Snippet, Device or NewScope.
+//
+// Models having folder, snippet and device fields have SetScope and GetScope
+// methods, list requests filtering on them have a Scope method, and every
+// FetchXxx helper has a FetchXxxInScope variant:
+//
+//	a.SetScope(api.Folder("Shared"))
+//	addrs, _, err := client.AddressesAPI.ListAddresses(ctx).Scope(api.Snippet("web")).Execute()
+//	web, err := client.AddressesAPI.FetchAddressesInScope(ctx, "web", api.Device("007951000388704"))
+//
+// The zero Scope, which Folder, Snippet and Device return for an empty name,
+// is invalid: SetScope and FetchXxxInScope return an error for it, and the
+// Execute method of a list request given it by Scope fails.
+type Scope struct {
+	param string
+	name  string
+}
+
+// Folder returns the scope of the named folder, such as "Shared".
+func Folder(name string) Scope {
+	return newScope("folder", name)
+}
+
+// Snippet returns the scope of the named snippet.
+func Snippet(name string) Scope {
+	return newScope("snippet", name)
+}
+
+// Device returns the scope of the device with the given serial number.
+func Device(serial string) Scope {
+	return newScope("device", serial)
+}
+
+func newScope(param, name string) Scope {
+	if name == "" {
+		return Scope{}
+	}
+	return Scope{param: param, name: name}
+}
+
+// NewScope returns the scope of the one of folder, snippet and device that is
+// set, as found in models.  It fails unless exactly one of them is set and not
+// empty.
+func NewScope(folder, snippet, device *string) (Scope, error) {
+	var ans Scope
+	n := 0
+	for _, s := range []Scope{Folder(deref(folder)), Snippet(deref(snippet)), Device(deref(device))} {
+		if !s.IsZero() {
+			ans = s
+			n++
+		}
+	}
+	if n != 1 {
+		return Scope{}, scopeError(n)
+	}
+	return ans, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func scopeError(n int) error {
+	return fmt.Errorf("scope must have exactly one of folder, snippet or device, got %d", n)
+}
+
+// IsZero returns whether s is the zero Scope.
+func (s Scope) IsZero() bool {
+	return s.param == ""
+}
+
+// Validate returns an error if s is the zero Scope.
+func (s Scope) Validate() error {
+	if s.IsZero() {
+		return scopeError(0)
+	}
+	return nil
+}
+
+// Param returns the name of the container field and its value, such as
+// "folder" and "Shared", or empty strings for the zero Scope.
+func (s Scope) Param() (string, string) {
+	return s.param, s.name
+}
+
+// Pointers returns the values of the folder, snippet and device fields of s,
+// nil for the ones not set.
+func (s Scope) Pointers() (folder, snippet, device *string) {
+	name := s.name
+	switch s.param {
+	case "folder":
+		folder = &name
+	case "snippet":
+		snippet = &name
+	case "device":
+		device = &name
+	}
+	return
+}
+
+// Contains returns whether obj is in the scope itself, rather than inherited
+// from a parent folder or predefined.
+func (s Scope) Contains(obj map[string]interface{}) bool {
+	return !s.IsZero() && obj[s.param] == s.name
+}
+
+func (s Scope) String() string {
+	return s.param + " " + s.name
+}
+
+// WithError returns a copy of ctx canceled with err as its cause, so that a
+// request made with it fails: request builders use it to report an invalid
+// argument from Execute rather than panicking.  The API clients of the scm
+// package and Paginate fail with err itself (see context.Cause).
+func WithError(ctx context.Context, err error) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	cancel(err)
+	return ctx
+}
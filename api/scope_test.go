@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScope(t *testing.T) {
+	s := Snippet("default")
+	require.NoError(t, s.Validate())
+	k, v := s.Param()
+	assert.Equal(t, "snippet", k)
+	assert.Equal(t, "default", v)
+	assert.True(t, s.Contains(map[string]interface{}{"snippet": "default"}))
+	assert.False(t, s.Contains(map[string]interface{}{"folder": "default"}))
+	assert.Equal(t, "snippet default", s.String())
+
+	folder, snippet, device := s.Pointers()
+	assert.Nil(t, folder)
+	assert.Equal(t, "default", *snippet)
+	assert.Nil(t, device)
+
+	assert.Equal(t, Folder("Shared"), Folder("Shared"))
+	assert.NotEqual(t, Folder("Shared"), Snippet("Shared"))
+
+	// Empty names make invalid scopes.
+	assert.True(t, Device("").IsZero())
+	assert.EqualError(t, Device("").Validate(), "scope must have exactly one of folder, snippet or device, got 0")
+	assert.False(t, Scope{}.Contains(map[string]interface{}{}))
+	folder, snippet, device = Scope{}.Pointers()
+	assert.True(t, folder == nil && snippet == nil && device == nil)
+}
+
+func TestWithError(t *testing.T) {
+	errBad := errors.New("bad argument")
+	ctx := WithError(context.Background(), errBad)
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.Equal(t, errBad, context.Cause(ctx))
+
+	// Paginate fails with the error without fetching anything.
+	for _, err := range Paginate(ctx, func(context.Context, int32, int32) ([]int, int32, error) {
+		t.Fatal("fetched a page")
+		return nil, 0, nil
+	}) {
+		assert.Equal(t, errBad, err)
+	}
+}
+
+func TestNewScope(t *testing.T) {
+	s, err := NewScope(nil, String(""), String("007951000388704"))
+	require.NoError(t, err)
+	assert.Equal(t, Device("007951000388704"), s)
+
+	_, err = NewScope(nil, nil, nil)
+	assert.EqualError(t, err, "scope must have exactly one of folder, snippet or device, got 0")
+
+	_, err = NewScope(String("Shared"), nil, String("007951000388704"))
+	assert.EqualError(t, err, "scope must have exactly one of folder, snippet or device, got 2")
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+)
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying the name of the API operation
+// being performed, such as "AddressesAPIService.CreateAddresses".
+//
+// The API clients of the scm package set it on the context of each of their
+// requests that does not carry one yet, from the request's method and path.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	if ctx == nil {
+		return nil
+	}
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// Operation returns the name of the API operation carried by ctx, or an
+// empty string if there is none.
+func Operation(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	op, _ := ctx.Value(operationKey{}).(string)
+	return op
+}
+
+// ClientTrace is a set of hooks run while an SCM API request is processed,
+// in the manner of net/http/httptrace.  Any hook may be nil.
+type ClientTrace struct {
+	// Retry is called before a request is sent again.  Attempt is the
+	// attempt about to be made (2 for the first retry), statusCode and err
+	// are what made the previous attempt fail.
+	Retry func(attempt, statusCode int, err error)
+
+	// TokenRefresh is called once a JWT refresh the request had to wait on
+	// is done, with the error of the refresh if any.
+	TokenRefresh func(err error)
+}
+
+type clientTraceKey struct{}
+
+// WithClientTrace returns a copy of ctx running the hooks of trace, in
+// addition to those of any ClientTrace already in ctx.
+func WithClientTrace(ctx context.Context, trace *ClientTrace) context.Context {
+	if trace == nil {
+		panic("nil trace")
+	}
+	if old := ContextClientTrace(ctx); old != nil {
+		trace = trace.compose(old)
+	}
+	return context.WithValue(ctx, clientTraceKey{}, trace)
+}
+
+// ContextClientTrace returns the ClientTrace of ctx, or nil.
+func ContextClientTrace(ctx context.Context) *ClientTrace {
+	if ctx == nil {
+		return nil
+	}
+	trace, _ := ctx.Value(clientTraceKey{}).(*ClientTrace)
+	return trace
+}
+
+// compose returns a ClientTrace running the hooks of t, then those of old.
+func (t *ClientTrace) compose(old *ClientTrace) *ClientTrace {
+	ans := *t
+	if ans.Retry == nil {
+		ans.Retry = old.Retry
+	} else if old.Retry != nil {
+		retry := ans.Retry
+		ans.Retry = func(attempt, statusCode int, err error) {
+			retry(attempt, statusCode, err)
+			old.Retry(attempt, statusCode, err)
+		}
+	}
+	if ans.TokenRefresh == nil {
+		ans.TokenRefresh = old.TokenRefresh
+	} else if old.TokenRefresh != nil {
+		refresh := ans.TokenRefresh
+		ans.TokenRefresh = func(err error) {
+			refresh(err)
+			old.TokenRefresh(err)
+		}
+	}
+	return &ans
+}
+
+// TraceRetry runs the Retry hook of the ClientTrace of ctx, if any.
+func TraceRetry(ctx context.Context, attempt, statusCode int, err error) {
+	if t := ContextClientTrace(ctx); t != nil && t.Retry != nil {
+		t.Retry(attempt, statusCode, err)
+	}
+}
+
+// TraceTokenRefresh runs the TokenRefresh hook of the ClientTrace of ctx,
+// if any.
+func TraceTokenRefresh(ctx context.Context, err error) {
+	if t := ContextClientTrace(ctx); t != nil && t.TokenRefresh != nil {
+		t.TokenRefresh(err)
+	}
+}
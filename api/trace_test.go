@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperation(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, Operation(ctx))
+	assert.Equal(t, "AddressesAPIService.CreateAddresses",
+		Operation(WithOperation(ctx, "AddressesAPIService.CreateAddresses")))
+}
+
+func TestClientTrace_Compose(t *testing.T) {
+	var calls []string
+	ctx := WithClientTrace(context.Background(), &ClientTrace{
+		Retry: func(attempt, statusCode int, err error) { calls = append(calls, "outer retry") },
+	})
+	ctx = WithClientTrace(ctx, &ClientTrace{
+		Retry:        func(attempt, statusCode int, err error) { calls = append(calls, "inner retry") },
+		TokenRefresh: func(err error) { calls = append(calls, "inner refresh") },
+	})
+
+	TraceRetry(ctx, 2, 503, nil)
+	TraceTokenRefresh(ctx, errors.New("boom"))
+	TraceRetry(context.Background(), 2, 503, nil)
+
+	assert.Equal(t, []string{"inner retry", "outer retry", "inner refresh"}, calls)
+}
@@ -2,82 +2,195 @@ package api
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
-	"net/http/httputil"
 	"strings"
+	"time"
 )
 
+// maxLoggedBody is the number of body bytes logged at debug level.
+const maxLoggedBody = 64 * 1024
+
 type transport struct {
 	transport http.RoundTripper
-	client    Client
+	logger    *slog.Logger
 }
 
 func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	ctx := req.Context()
+	debug := t.logger.Enabled(ctx, slog.LevelDebug)
+	start := time.Now()
 
 	// Input.
-	if t.client.LoggingIsSetTo(LogDetailed) {
-		reqData, err := httputil.DumpRequestOut(req, true)
-		if err == nil {
-			t.client.Log(ctx, LogDetailed, fmt.Sprintf(logReqMsg, prettyPrintJsonLines(reqData)))
-		} else {
-			t.client.Log(ctx, "", fmt.Sprintf("[ERROR] API Request error: %#v", err))
+	if debug {
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("url", RedactURL(req.URL)),
+			slog.Any("headers", RedactHeader(req.Header)),
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			attrs = append(attrs, slog.String("body", loggedBody(req.Header, body)))
 		}
+		t.logger.LogAttrs(ctx, slog.LevelDebug, "API request", attrs...)
 	}
 
 	resp, err := t.transport.RoundTrip(req)
+	duration := time.Since(start)
 	if err != nil {
+		t.logger.LogAttrs(ctx, slog.LevelWarn, "API request failed",
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+			slog.Duration("duration", duration),
+			slog.Any("error", err),
+		)
 		return resp, err
 	}
 
 	// Output.
-	if t.client.LoggingIsSetTo(LogDetailed) {
-		respData, err := httputil.DumpResponse(resp, true)
-		if err == nil {
-			t.client.Log(ctx, LogDetailed, fmt.Sprintf(logRespMsg, prettyPrintJsonLines(respData)))
-		} else {
-			t.client.Log(ctx, "", fmt.Sprintf("[ERROR] API Response error: %#v", err))
+	level := slog.LevelInfo
+	if resp.StatusCode >= 400 {
+		level = slog.LevelWarn
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("status", resp.StatusCode),
+		slog.String("request_id", resp.Header.Get("X-Request-ID")),
+		slog.Duration("duration", duration),
+	}
+	if debug {
+		attrs = append(attrs, slog.Any("headers", RedactHeader(resp.Header)))
+		if resp.Body != nil {
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			attrs = append(attrs, slog.String("body", loggedBody(resp.Header, body)))
 		}
 	}
+	t.logger.LogAttrs(ctx, level, "API response", attrs...)
 
 	return resp, nil
 }
 
+// NewLoggingTransport creates a wrapper around a http.RoundTripper, designed
+// to be used for the `Transport` field of http.Client.
+//
+// Each response is logged at info level (warn for errors) with its method,
+// path, status, request_id and duration.  At debug level, the redacted
+// headers and bodies of requests and responses are logged as well.
+func NewLoggingTransport(t http.RoundTripper, logger *slog.Logger) http.RoundTripper {
+	return &transport{t, logger}
+}
+
 // NewTransport creates a wrapper around a *http.RoundTripper,
 // designed to be used for the `Transport` field of http.Client.
 //
-// This logs each pair of HTTP request/response that it handles.
-// The logging is done via Go standard library `log` package.
+// This logs each pair of HTTP request/response that it handles through
+// client.Log(), with sensitive values redacted.
 //
-// Deprecated: This will log the content of every http request/response
-// at `[DEBUG]` level, without any filtering. Any sensitive information
-// will appear as-is in your logs. Please use NewSubsystemLoggingHTTPTransport instead.
-func NewTransport(t http.RoundTripper, client Client) *transport {
-	return &transport{t, client}
-}
-
-// prettyPrintJsonLines iterates through a []byte line-by-line,
-// transforming any lines that are complete json into pretty-printed json.
-func prettyPrintJsonLines(b []byte) string {
-	parts := strings.Split(string(b), "\n")
-	for i, p := range parts {
-		if b := []byte(p); json.Valid(b) {
-			var out bytes.Buffer
-			_ = json.Indent(&out, b, "", " ") // already checked for validity
-			parts[i] = out.String()
-		}
+// Deprecated: Use NewLoggingTransport instead.
+func NewTransport(t http.RoundTripper, client Client) http.RoundTripper {
+	return NewLoggingTransport(t, slog.New(NewRedactingHandler(clientHandler{client: client})))
+}
+
+// loggedBody returns body as logged: redacted and truncated.
+func loggedBody(h http.Header, body []byte) string {
+	body = RedactBody(h.Get("Content-Type"), body)
+	if len(body) > maxLoggedBody {
+		return string(body[:maxLoggedBody]) + "..."
+	}
+	return string(body)
+}
+
+// clientHandler is a slog.Handler logging through Client.Log().
+type clientHandler struct {
+	client Client
+	attrs  []slog.Attr
+}
+
+func (h clientHandler) Enabled(_ context.Context, level slog.Level) bool {
+	if level < slog.LevelInfo {
+		return h.client.LoggingIsSetTo(LogDetailed)
+	}
+	return !h.client.LoggingIsSetTo(LogQuiet)
+}
+
+func (h clientHandler) Handle(ctx context.Context, r slog.Record) error {
+	var buf strings.Builder
+	buf.WriteString(r.Message)
+	write := func(a slog.Attr) bool {
+		fmt.Fprintf(&buf, " %s=%v", a.Key, a.Value)
+		return true
+	}
+	for _, a := range h.attrs {
+		write(a)
 	}
-	return strings.Join(parts, "\n")
+	r.Attrs(write)
+
+	h.client.Log(ctx, "", buf.String())
+	return nil
+}
+
+func (h clientHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return clientHandler{client: h.client, attrs: append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...)}
+}
+
+func (h clientHandler) WithGroup(string) slog.Handler {
+	return h
 }
 
-const logReqMsg = `
----[ REQUEST ]---------------------------------------
-%s
------------------------------------------------------`
+// NewLoggerHandler returns a slog.Handler passing records of at least the
+// given level to l, with their attributes as the map argument.
+func NewLoggerHandler(l Logger, level slog.Leveler) slog.Handler {
+	return &loggerHandler{logger: l, level: level}
+}
+
+type loggerHandler struct {
+	logger Logger
+	level  slog.Leveler
+	attrs  []slog.Attr
+}
+
+func (h *loggerHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
 
-const logRespMsg = `
----[ RESPONSE ]--------------------------------------
-%s
------------------------------------------------------`
+func (h *loggerHandler) Handle(ctx context.Context, r slog.Record) error {
+	fields := make(map[string]interface{}, len(h.attrs)+r.NumAttrs()+1)
+	fields["level"] = r.Level.String()
+	add := func(a slog.Attr) bool {
+		fields[a.Key] = a.Value.Resolve().Any()
+		return true
+	}
+	for _, a := range h.attrs {
+		add(a)
+	}
+	r.Attrs(add)
+
+	h.logger(ctx, r.Message, fields)
+	return nil
+}
+
+func (h *loggerHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &loggerHandler{
+		logger: h.logger,
+		level:  h.level,
+		attrs:  append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...),
+	}
+}
+
+func (h *loggerHandler) WithGroup(string) slog.Handler {
+	return h
+}
@@ -0,0 +1,232 @@
+package scm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+)
+
+// DefaultBulkConcurrency is the number of items applied at once when
+// BulkOptions.Concurrency is not set.
+const DefaultBulkConcurrency = 4
+
+// BulkFunc applies one item, typically by executing a generated API request,
+// and returns the HTTP response if there is one.
+type BulkFunc[T any] func(ctx context.Context, item T) (*http.Response, error)
+
+// BulkOptions are the options of BulkApply.
+type BulkOptions[T any] struct {
+	// Concurrency is the number of items applied at once.
+	Concurrency int
+
+	// Upsert, if set, is called for the items failing with a NameNotUnique
+	// error, typically to update the existing object of the same name
+	// instead of creating it.
+	Upsert BulkFunc[T]
+
+	// StopOnError stops applying items after the first failure.  The items
+	// not applied get the error ErrBulkSkipped.
+	StopOnError bool
+
+	// Progress, if set, is called with the result of each item as it is
+	// done.  Calls are serialized.
+	Progress func(BulkResult[T])
+}
+
+// ErrBulkSkipped is the error of the items BulkApply did not apply, because
+// of StopOnError or because its context is done.
+var ErrBulkSkipped = errors.New("skipped")
+
+// BulkResult is the result of applying one item.
+type BulkResult[T any] struct {
+	// Index is the index of the item in the items given to BulkApply.
+	Index int
+	Item  T
+
+	// Err is the error applying the item, nil on success.  API errors are
+	// the typed errors of the errors package.
+	Err error
+
+	// Upserted is set if the item was applied by BulkOptions.Upsert.
+	Upserted bool
+
+	// StatusCode and RequestID are the ones of the last API response for
+	// the item, if any.
+	StatusCode int
+	RequestID  string
+
+	Duration time.Duration
+}
+
+// BulkReport is the result of BulkApply.
+type BulkReport[T any] struct {
+	// Results holds the result of each item, in the order of the items.
+	Results []BulkResult[T]
+}
+
+// Succeeded returns the number of items applied successfully.
+func (r *BulkReport[T]) Succeeded() int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Failures returns the results of the items that were not applied, skipped
+// ones included.
+func (r *BulkReport[T]) Failures() []BulkResult[T] {
+	var ans []BulkResult[T]
+	for _, res := range r.Results {
+		if res.Err != nil {
+			ans = append(ans, res)
+		}
+	}
+	return ans
+}
+
+// Err returns the errors of the failed items joined, or nil if all items
+// succeeded.  Each error is prefixed by the index of its item.
+func (r *BulkReport[T]) Err() error {
+	var errs []error
+	for _, res := range r.Results {
+		if res.Err != nil && !errors.Is(res.Err, ErrBulkSkipped) {
+			errs = append(errs, fmt.Errorf("item %d: %w", res.Index, res.Err))
+		}
+	}
+	if skipped := len(r.Failures()) - len(errs); skipped != 0 {
+		errs = append(errs, fmt.Errorf("%d item(s) %w", skipped, ErrBulkSkipped))
+	}
+	return errors.Join(errs...)
+}
+
+/*
+BulkApply applies fn to each item through a pool of workers, and reports the
+result of each item rather than stopping at the first failure.
+
+Requests go through the client's rate limiter and RetryTransport like any
+other, so throttled requests are retried and Concurrency above the rate limit
+only queues requests.  Once ctx is done, the remaining items are skipped.
+
+	api := scm.GetObjectsAPIClient(client).AddressesAPI
+	report := scm.BulkApply(ctx, addresses, func(ctx context.Context, a objects.Addresses) (*http.Response, error) {
+		_, resp, err := api.CreateAddresses(ctx).Addresses(a).Execute()
+		return resp, err
+	}, scm.BulkOptions[objects.Addresses]{Concurrency: 8})
+	for _, f := range report.Failures() {
+		log.Printf("%s: %v (request %s)", f.Item.Name, f.Err, f.RequestID)
+	}
+*/
+func BulkApply[T any](ctx context.Context, items []T, fn BulkFunc[T], opts BulkOptions[T]) *BulkReport[T] {
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = DefaultBulkConcurrency
+	}
+	workers = min(workers, len(items))
+
+	report := &BulkReport[T]{Results: make([]BulkResult[T], len(items))}
+
+	// Stopping on error lets the items in flight finish.
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	stopped := func() bool {
+		select {
+		case <-stop:
+			return true
+		default:
+			return ctx.Err() != nil
+		}
+	}
+
+	var mu sync.Mutex
+	done := func(res BulkResult[T]) {
+		report.Results[res.Index] = res
+		if res.Err != nil && opts.StopOnError {
+			stopOnce.Do(func() { close(stop) })
+		}
+		if opts.Progress != nil {
+			mu.Lock()
+			defer mu.Unlock()
+			opts.Progress(res)
+		}
+	}
+	skip := func(i int) {
+		done(BulkResult[T]{Index: i, Item: items[i], Err: ErrBulkSkipped})
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if stopped() {
+					skip(i)
+				} else {
+					done(bulkApplyOne(ctx, i, items[i], fn, opts.Upsert))
+				}
+			}
+		}()
+	}
+
+	for i := range items {
+		if stopped() {
+			skip(i)
+			continue
+		}
+		select {
+		case indexes <- i:
+		case <-stop:
+			skip(i)
+		case <-ctx.Done():
+			skip(i)
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	return report
+}
+
+// bulkApplyOne applies item, the ith one.
+func bulkApplyOne[T any](ctx context.Context, i int, item T, fn, upsert BulkFunc[T]) BulkResult[T] {
+	res := BulkResult[T]{Index: i, Item: item}
+
+	start := time.Now()
+	resp, err := fn(ctx, item)
+	if err != nil && upsert != nil && scmErrors.IsNameNotUnique(err) {
+		res.Upserted = true
+		resp, err = upsert(ctx, item)
+	}
+	res.Duration = time.Since(start)
+	res.Err = err
+
+	if resp != nil {
+		res.StatusCode = resp.StatusCode
+		res.RequestID = resp.Header.Get("X-Request-ID")
+	}
+	var se scmErrors.ScmError
+	var ar api.Response
+	switch {
+	case errors.As(err, &se):
+		res.StatusCode = se.HTTPStatusCode()
+		if id := scmErrors.RequestID(err); id != "" {
+			res.RequestID = id
+		}
+	case errors.As(err, &ar):
+		res.StatusCode = ar.StatusCode
+		if ar.RequestId != "" {
+			res.RequestID = ar.RequestId
+		}
+	}
+	return res
+}
@@ -0,0 +1,150 @@
+package scm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func TestBulkApply(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+	srv.Add("/config/objects/v1/addresses", map[string]interface{}{"name": "addr-3", "folder": "Shared", "ip_netmask": "10.0.0.1/32"})
+
+	ctx := context.Background()
+	client := newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true})
+	require.NoError(t, client.RefreshJwt(ctx))
+	api := GetObjectsAPIClient(client).AddressesAPI
+
+	var items []objects.Addresses
+	for i := range 10 {
+		a := objects.NewAddresses("", fmt.Sprintf("addr-%d", i))
+		a.SetIpNetmask(fmt.Sprintf("10.0.1.%d/32", i))
+		if i != 7 {
+			a.SetFolder("Shared")
+		}
+		items = append(items, *a)
+	}
+
+	var progress atomic.Int32
+	report := BulkApply(ctx, items, func(ctx context.Context, a objects.Addresses) (*http.Response, error) {
+		_, resp, err := api.CreateAddresses(ctx).Addresses(a).Execute()
+		return resp, err
+	}, BulkOptions[objects.Addresses]{
+		Concurrency: 3,
+		Upsert: func(ctx context.Context, a objects.Addresses) (*http.Response, error) {
+			cur, err := api.FetchAddresses(ctx, a.Name, a.Folder, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			_, resp, err := api.UpdateAddressesByID(ctx, cur.Id).Addresses(a).Execute()
+			return resp, err
+		},
+		Progress: func(BulkResult[objects.Addresses]) { progress.Add(1) },
+	})
+
+	require.Len(t, report.Results, 10)
+	assert.EqualValues(t, 10, progress.Load())
+	assert.Equal(t, 9, report.Succeeded())
+
+	for i, res := range report.Results {
+		assert.Equal(t, i, res.Index)
+		assert.Equal(t, items[i].Name, res.Item.Name)
+		assert.NotEmpty(t, res.RequestID)
+	}
+	assert.True(t, report.Results[3].Upserted)
+	assert.Equal(t, http.StatusOK, report.Results[3].StatusCode)
+	assert.Equal(t, http.StatusCreated, report.Results[0].StatusCode)
+
+	failures := report.Failures()
+	require.Len(t, failures, 1)
+	assert.Equal(t, 7, failures[0].Index)
+	assert.Equal(t, http.StatusBadRequest, failures[0].StatusCode)
+	assert.True(t, scmErrors.IsInvalidObject(failures[0].Err), "%v", failures[0].Err)
+	assert.Equal(t, scmErrors.RequestID(failures[0].Err), failures[0].RequestID)
+	assert.ErrorContains(t, report.Err(), "item 7: ")
+
+	addrs := srv.Objects("/config/objects/v1/addresses")
+	assert.Len(t, addrs, 9)
+	for _, a := range addrs {
+		if a["name"] == "addr-3" {
+			assert.Equal(t, "10.0.1.3/32", a["ip_netmask"])
+		}
+	}
+}
+
+func TestBulkApply_Concurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	items := make([]int, 20)
+
+	report := BulkApply(context.Background(), items, func(ctx context.Context, _ int) (*http.Response, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return nil, nil
+	}, BulkOptions[int]{Concurrency: 4})
+
+	assert.Equal(t, 20, report.Succeeded())
+	assert.NoError(t, report.Err())
+	assert.LessOrEqual(t, peak.Load(), int32(4))
+	assert.Greater(t, peak.Load(), int32(1))
+}
+
+func TestBulkApply_StopOnError(t *testing.T) {
+	boom := errors.New("boom")
+	items := []int{0, 1, 2, 3, 4}
+
+	var calls atomic.Int32
+	report := BulkApply(context.Background(), items, func(ctx context.Context, i int) (*http.Response, error) {
+		calls.Add(1)
+		if i == 1 {
+			return nil, boom
+		}
+		return nil, nil
+	}, BulkOptions[int]{Concurrency: 1, StopOnError: true})
+
+	assert.EqualValues(t, 2, calls.Load())
+	assert.Equal(t, 1, report.Succeeded())
+	assert.Len(t, report.Failures(), 4)
+	assert.ErrorIs(t, report.Results[1].Err, boom)
+	for _, res := range report.Results[2:] {
+		assert.ErrorIs(t, res.Err, ErrBulkSkipped)
+	}
+
+	err := report.Err()
+	assert.ErrorIs(t, err, boom)
+	assert.ErrorIs(t, err, ErrBulkSkipped)
+	assert.EqualError(t, err, "item 1: boom\n3 item(s) skipped")
+}
+
+func TestBulkApply_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report := BulkApply(ctx, []string{"a", "b"}, func(ctx context.Context, _ string) (*http.Response, error) {
+		t.Fatal("no item should be applied")
+		return nil, nil
+	}, BulkOptions[string]{})
+
+	assert.Equal(t, 0, report.Succeeded())
+	for _, res := range report.Results {
+		assert.ErrorIs(t, res.Err, ErrBulkSkipped)
+	}
+}
@@ -0,0 +1,118 @@
+package scm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JwtClaims are the claims of a JWT issued by the auth server.
+type JwtClaims struct {
+	// TsgId is the tenant service group the JWT gives access to, from its
+	// "tsg_id" claim or its "tsg_id:<id>" scope.
+	TsgId string
+
+	// Subject is the service account the JWT was issued to.
+	Subject string
+
+	// Scopes are the scopes granted, and Roles the roles, if the JWT has
+	// them.
+	Scopes []string
+	Roles  []string
+
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+
+	// Claims holds all of the claims, as decoded from JSON.
+	Claims map[string]interface{}
+}
+
+// ParseJwtClaims decodes the claims of jwt.  The signature is not verified:
+// the claims are only informative, the API being the one checking them.
+func ParseJwtClaims(jwt string) (*JwtClaims, error) {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed JWT: %d parts instead of 3", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("malformed JWT payload: %w", err)
+	}
+
+	ans := &JwtClaims{}
+	d := json.NewDecoder(strings.NewReader(string(payload)))
+	d.UseNumber()
+	if err = d.Decode(&ans.Claims); err != nil {
+		return nil, fmt.Errorf("malformed JWT claims: %w", err)
+	}
+
+	ans.Subject = claimString(ans.Claims["sub"])
+	ans.Scopes = claimList(ans.Claims["scope"])
+	if len(ans.Scopes) == 0 {
+		ans.Scopes = claimList(ans.Claims["scp"])
+	}
+	ans.Roles = claimList(ans.Claims["roles"])
+	ans.TsgId = claimString(ans.Claims["tsg_id"])
+	for _, s := range ans.Scopes {
+		if id, ok := strings.CutPrefix(s, "tsg_id:"); ok && ans.TsgId == "" {
+			ans.TsgId = id
+		}
+	}
+	ans.IssuedAt = claimTime(ans.Claims["iat"])
+	ans.ExpiresAt = claimTime(ans.Claims["exp"])
+
+	return ans, nil
+}
+
+// JwtClaims returns the claims of the client's current JWT.  It fails if the
+// client has no JWT yet, or if it is not a JWT.
+func (c *Client) JwtClaims() (*JwtClaims, error) {
+	jwt, _ := c.jwt()
+	if jwt == "" {
+		return nil, fmt.Errorf("no JWT, RefreshJwt has not been called")
+	}
+	return ParseJwtClaims(jwt)
+}
+
+// claimString returns the string or number claim v.
+func claimString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	return ""
+}
+
+// claimList returns the claim v, either a space separated string or an
+// array of strings.
+func claimList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		var ans []string
+		for _, s := range v {
+			if s := claimString(s); s != "" {
+				ans = append(ans, s)
+			}
+		}
+		return ans
+	}
+	return nil
+}
+
+// claimTime returns the NumericDate claim v, the number of seconds since the
+// epoch.
+func claimTime(v interface{}) time.Time {
+	f, err := strconv.ParseFloat(claimString(v), 64)
+	if err != nil {
+		return time.Time{}
+	}
+	sec := int64(f)
+	return time.Unix(sec, int64((f-float64(sec))*1e9))
+}
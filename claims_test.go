@@ -0,0 +1,69 @@
+package scm
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func testJwt(payload string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`)) + "." + enc.EncodeToString([]byte(payload)) + ".c2lnbmF0dXJl"
+}
+
+func TestParseJwtClaims(t *testing.T) {
+	claims, err := ParseJwtClaims(testJwt(`{
+		"sub": "automation@1234567890.iam.panserviceaccount.com",
+		"scope": "profile tsg_id:1234567890 email",
+		"roles": ["superuser"],
+		"iat": 1760000000,
+		"exp": 1760000899.5
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", claims.TsgId)
+	assert.Equal(t, "automation@1234567890.iam.panserviceaccount.com", claims.Subject)
+	assert.Equal(t, []string{"profile", "tsg_id:1234567890", "email"}, claims.Scopes)
+	assert.Equal(t, []string{"superuser"}, claims.Roles)
+	assert.Equal(t, time.Unix(1760000000, 0), claims.IssuedAt)
+	assert.Equal(t, time.Unix(1760000899, 5e8), claims.ExpiresAt)
+	assert.Equal(t, "superuser", claims.Claims["roles"].([]interface{})[0])
+
+	// An explicit tsg_id claim, which may be a number, and scp scopes.
+	claims, err = ParseJwtClaims(testJwt(`{"tsg_id": 1111111111, "scp": ["tsg_id:2222222222"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "1111111111", claims.TsgId)
+	assert.Equal(t, []string{"tsg_id:2222222222"}, claims.Scopes)
+	assert.True(t, claims.ExpiresAt.IsZero())
+
+	for jwt, msg := range map[string]string{
+		"opaque":                     "malformed JWT: 1 parts instead of 3",
+		"a.!!.c":                     "malformed JWT payload",
+		testJwt(`["not", "claims"]`): "malformed JWT claims",
+	} {
+		_, err = ParseJwtClaims(jwt)
+		assert.ErrorContains(t, err, msg)
+	}
+}
+
+func TestClient_JwtClaims(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	c := newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true})
+	_, err := c.JwtClaims()
+	assert.EqualError(t, err, "no JWT, RefreshJwt has not been called")
+
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	claims, err := c.JwtClaims()
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", claims.TsgId)
+	assert.Equal(t, scmtest.ClientID, claims.Subject)
+	assert.Equal(t, []string{scmtest.Scope}, claims.Scopes)
+	assert.WithinDuration(t, time.Now().Add(scmtest.TokenLifetime*time.Second), claims.ExpiresAt, 5*time.Second)
+}
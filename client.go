@@ -2,21 +2,21 @@ package scm
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
 	"strconv"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"github.com/paloaltonetworks/scm-go/api"
+	"github.com/paloaltonetworks/scm-go/validate"
 	retry "github.com/sethvargo/go-retry"
+	"golang.org/x/oauth2"
 )
 
 /*
@@ -27,7 +27,7 @@ values are configured for the client, then this is the resolution order:
 
 1. Non-empty values for the param (explicitly defined).
 2. Environment variables
-3. Taken from the JSON config file
+3. Taken from the JSON config file, from the selected profile
 
 This resolution happens during Setup().
 
@@ -40,20 +40,78 @@ Host | SCM_HOST | host | "api.strata.paloaltonetworks.com"
 Port | SCM_PORT | port | 0
 ClientId | SCM_CLIENT_ID | client_id | ""
 ClientSecret | SCM_CLIENT_SECRET | client_secret | ""
+ClientSecretFile | SCM_CLIENT_SECRET_FILE | client_secret_file | ""
+ClientSecretCommand | SCM_CLIENT_SECRET_COMMAND | client_secret_command | nil
 Scope | SCM_SCOPE | scope | ""
 Protocol | SCM_PROTOCOL | protocol | "https"
 Headers | SCM_HEADERS | headers | nil
+RateLimit | SCM_RATE_LIMIT | rate_limit | nil
 Agent | - | agent | ""
+Profile | SCM_PROFILE | profile | "default"
 SkipVerifyCertificate | SCM_SKIP_VERIFY_CERTIFICATE | skip_verify_certificate | false
+CaFile | SCM_CA_FILE | ca_file | ""
+CaPem | SCM_CA_PEM | ca_pem | ""
+ClientCertFile | SCM_CLIENT_CERT_FILE | client_cert_file | ""
+ClientKeyFile | SCM_CLIENT_KEY_FILE | client_key_file | ""
+MinTlsVersion | SCM_MIN_TLS_VERSION | min_tls_version | ""
+CipherSuites | SCM_CIPHER_SUITES | cipher_suites | nil
+ProxyUrl | SCM_PROXY_URL | proxy_url | ""
+NoProxy | SCM_NO_PROXY | no_proxy | ""
 Logging | SCM_LOGGING | logging | "quiet"
 SkipLoggingTransport | - | skip_logging_transport | false
+ValidateRequests | SCM_VALIDATE_REQUESTS | validate_requests | false
+
+Host, Port, Protocol, Headers and Agent apply both to Do() and to the API
+clients returned by the Get*APIClient functions.
+
+The TLS and proxy settings apply to the transport built by Setup() when
+Transport is not set, used by both the auth and the API requests, including
+RefreshJwt().  The CA
+certificates of CaFile and CaPem are trusted along with the system ones.
+MinTlsVersion is one of "1.0", "1.1", "1.2" or "1.3", and CipherSuites, a
+comma separated list in SCM_CIPHER_SUITES, holds names of secure suites such
+as "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"; they only restrict TLS 1.2 and
+below.  ProxyUrl, when not set, defaults to the proxy of the HTTPS_PROXY and
+related environment variables.  NoProxy is a comma separated list of hosts,
+domains, IP addresses and CIDR ranges reached directly.
+
+The client secret is taken from the first of ClientSecret, ClientSecretFile
+and ClientSecretCommand that is set, the fields coming before the environment
+variables, which come before the JSON config file: a secret file given in a
+field or in SCM_CLIENT_SECRET_FILE wins over a client_secret of the config
+file.  SCM_CLIENT_SECRET_COMMAND holds the command and its arguments
+separated by spaces.  A profile setting one of client_secret,
+client_secret_file and client_secret_command inherits none of them.  A
+ClientSecret of the form "env:NAME" is the value of the environment variable
+NAME, and one of the form "scheme:ref" is resolved by
+SecretProviders[scheme], if set.  The secret is never logged, nor encoded to
+JSON.
+
+The top level of the JSON config file holds the default profile.  Named
+profiles are under "profiles", and inherit the settings of the default
+profile, or of the profile named by their "inherits" key, overriding some of
+them as a whole:
+
+	{
+	  "client_id": "automation@1234567890.iam.panserviceaccount.com",
+	  "client_secret": "...",
+	  "scope": "tsg_id:1234567890",
+	  "profile": "prod",
+	  "profiles": {
+	    "prod": {"rate_limit": {"default": {"requests_per_second": 10}}},
+	    "staging": {"inherits": "prod", "scope": "tsg_id:1234567891"}
+	  }
+	}
+
+JWTs are requested from AuthUrl with the OAuth2 client credentials grant,
+unless TokenSource is set, in which case they are taken from it instead.
 */
 type Client struct {
 	AuthUrl      string            `json:"auth_url"`
 	Host         string            `json:"host"`
 	Port         int               `json:"port"`
 	ClientId     string            `json:"client_id"`
-	ClientSecret string            `json:"client_secret"`
+	ClientSecret string            `json:"-"` // Only decoded, see UnmarshalJSON.
 	Scope        string            `json:"scope"`
 	Protocol     string            `json:"protocol"`
 	Headers      map[string]string `json:"headers"`
@@ -62,22 +120,101 @@ type Client struct {
 	AuthFile         string `json:"-"`
 	CheckEnvironment bool   `json:"-"`
 
+	// ClientSecretFile is the path of a file holding the client secret.
+	ClientSecretFile string `json:"client_secret_file"`
+
+	// ClientSecretCommand is a command, and its arguments, printing the
+	// client secret.  It is run for at most SecretTimeout.
+	ClientSecretCommand []string `json:"client_secret_command"`
+
+	// SecretProviders resolve ClientSecret references of the form
+	// "scheme:ref", by scheme.
+	SecretProviders map[string]SecretProvider `json:"-"`
+
+	// SecretTimeout bounds the time taken resolving the client secret,
+	// DefaultSecretTimeout if not set.
+	SecretTimeout time.Duration `json:"-"`
+
+	// Profile is the profile of AuthFile to take settings from.  Setup sets
+	// it to the profile used.
+	Profile string `json:"-"`
+
 	SkipVerifyCertificate bool            `json:"skip_verify_certificate"`
 	Transport             *http.Transport `json:"-"`
 
+	// CaFile and CaPem hold PEM CA certificates to trust, such as the one of
+	// a TLS intercepting proxy.
+	CaFile string `json:"ca_file"`
+	CaPem  string `json:"ca_pem"`
+
+	// ClientCertFile and ClientKeyFile hold the PEM certificate and key
+	// presented for mutual TLS.
+	ClientCertFile string `json:"client_cert_file"`
+	ClientKeyFile  string `json:"client_key_file"`
+
+	// MinTlsVersion and CipherSuites restrict the TLS versions and cipher
+	// suites negotiated.
+	MinTlsVersion string   `json:"min_tls_version"`
+	CipherSuites  []string `json:"cipher_suites"`
+
+	// ProxyUrl is the proxy to send requests through, except for the hosts
+	// matching NoProxy.
+	ProxyUrl string `json:"proxy_url"`
+	NoProxy  string `json:"no_proxy"`
+
 	SkipLoggingTransport bool       `json:"skip_logging_transport"`
 	Logging              string     `json:"logging"`
 	Logger               api.Logger `json:"-"`
 
-	Jwt       string `json:"jwt,omitempty"`
-	jwtAtomic int32  `json:"-"`
+	// SlogLogger receives the client's logs.  See Log() for how the
+	// Logging level applies.
+	SlogLogger *slog.Logger `json:"-"`
+	slogger    *slog.Logger
+
+	// RateLimit, if set, throttles outgoing requests.  See RateLimit.
+	RateLimit *RateLimit `json:"rate_limit,omitempty"`
+
+	// RateLimiter is the limiter enforcing RateLimit, created by Setup()
+	// unless set.  Clients given the same RateLimiter share its buckets,
+	// whatever their RateLimit.
+	RateLimiter *RateLimiter `json:"-"`
+
+	// RetryPolicy controls how requests failing with 429, 5xx or network
+	// errors are retried, both by Do() and by the API clients.
+	RetryPolicy RetryPolicy `json:"-"`
+
+	// TokenSource, if set, is used to obtain JWTs instead of requesting them
+	// from AuthUrl.  ClientId, ClientSecret and Scope are then optional.
+	TokenSource oauth2.TokenSource `json:"-"`
+
+	// TokenCache, if set, shares JWTs requested from AuthUrl with other
+	// clients and processes using the same credentials.
+	TokenCache TokenCache `json:"-"`
+
+	// Middleware wrap every API request, both those of Do() and those of the
+	// API clients, the first one being the outermost.  They run before the
+	// JWT is set, so any JWT refresh a request waits on happens within them.
+	// Do() uses the middleware set when Setup() is called.
+	Middleware []Middleware `json:"-"`
+
+	// ValidateRequests validates request bodies against the constraints of
+	// their schema before sending them, both in Do() and in the API clients.
+	// See package validate.
+	ValidateRequests bool `json:"validate_requests"`
+
+	Jwt    string       `json:"-"` // Only decoded, see UnmarshalJSON.
+	tokens tokenManager `json:"-"`
 
 	JwtExpiresAt time.Time `json:"jwt_expires_at,omitempty"` // The actual time the JWT will expire
 	JwtLifetime  int64     `json:"jwt_lifetime,omitempty"`   // The TTL received from the auth server (in seconds)
 
 	apiPrefix string
 
-	HttpClient *http.Client
+	HttpClient *http.Client `json:"-"`
+
+	// apiHttpClient is the client of Do, sending requests through the same
+	// transports as the API clients.  It is built by Setup.
+	apiHttpClient *http.Client
 
 	testData        []*http.Response
 	testIndex       int
@@ -90,7 +227,7 @@ func (c *Client) Setup() error {
 	var err error
 
 	// Load up the JSON config file.
-	var json_client Client
+	json_client := &Client{}
 	if c.AuthFile != "" {
 		var b []byte
 		if len(c.testData) != 0 {
@@ -103,9 +240,16 @@ func (c *Client) Setup() error {
 			return err
 		}
 
-		if err = json.Unmarshal(b, &json_client); err != nil {
+		// Profile.
+		profile := c.Profile
+		if val := os.Getenv("SCM_PROFILE"); profile == "" && c.CheckEnvironment && val != "" {
+			profile = val
+		}
+		if json_client, c.Profile, err = loadProfile(b, profile); err != nil {
 			return err
 		}
+	} else if c.Profile != "" {
+		return fmt.Errorf("Profile %q requires an AuthFile", c.Profile)
 	}
 
 	// AuthUrl.
@@ -157,20 +301,14 @@ func (c *Client) Setup() error {
 			c.ClientId = val
 		} else if json_client.ClientId != "" {
 			c.ClientId = json_client.ClientId
-		} else {
+		} else if c.TokenSource == nil {
 			return fmt.Errorf("ClientId must be specified")
 		}
 	}
 
 	// Client secret.
-	if c.ClientSecret == "" {
-		if val := os.Getenv("SCM_CLIENT_SECRET"); c.CheckEnvironment && val != "" {
-			c.ClientSecret = val
-		} else if json_client.ClientSecret != "" {
-			c.ClientSecret = json_client.ClientSecret
-		} else {
-			return fmt.Errorf("ClientSecret must be specified")
-		}
+	if err = c.resolveClientSecret(json_client); err != nil {
+		return err
 	}
 
 	// Scope.
@@ -179,7 +317,7 @@ func (c *Client) Setup() error {
 			c.Scope = val
 		} else if json_client.Scope != "" {
 			c.Scope = json_client.Scope
-		} else {
+		} else if c.TokenSource == nil {
 			return fmt.Errorf("Scope must be specified")
 		}
 	}
@@ -210,6 +348,17 @@ func (c *Client) Setup() error {
 		}
 	}
 
+	// Rate limit.
+	if c.RateLimit == nil {
+		if val := os.Getenv("SCM_RATE_LIMIT"); c.CheckEnvironment && val != "" {
+			if err := json.Unmarshal([]byte(val), &c.RateLimit); err != nil {
+				return err
+			}
+		} else if json_client.RateLimit != nil {
+			c.RateLimit = json_client.RateLimit
+		}
+	}
+
 	// Skip verify certificate.
 	if !c.SkipVerifyCertificate {
 		if val := os.Getenv("SCM_SKIP_VERIFY_CERTIFICATE"); c.CheckEnvironment && val != "" {
@@ -224,12 +373,34 @@ func (c *Client) Setup() error {
 		}
 	}
 
+	// TLS and proxy.
+	if err = c.resolveTransportSettings(json_client); err != nil {
+		return err
+	}
+
+	// Validate requests.
+	if !c.ValidateRequests {
+		if val := os.Getenv("SCM_VALIDATE_REQUESTS"); c.CheckEnvironment && val != "" {
+			if vr, err := strconv.ParseBool(val); err != nil {
+				return err
+			} else if vr {
+				c.ValidateRequests = vr
+			}
+		}
+		if !c.ValidateRequests && json_client.ValidateRequests {
+			c.ValidateRequests = json_client.ValidateRequests
+		}
+	}
+
 	// Logging.
 	if c.Logging == "" {
 		if val := os.Getenv("SCM_LOGGING"); c.CheckEnvironment && val != "" {
 			c.Logging = val
 		} else if json_client.Logging != "" {
 			c.Logging = json_client.Logging
+		} else if c.SlogLogger != nil {
+			// Let the level of the logger's handler decide.
+			c.Logging = api.LogDetailed
 		} else {
 			c.Logging = api.LogQuiet
 		}
@@ -247,20 +418,49 @@ func (c *Client) Setup() error {
 
 	// Setup the https client.
 	if c.Transport == nil {
-		c.Transport = &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: c.SkipVerifyCertificate,
-			},
+		if c.Transport, err = c.newTransport(); err != nil {
+			return err
 		}
 	}
+	if json_client.SkipLoggingTransport {
+		c.SkipLoggingTransport = true
+	}
+	c.setupHttpClient()
+	c.apiHttpClient = newAPIHTTPClient(c)
+
+	return nil
+}
+
+// setupHttpClient builds HttpClient on top of Transport, according to the
+// resolved settings.
+func (c *Client) setupHttpClient() {
 	c.HttpClient = &http.Client{
 		Transport: c.Transport,
 	}
 
+	// Setup logging.
+	c.slogger = c.newLogger()
+
 	// Attach logging transport.
-	if !c.SkipLoggingTransport && !json_client.SkipLoggingTransport {
-		c.HttpClient.Transport = api.NewTransport(c.HttpClient.Transport, c)
+	if !c.SkipLoggingTransport {
+		c.HttpClient.Transport = api.NewLoggingTransport(c.HttpClient.Transport, c.slogger)
+	}
+
+	// Throttle requests.
+	if c.RateLimiter == nil && c.RateLimit != nil {
+		c.RateLimiter = NewRateLimiter(*c.RateLimit)
+	}
+	if c.RateLimiter != nil {
+		c.HttpClient.Transport = &RateLimitTransport{
+			Wrapped: c.HttpClient.Transport,
+			Limiter: c.RateLimiter,
+		}
+	}
+
+	// Retry failed requests.
+	c.HttpClient.Transport = &RetryTransport{
+		Wrapped: c.HttpClient.Transport,
+		Policy:  c.RetryPolicy,
 	}
 
 	// Configure the uri prefix.
@@ -269,32 +469,34 @@ func (c *Client) Setup() error {
 	} else {
 		c.apiPrefix = fmt.Sprintf("%s://%s", c.Protocol, c.Host)
 	}
-
-	return nil
 }
 
 // RefreshJwt refreshes the JWT necessary to interact with the API.
 //
-// This function is atomic (only one may be running at any given time).
+// This function is safe for concurrent use: only one refresh runs at any
+// given time, and concurrent callers wait for that refresh to complete and
+// share its result.  Waiting stops early if ctx is done.
 func (c *Client) RefreshJwt(ctx context.Context) error {
-	c.Log(ctx, "", "=== RefreshJwt() CALLED ===")
+	return c.refreshJwt(ctx, nil)
+}
 
-	// Ensure that this is atomic.
-	nv := atomic.AddInt32(&c.jwtAtomic, 1)
-	defer atomic.AddInt32(&c.jwtAtomic, -1)
-	c.Log(ctx, "", fmt.Sprintf("RefreshJwt: Atomic counter value: %d", nv))
+// fetchJwt obtains a new JWT and stores it on the client.
+func (c *Client) fetchJwt(ctx context.Context) error {
+	c.Log(ctx, "", "=== RefreshJwt() CALLED ===")
 
-	if nv != 1 {
-		c.Log(ctx, "", "RefreshJwt: Another refresh in progress, waiting...")
-		for {
-			if atomic.LoadInt32(&c.jwtAtomic) == nv-1 {
-				break
-			}
-		}
-		c.Log(ctx, "", "RefreshJwt: Wait completed, returning")
-		return nil
+	switch {
+	case c.TokenSource != nil:
+		c.Log(ctx, "", "RefreshJwt: Using the configured token source")
+		return c.fetchJwtFromSource()
+	case c.TokenCache != nil:
+		c.Log(ctx, "", "RefreshJwt: Using the configured token cache")
+		return c.fetchJwtCached(ctx)
 	}
+	return c.requestJwt(ctx)
+}
 
+// requestJwt obtains a new JWT from the auth server and stores it on the client.
+func (c *Client) requestJwt(ctx context.Context) error {
 	var resp *http.Response
 	var err error
 	var body []byte
@@ -370,7 +572,7 @@ func (c *Client) RefreshJwt(ctx context.Context) error {
 				return readErr
 			}
 
-			c.Log(ctx, "", fmt.Sprintf("RefreshJwt: Response body: %s", string(body)))
+			c.Log(ctx, "", fmt.Sprintf("RefreshJwt: Response body: %s", api.RedactJSON(body)))
 
 			if err := json.Unmarshal(body, &aa); err != nil {
 				c.Log(ctx, "", fmt.Sprintf("RefreshJwt: Failed to unmarshal response: %v", err))
@@ -395,13 +597,9 @@ func (c *Client) RefreshJwt(ctx context.Context) error {
 		}
 
 		c.Log(ctx, "", "RefreshJwt: Setting new JWT token")
-		c.Jwt = aa.Jwt
-		// Set the lifetime and calculated expiry time (new lines)
-		c.JwtLifetime = int64(aa.ExpiresIn)
-		c.Log(ctx, "", fmt.Sprintf("RefreshJwt: Expires In: %d", c.JwtLifetime))
-		// Set expiry to the current time plus the token lifetime, minus a 60 second buffer
-		c.JwtExpiresAt = time.Now().Add(time.Duration(c.JwtLifetime)*time.Second - 60*time.Second)
-		c.Log(ctx, "", "JWT Expiry Set - ExpiresAt: "+c.JwtExpiresAt.Format("15:04:05"))
+		expiresAt := c.setJwt(aa.Jwt, int64(aa.ExpiresIn))
+		c.Log(ctx, "", fmt.Sprintf("RefreshJwt: Expires In: %d", aa.ExpiresIn))
+		c.Log(ctx, "", "JWT Expiry Set - ExpiresAt: "+expiresAt.Format("15:04:05"))
 
 		c.Log(ctx, "", "=== RefreshJwt() COMPLETED SUCCESSFULLY ===")
 		return nil
@@ -433,10 +631,7 @@ func (c *Client) RefreshJwt(ctx context.Context) error {
 		return err
 	}
 
-	c.Jwt = aa.Jwt
-	// Set the lifetime and calculated expiry time (new lines)
-	c.JwtLifetime = int64(aa.ExpiresIn)
-	c.JwtExpiresAt = time.Now().Add(time.Duration(c.JwtLifetime)*time.Second - 60*time.Second)
+	c.setJwt(aa.Jwt, int64(aa.ExpiresIn))
 	return nil
 }
 
@@ -445,19 +640,72 @@ func (c *Client) LoggingIsSetTo(v string) bool {
 	return c.Logging == v
 }
 
-// Log outputs API actions.
+/*
+Log outputs API actions.
+
+Messages logged with api.LogBasic are logged at info level, all others at
+debug level.  The Logging param selects which ones are output: nothing for
+api.LogQuiet, info and above for api.LogBasic, everything for api.LogDetailed.
+
+Logs go to SlogLogger if set, else to Logger if set, else to stderr.  Tokens,
+secrets and passwords are always redacted.
+*/
 func (c *Client) Log(ctx context.Context, level, msg string) {
-	if c.Logging == api.LogQuiet {
-		return
+	lvl := slog.LevelDebug
+	if level == api.LogBasic {
+		lvl = slog.LevelInfo
 	}
+	c.logger().Log(ctx, lvl, msg)
+}
 
-	if level == "" || c.Logging == level {
-		if c.Logger == nil {
-			log.Printf(msg)
-		} else {
-			c.Logger(ctx, msg)
-		}
+// logger returns the logger of the client.
+func (c *Client) logger() *slog.Logger {
+	if c.slogger != nil {
+		return c.slogger
+	}
+	return c.newLogger()
+}
+
+// newLogger builds the logger of the client from its logging params.
+func (c *Client) newLogger() *slog.Logger {
+	var level slog.Level
+	switch c.Logging {
+	case "", api.LogQuiet:
+		return slog.New(slog.DiscardHandler)
+	case api.LogDetailed:
+		level = slog.LevelDebug
+	default:
+		level = slog.LevelInfo
+	}
+
+	var h slog.Handler
+	switch {
+	case c.SlogLogger != nil:
+		h = levelHandler{c.SlogLogger.Handler(), level}
+	case c.Logger != nil:
+		h = api.NewLoggerHandler(c.Logger, level)
+	default:
+		h = slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})
 	}
+	return slog.New(api.NewRedactingHandler(h))
+}
+
+// levelHandler is a slog.Handler dropping records below a minimum level.
+type levelHandler struct {
+	slog.Handler
+	level slog.Level
+}
+
+func (h levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level && h.Handler.Enabled(ctx, level)
+}
+
+func (h levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return levelHandler{h.Handler.WithAttrs(attrs), h.level}
+}
+
+func (h levelHandler) WithGroup(name string) slog.Handler {
+	return levelHandler{h.Handler.WithGroup(name), h.level}
 }
 
 /*
@@ -487,22 +735,18 @@ func (c *Client) Do(ctx context.Context, method string, path string, queryParams
 		return nil, retry[len(retry)-1]
 	}
 
-	// Refresh token if it expires or empty
-	if c.Jwt != "" && time.Now().After(c.JwtExpiresAt) {
-		c.Log(ctx, api.LogBasic, "JWT expired or near expiry, attempting proactive refresh.")
-		// The Jwt check is atomic, so it's safe to call here.
-		if err := c.RefreshJwt(ctx); err != nil {
-			return nil, fmt.Errorf("failed to proactively refresh JWT: %w", err)
-		}
-	}
-
-	var err error
 	var body, data []byte
 	var resp *http.Response
 	var qp string
+	var err error
 
 	// Convert input into JSON.
 	if input != nil {
+		if c.ValidateRequests {
+			if err = validate.Value(input); err != nil {
+				return nil, err
+			}
+		}
 		data, err = json.Marshal(input)
 		if err != nil {
 			return nil, err
@@ -522,6 +766,9 @@ func (c *Client) Do(ctx context.Context, method string, path string, queryParams
 
 	if len(c.testData) != 0 {
 		// Testing.
+		if _, err = c.currentJwt(ctx); err != nil {
+			return nil, fmt.Errorf("failed to proactively refresh JWT: %w", err)
+		}
 		resp = c.testData[c.testIndex%len(c.testData)]
 		c.testIndex++
 	} else {
@@ -535,14 +782,13 @@ func (c *Client) Do(ctx context.Context, method string, path string, queryParams
 		if c.Agent != "" {
 			req.Header.Set("User-Agent", c.Agent)
 		}
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Jwt))
 		req.Header.Set("Accept", "application/json")
-		req.Header.Set("x-auth-jwt", c.Jwt)
 		for k, v := range c.Headers {
 			req.Header.Set(k, v)
 		}
 
-		resp, err = c.HttpClient.Do(req)
+		// The JWT is set by the JWTRefreshTransport, within the middleware.
+		resp, err = c.apiHttpClient.Do(req)
 	}
 
 	if err != nil {
@@ -592,21 +838,17 @@ func (c *Client) Do(ctx context.Context, method string, path string, queryParams
 		}
 
 		// First auth failure, so refresh the JWT then retry the operation.
-		if err = c.RefreshJwt(ctx); err != nil {
-			return nil, err
+		jwt, _ := c.jwt()
+		if resp.Request != nil && resp.Request.Header.Get("x-auth-jwt") != "" {
+			jwt = resp.Request.Header.Get("x-auth-jwt")
 		}
-		return c.Do(ctx, method, path, queryParams, input, output, append(retry, stat)...)
-	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
-		// When these errors are encountered, we should be sleeping and then retrying again:
-		// https://pan.dev/prisma-cloud/api/cspm/api-errors/#reattempting-requests-that-fail-due-to-a-server-error
-		// TODO(shinmog): When/if this is implemented, verify backoff logic with eng.
-
-		// Only sleep if we're not running tests.
-		if len(c.testData) == 0 {
-			time.Sleep(time.Duration(len(retry)+1*2) * time.Second)
+		if err = c.refreshJwt(ctx, &jwt); err != nil {
+			return nil, err
 		}
 		return c.Do(ctx, method, path, queryParams, input, output, append(retry, stat)...)
 	default:
+		// 429 and 5xx responses have already been retried by the
+		// RetryTransport according to the RetryPolicy.
 		return body, stat
 	}
 
@@ -624,6 +866,35 @@ func (c *Client) Do(ctx context.Context, method string, path string, queryParams
 // GetHost returns the Host property.
 func (c *Client) GetHost() string { return c.Host }
 
+// apiScheme returns the protocol used for API requests.
+func (c *Client) apiScheme() string {
+	if c.Protocol == "" {
+		return "https"
+	}
+	return c.Protocol
+}
+
+// apiHost returns the host, including the port if one is configured, used
+// for API requests.
+func (c *Client) apiHost() string {
+	if c.Port != 0 {
+		return fmt.Sprintf("%s:%d", c.Host, c.Port)
+	}
+	return c.Host
+}
+
+// apiServerURL rewrites the scheme, host and port of a generated server URL
+// to the ones configured on the client, keeping the service path.
+func (c *Client) apiServerURL(serverURL string) string {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return serverURL
+	}
+	u.Scheme = c.apiScheme()
+	u.Host = c.apiHost()
+	return u.String()
+}
+
 // authResponse represents the response from the auth endpoint
 type authResponse struct {
 	Jwt       string `json:"access_token"`
@@ -660,18 +931,15 @@ func (j *JWTRefreshTransport) RoundTrip(req *http.Request) (*http.Response, erro
 		j.Wrapped = http.DefaultTransport
 	}
 
-	// Check if JWT needs refresh (similar logic to client.go Do method)
-	if j.SetupClient.Jwt != "" && time.Now().After(j.SetupClient.JwtExpiresAt) {
-		fmt.Printf("Refreshing tokens\n")
-		// JWT expired or near expiry, attempt proactive refresh
-		if err := j.SetupClient.RefreshJwt(req.Context()); err != nil {
-			// If refresh fails, continue with existing token and let the request handle the auth failure
-			return nil, err
-		}
+	// Refresh the JWT if it expired or is near expiry
+	jwt, err := j.SetupClient.currentJwt(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to proactively refresh JWT: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+j.SetupClient.Jwt)
-	req.Header.Set("x-auth-jwt", j.SetupClient.Jwt)
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("x-auth-jwt", jwt)
 
 	// Execute the request
 	resp, err := j.Wrapped.RoundTrip(req)
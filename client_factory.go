@@ -2,8 +2,9 @@ package scm
 
 import (
 	"net/http"
+	"reflect"
+	"slices"
 
-	"github.com/paloaltonetworks/scm-go/common"
 	"github.com/paloaltonetworks/scm-go/generated/config_operations"
 	"github.com/paloaltonetworks/scm-go/generated/config_setup"
 	"github.com/paloaltonetworks/scm-go/generated/deployment_services"
@@ -14,220 +15,103 @@ import (
 	"github.com/paloaltonetworks/scm-go/generated/security_services"
 )
 
-// This file is automatically generated. DO NOT EDIT.
-
-func GetConfigOperationsAPIClient(setupClient *Client) *config_operations.APIClient {
-	config := config_operations.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
+// Middleware wraps the transport used by the generated API clients.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// newAPIHTTPClient builds the transport chain shared by the generated API clients.
+//
+// Requests are named after their API operation, then have their body
+// validated if setupClient.ValidateRequests is set, then go through
+// setupClient's middleware, then each middleware in the order given, then the JWT refresh
+// transport, and finally setupClient's transport (retries, rate limiting and
+// logging).
+func newAPIHTTPClient(setupClient *Client, middleware ...Middleware) *http.Client {
 	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
+	var transport http.RoundTripper = &JWTRefreshTransport{
 		Wrapped:     setupClient.HttpClient.Transport,
 		SetupClient: setupClient,
 	}
 
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
+	// Apply the middleware, the first one being the outermost
+	middleware = append(slices.Clip(setupClient.Middleware), middleware...)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
 	}
+	if setupClient.ValidateRequests {
+		transport = &ValidateTransport{Wrapped: transport}
+	}
+	transport = &OperationTransport{Wrapped: transport}
 
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
+	// Create a new HTTP client with the transports.  Logging is done by the
+	// logging transport of setupClient.HttpClient.
+	return &http.Client{
+		Transport: transport,
 		Timeout:   setupClient.HttpClient.Timeout,
 	}
-
-	config.HTTPClient = httpClientWithJWT
-
-	return config_operations.NewAPIClient(config)
 }
 
-func GetConfigSetupAPIClient(setupClient *Client) *config_setup.APIClient {
-	config := config_setup.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
-	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
-		Wrapped:     setupClient.HttpClient.Transport,
-		SetupClient: setupClient,
-	}
-
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
-	}
+// newAPIClient returns the API client built by newClient from the
+// Configuration returned by newConfig, set up to send its requests to the
+// host of setupClient, with its headers and agent, through httpClient.
+func newAPIClient[C, A any](setupClient *Client, httpClient *http.Client, newConfig func() *C, newClient func(*C) *A) *A {
+	config := newConfig()
+	setupClient.configure(reflect.ValueOf(config).Elem(), httpClient)
+	return newClient(config)
+}
 
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
-		Timeout:   setupClient.HttpClient.Timeout,
-	}
+// configure sets up config, the Configuration of a generated API client.
+// The Configuration types of the generated packages have the same fields.
+func (c *Client) configure(config reflect.Value, httpClient *http.Client) {
+	config.FieldByName("Host").SetString(c.apiHost())
+	config.FieldByName("Scheme").SetString(c.apiScheme())
+	setURLs := func(servers reflect.Value) {
+		for i := 0; i < servers.Len(); i++ {
+			u := servers.Index(i).FieldByName("URL")
+			u.SetString(c.apiServerURL(u.String()))
+		}
+	}
+	setURLs(config.FieldByName("Servers"))
+	for it := config.FieldByName("OperationServers").MapRange(); it.Next(); {
+		setURLs(it.Value())
+	}
+	header := config.FieldByName("DefaultHeader").Interface().(map[string]string)
+	for k, v := range c.Headers {
+		header[k] = v
+	}
+	if c.Agent != "" {
+		config.FieldByName("UserAgent").SetString(c.Agent)
+	}
+	config.FieldByName("HTTPClient").Set(reflect.ValueOf(httpClient))
+}
 
-	config.HTTPClient = httpClientWithJWT
+func GetConfigOperationsAPIClient(setupClient *Client) *config_operations.APIClient {
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), config_operations.NewConfiguration, config_operations.NewAPIClient)
+}
 
-	return config_setup.NewAPIClient(config)
+func GetConfigSetupAPIClient(setupClient *Client) *config_setup.APIClient {
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), config_setup.NewConfiguration, config_setup.NewAPIClient)
 }
 
 func GetDeploymentServicesAPIClient(setupClient *Client) *deployment_services.APIClient {
-	config := deployment_services.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
-	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
-		Wrapped:     setupClient.HttpClient.Transport,
-		SetupClient: setupClient,
-	}
-
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
-	}
-
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
-		Timeout:   setupClient.HttpClient.Timeout,
-	}
-
-	config.HTTPClient = httpClientWithJWT
-
-	return deployment_services.NewAPIClient(config)
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), deployment_services.NewConfiguration, deployment_services.NewAPIClient)
 }
 
 func GetDeviceSettingsAPIClient(setupClient *Client) *device_settings.APIClient {
-	config := device_settings.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
-	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
-		Wrapped:     setupClient.HttpClient.Transport,
-		SetupClient: setupClient,
-	}
-
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
-	}
-
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
-		Timeout:   setupClient.HttpClient.Timeout,
-	}
-
-	config.HTTPClient = httpClientWithJWT
-
-	return device_settings.NewAPIClient(config)
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), device_settings.NewConfiguration, device_settings.NewAPIClient)
 }
 
 func GetIdentityServicesAPIClient(setupClient *Client) *identity_services.APIClient {
-	config := identity_services.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
-	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
-		Wrapped:     setupClient.HttpClient.Transport,
-		SetupClient: setupClient,
-	}
-
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
-	}
-
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
-		Timeout:   setupClient.HttpClient.Timeout,
-	}
-
-	config.HTTPClient = httpClientWithJWT
-
-	return identity_services.NewAPIClient(config)
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), identity_services.NewConfiguration, identity_services.NewAPIClient)
 }
 
 func GetNetworkServicesAPIClient(setupClient *Client) *network_services.APIClient {
-	config := network_services.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
-	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
-		Wrapped:     setupClient.HttpClient.Transport,
-		SetupClient: setupClient,
-	}
-
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
-	}
-
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
-		Timeout:   setupClient.HttpClient.Timeout,
-	}
-
-	config.HTTPClient = httpClientWithJWT
-
-	return network_services.NewAPIClient(config)
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), network_services.NewConfiguration, network_services.NewAPIClient)
 }
 
 func GetObjectsAPIClient(setupClient *Client) *objects.APIClient {
-	config := objects.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
-	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
-		Wrapped:     setupClient.HttpClient.Transport,
-		SetupClient: setupClient,
-	}
-
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
-	}
-
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
-		Timeout:   setupClient.HttpClient.Timeout,
-	}
-
-	config.HTTPClient = httpClientWithJWT
-
-	return objects.NewAPIClient(config)
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), objects.NewConfiguration, objects.NewAPIClient)
 }
 
 func GetSecurityServicesAPIClient(setupClient *Client) *security_services.APIClient {
-	config := security_services.NewConfiguration()
-	config.Host = setupClient.GetHost()
-	config.Scheme = "https"
-
-	// Create a custom transport that handles JWT refresh
-	jwtTransport := &JWTRefreshTransport{
-		Wrapped:     setupClient.HttpClient.Transport,
-		SetupClient: setupClient,
-	}
-
-	// Wrap with logging transport
-	loggingTransport := &common.LoggingRoundTripper{
-		Wrapped: jwtTransport,
-	}
-
-	// Create a new HTTP client with the transports
-	httpClientWithJWT := &http.Client{
-		Transport: loggingTransport,
-		Timeout:   setupClient.HttpClient.Timeout,
-	}
-
-	config.HTTPClient = httpClientWithJWT
-
-	return security_services.NewAPIClient(config)
+	return newAPIClient(setupClient, newAPIHTTPClient(setupClient), security_services.NewConfiguration, security_services.NewAPIClient)
 }
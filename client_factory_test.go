@@ -0,0 +1,156 @@
+package scm
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/scmtest"
+	"github.com/paloaltonetworks/scm-go/validate"
+)
+
+func TestGetAPIClient_UsesClientSettings(t *testing.T) {
+	var got *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[],"limit":200,"offset":0,"total":0}`))
+	}))
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	c := &Client{
+		ClientId:             "client-id",
+		ClientSecret:         "client-secret",
+		Scope:                "tsg_id:1234567890",
+		Host:                 host,
+		Port:                 portNum,
+		Protocol:             "http",
+		Headers:              map[string]string{"X-Custom": "custom-value"},
+		Agent:                "my-agent/1.0",
+		SkipLoggingTransport: true,
+		Jwt:                  "test-jwt",
+		JwtExpiresAt:         time.Now().Add(time.Hour),
+	}
+	require.NoError(t, c.Setup())
+
+	apiClient := GetObjectsAPIClient(c)
+
+	cfg := apiClient.GetConfig()
+	assert.Equal(t, "http", cfg.Scheme)
+	assert.Equal(t, host+":"+port, cfg.Host)
+	assert.Equal(t, "http://"+host+":"+port+"/config/objects/v1", cfg.Servers[0].URL)
+	assert.Equal(t, "http://"+host+":"+port+"/sse/config/v1", cfg.Servers[1].URL)
+
+	_, _, err = apiClient.AddressesAPI.ListAddresses(context.Background()).Folder("Shared").Execute()
+	require.NoError(t, err)
+
+	require.NotNil(t, got)
+	assert.Equal(t, "/config/objects/v1/addresses", got.URL.Path)
+	assert.Equal(t, "custom-value", got.Header.Get("X-Custom"))
+	assert.Equal(t, "my-agent/1.0", got.Header.Get("User-Agent"))
+	assert.Equal(t, "Bearer test-jwt", got.Header.Get("Authorization"))
+}
+
+func TestGetAPIClient_Defaults(t *testing.T) {
+	c := &Client{
+		ClientId:     "client-id",
+		ClientSecret: "client-secret",
+		Scope:        "tsg_id:1234567890",
+	}
+	require.NoError(t, c.Setup())
+
+	cfg := GetSecurityServicesAPIClient(c).GetConfig()
+	assert.Equal(t, "https", cfg.Scheme)
+	assert.Equal(t, "api.strata.paloaltonetworks.com", cfg.Host)
+	assert.Equal(t, "https://api.strata.paloaltonetworks.com/config/security/v1", cfg.Servers[0].URL)
+	assert.Equal(t, "OpenAPI-Generator/1.0.0/go", cfg.UserAgent)
+}
+
+func TestGetAPIClient_ValidateRequests(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true, ValidateRequests: true})
+	require.NoError(t, c.RefreshJwt(ctx))
+
+	a := objects.NewAddresses("", "web")
+	a.SetFolder("Shared")
+	a.SetIpNetmask("10.0.0.1/32")
+	a.SetFqdn("web.example.com")
+
+	_, _, err := GetObjectsAPIClient(c).AddressesAPI.CreateAddresses(ctx).Addresses(*a).Execute()
+	var errs validate.Errors
+	require.True(t, errors.As(err, &errs), "%v", err)
+	assert.ErrorContains(t, err, "fqdn: cannot be set along with ip_netmask")
+
+	_, err = c.Do(ctx, http.MethodPost, "/config/objects/v1/addresses", nil, a, nil)
+	assert.EqualError(t, err, "fqdn: cannot be set along with ip_netmask")
+	assert.Empty(t, srv.Objects("/config/objects/v1/addresses"))
+
+	a.Fqdn = nil
+	_, _, err = GetObjectsAPIClient(c).AddressesAPI.CreateAddresses(ctx).Addresses(*a).Execute()
+	require.NoError(t, err)
+	assert.Len(t, srv.Objects("/config/objects/v1/addresses"), 1)
+}
+
+func TestGetAPIClient_Scope(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+	srv.Add("/config/objects/v1/addresses", map[string]interface{}{"name": "web", "folder": "Shared", "ip_netmask": "10.0.0.1/32"})
+	srv.Add("/config/objects/v1/addresses", map[string]interface{}{"name": "web", "snippet": "Texas", "ip_netmask": "10.0.0.2/32"})
+
+	ctx := context.Background()
+	c := newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true})
+	require.NoError(t, c.RefreshJwt(ctx))
+	addrs := GetObjectsAPIClient(c).AddressesAPI
+
+	list, _, err := addrs.ListAddresses(ctx).Folder("Shared").Scope(api.Snippet("Texas")).Execute()
+	require.NoError(t, err)
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, "10.0.0.2/32", list.Data[0].GetIpNetmask())
+
+	web, err := addrs.FetchAddressesInScope(ctx, "web", api.Folder("Shared"))
+	require.NoError(t, err)
+	require.NotNil(t, web)
+	assert.Equal(t, "10.0.0.1/32", web.GetIpNetmask())
+
+	_, err = addrs.FetchAddressesInScope(ctx, "web", api.Scope{})
+	assert.Error(t, err)
+
+	scope, err := web.GetScope()
+	require.NoError(t, err)
+	assert.Equal(t, api.Folder("Shared"), scope)
+
+	require.NoError(t, web.SetScope(api.Device("007951000388704")))
+	assert.Nil(t, web.Folder)
+	assert.Equal(t, "007951000388704", web.GetDevice())
+
+	web.SetFolder("Shared")
+	_, err = web.GetScope()
+	assert.EqualError(t, err, "scope must have exactly one of folder, snippet or device, got 2")
+
+	// The zero Scope would silently clear the containers.
+	assert.EqualError(t, web.SetScope(api.Folder("")), "scope must have exactly one of folder, snippet or device, got 0")
+	assert.Equal(t, "Shared", web.GetFolder())
+	_, _, err = addrs.ListAddresses(ctx).Scope(api.Scope{}).Execute()
+	assert.ErrorContains(t, err, "scope must have exactly one of folder, snippet or device, got 0")
+	for _, err := range addrs.ListAddresses(ctx).Scope(api.Scope{}).All() {
+		assert.EqualError(t, err, "scope must have exactly one of folder, snippet or device, got 0")
+	}
+}
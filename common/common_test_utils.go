@@ -15,6 +15,8 @@ import (
 	"runtime"
 	"strings"
 	"time"
+
+	"github.com/paloaltonetworks/scm-go/api"
 )
 
 // LoggingRoundTripper Custom HTTP client that logs requests as curl commands
@@ -85,7 +87,7 @@ func printCurlCommand(req *http.Request) {
 			// Restore the body for the actual request
 			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
 			// Add to curl command
-			curlCmd = append(curlCmd, "-d", fmt.Sprintf("'%s'", string(bodyBytes)))
+			curlCmd = append(curlCmd, "-d", fmt.Sprintf("'%s'", api.RedactBody(req.Header.Get("Content-Type"), bodyBytes)))
 		}
 	}
 
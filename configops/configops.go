@@ -0,0 +1,49 @@
+/*
+Package configops provides high level configuration operations on top of the
+config_operations API: pushing the candidate configuration and waiting for
+the resulting jobs to finish, rolling back to a previous configuration
+version, and comparing the objects of the candidate configuration with the
+running one, or of two versions.
+
+	client := &scm.Client{AuthFile: "scm-config.json"}
+	if err := client.Setup(); err != nil {
+		return err
+	}
+	ops := configops.New(scm.NewSDK(client))
+
+	job, err := ops.Push(ctx, []string{"Mobile Users"}, configops.PushOptions{
+		Description: "Nightly sync",
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := job.Wait(ctx); err != nil {
+		var pe *configops.PushError
+		if errors.As(err, &pe) {
+			for _, f := range pe.Failures {
+				log.Printf("%s: %s", f.DeviceName, f.Errors)
+			}
+		}
+		return err
+	}
+*/
+package configops
+
+import (
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/generated/config_operations"
+)
+
+// Client performs configuration operations.
+type Client struct {
+	sdk *scm.SDK
+	api *config_operations.APIClient
+}
+
+// New returns a Client using the API clients of sdk.
+func New(sdk *scm.SDK) *Client {
+	return &Client{
+		sdk: sdk,
+		api: sdk.ConfigOperations(),
+	}
+}
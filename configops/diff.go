@@ -0,0 +1,286 @@
+package configops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// DefaultResources are the resources snapshotted when none are given.
+var DefaultResources = []string{
+	"/config/objects/v1/addresses",
+	"/config/objects/v1/address-groups",
+	"/config/objects/v1/services",
+	"/config/objects/v1/service-groups",
+	"/config/objects/v1/tags",
+	"/config/objects/v1/application-groups",
+	"/config/objects/v1/application-filters",
+	"/config/security/v1/security-rules",
+}
+
+// rulebases are the resources of rules listed one position at a time,
+// before ("pre") or after ("post") the rules of the child folders.
+var rulebases = map[string]bool{
+	"/config/identity/v1/authentication-rules": true,
+	"/config/network/v1/nat-rules":             true,
+	"/config/network/v1/qos-policy-rules":      true,
+	"/config/security/v1/app-override-rules":   true,
+	"/config/security/v1/decryption-rules":     true,
+	"/config/security/v1/security-rules":       true,
+}
+
+// rulePositions are the positions the rules of rulebases are listed at.
+var rulePositions = []string{"pre", "post"}
+
+// Snapshot is the state of the objects of a folder in the candidate
+// configuration.
+//
+// Snapshots can be saved as JSON, for example to compare the candidate
+// configuration with a snapshot taken right after the last push.
+type Snapshot struct {
+	Folder string
+
+	// Objects holds the objects of each resource, keyed by resource path,
+	// then by object name.
+	Objects map[string]map[string]map[string]interface{}
+}
+
+// Snapshot fetches the objects of the given resources (API paths such as
+// "/config/objects/v1/addresses") in folder.  DefaultResources are fetched
+// if none are given.
+//
+// Rules, such as security rules, are fetched at both the "pre" and "post"
+// positions, their "position" field telling which.
+func (c *Client) Snapshot(ctx context.Context, folder string, resources ...string) (*Snapshot, error) {
+	if len(resources) == 0 {
+		resources = DefaultResources
+	}
+
+	s := &Snapshot{
+		Folder:  folder,
+		Objects: make(map[string]map[string]map[string]interface{}, len(resources)),
+	}
+	for _, res := range resources {
+		positions := []string{""}
+		if rulebases[res] {
+			positions = rulePositions
+		}
+
+		objs := make(map[string]map[string]interface{})
+		for _, pos := range positions {
+			for obj, err := range c.listObjects(ctx, folder, res, pos) {
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", res, err)
+				}
+				if pos != "" {
+					obj["position"] = pos
+				}
+				name, _ := obj["name"].(string)
+				objs[name] = obj
+			}
+		}
+		s.Objects[res] = objs
+	}
+	return s, nil
+}
+
+// listObjects iterates over the objects of resource in folder, at position
+// if not empty.
+func (c *Client) listObjects(ctx context.Context, folder, resource, position string) iter.Seq2[map[string]interface{}, error] {
+	return api.Paginate(ctx, func(ctx context.Context, offset, limit int32) ([]map[string]interface{}, int32, error) {
+		q := url.Values{}
+		q.Set("folder", folder)
+		if position != "" {
+			q.Set("position", position)
+		}
+		q.Set("offset", strconv.Itoa(int(offset)))
+		q.Set("limit", strconv.Itoa(int(limit)))
+
+		var page struct {
+			Data  []map[string]interface{} `json:"data"`
+			Total int32                    `json:"total"`
+		}
+		if _, err := c.sdk.Client().Do(ctx, http.MethodGet, resource, q, nil, &page); err != nil {
+			return nil, 0, err
+		}
+		return page.Data, page.Total, nil
+	})
+}
+
+// ChangeType is the kind of a Change.
+type ChangeType string
+
+// Kinds of changes.
+const (
+	Added    ChangeType = "added"
+	Removed  ChangeType = "removed"
+	Modified ChangeType = "modified"
+)
+
+// Change is an object that differs between two snapshots.
+type Change struct {
+	Resource string
+	Name     string
+	Type     ChangeType
+
+	// Fields are the top level fields that differ, for modified objects.
+	Fields []string
+
+	// Before and After are the object in each snapshot, nil if it is
+	// absent from it.
+	Before map[string]interface{}
+	After  map[string]interface{}
+}
+
+func (c Change) String() string {
+	if c.Type == Modified {
+		return fmt.Sprintf("%s %s %s (%s)", c.Type, c.Resource, c.Name, strings.Join(c.Fields, ", "))
+	}
+	return fmt.Sprintf("%s %s %s", c.Type, c.Resource, c.Name)
+}
+
+// Diff is the list of changes between two snapshots, ordered by resource and
+// object name.
+type Diff []Change
+
+// ignoredFields are the fields not compared between snapshots.
+var ignoredFields = map[string]bool{
+	"id": true,
+}
+
+// Compare returns the changes from snapshot from to snapshot to.
+func Compare(from, to *Snapshot) Diff {
+	var ans Diff
+
+	resources := make(map[string]bool)
+	for res := range from.Objects {
+		resources[res] = true
+	}
+	for res := range to.Objects {
+		resources[res] = true
+	}
+
+	for res := range resources {
+		before, after := from.Objects[res], to.Objects[res]
+		for name, b := range before {
+			a, ok := after[name]
+			if !ok {
+				ans = append(ans, Change{Resource: res, Name: name, Type: Removed, Before: b})
+				continue
+			}
+			if fields := changedFields(b, a); len(fields) != 0 {
+				ans = append(ans, Change{Resource: res, Name: name, Type: Modified, Fields: fields, Before: b, After: a})
+			}
+		}
+		for name, a := range after {
+			if _, ok := before[name]; !ok {
+				ans = append(ans, Change{Resource: res, Name: name, Type: Added, After: a})
+			}
+		}
+	}
+
+	slices.SortFunc(ans, func(a, b Change) int {
+		if c := strings.Compare(a.Resource, b.Resource); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return ans
+}
+
+// changedFields returns the sorted top level fields that differ between a
+// and b.
+func changedFields(a, b map[string]interface{}) []string {
+	var ans []string
+	for k, v := range a {
+		if w, ok := b[k]; !ignoredFields[k] && (!ok || !reflect.DeepEqual(v, w)) {
+			ans = append(ans, k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ignoredFields[k] && !ok {
+			ans = append(ans, k)
+		}
+	}
+	slices.Sort(ans)
+	return ans
+}
+
+// DiffCandidate returns the changes made to the candidate configuration
+// since running, a snapshot of the running configuration, by snapshotting the
+// same folder and resources.  Nothing is loaded: the candidate configuration
+// is left as is.
+//
+// Take the running snapshot while the candidate configuration is the running
+// one, such as right after a successful push:
+//
+//	if _, err := job.Wait(ctx); err != nil {
+//		return err
+//	}
+//	running, err := ops.Snapshot(ctx, "Mobile Users")
+//
+//	// Later on, with changes made to the candidate configuration.
+//	diff, err := ops.DiffCandidate(ctx, running)
+func (c *Client) DiffCandidate(ctx context.Context, running *Snapshot) (Diff, error) {
+	resources := slices.Sorted(maps.Keys(running.Objects))
+	candidate, err := c.Snapshot(ctx, running.Folder, resources...)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(running, candidate), nil
+}
+
+// ErrDiscardsCandidate is returned by DiffVersions unless its
+// DiscardCandidate option is set.
+var ErrDiscardsCandidate = errors.New("comparing versions replaces the candidate configuration, set DiscardCandidate to allow it")
+
+// DiffVersionsOptions are the options of DiffVersions.
+type DiffVersionsOptions struct {
+	// Resources are the resources compared, DefaultResources if empty.
+	Resources []string
+
+	// DiscardCandidate allows DiffVersions to replace the candidate
+	// configuration, losing any change not pushed yet.
+	DiscardCandidate bool
+}
+
+// DiffVersions returns the changes made to the objects of folder between
+// configuration versions from and to, by loading and snapshotting each.
+//
+// This replaces the candidate configuration: version to is left loaded, and
+// any change not pushed yet is lost.  DiffVersions fails with
+// ErrDiscardsCandidate unless opts.DiscardCandidate is set.  Use
+// DiffCandidate to compare the candidate configuration with the running one
+// instead.
+func (c *Client) DiffVersions(ctx context.Context, folder string, from, to int32, opts DiffVersionsOptions) (Diff, error) {
+	if !opts.DiscardCandidate {
+		return nil, ErrDiscardsCandidate
+	}
+
+	snap := func(version int32) (*Snapshot, error) {
+		if err := c.Load(ctx, version); err != nil {
+			return nil, fmt.Errorf("loading version %d: %w", version, err)
+		}
+		return c.Snapshot(ctx, folder, opts.Resources...)
+	}
+
+	before, err := snap(from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := snap(to)
+	if err != nil {
+		return nil, err
+	}
+	return Compare(before, after), nil
+}
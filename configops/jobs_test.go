@@ -0,0 +1,106 @@
+package configops_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/generated/config_operations"
+)
+
+func TestJobs_Accessors(t *testing.T) {
+	var j config_operations.Jobs
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "115", "parent_id": "114", "device_name": "fw1",
+		"job_status": "2", "job_result": "2", "job_type": "53",
+		"status_str": "PUSHFAIL", "result_str": "FAIL", "type_str": "CommitAndPush",
+		"percent": "100", "summary": "", "uname": "admin@example.com",
+		"start_ts": "2024-01-12 08:19:52", "end_ts": "2024-01-12T08:21:07Z"
+	}`), &j))
+
+	assert.Equal(t, config_operations.JobStatusFinished, j.Status())
+	assert.Equal(t, config_operations.JobResultFail, j.Result())
+	assert.True(t, j.Done())
+	assert.True(t, j.Failed())
+
+	p, ok := j.PercentComplete()
+	assert.True(t, ok)
+	assert.Equal(t, 100, p)
+
+	start, err := j.StartTime()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 12, 8, 19, 52, 0, time.UTC), start)
+	end, err := j.EndTime()
+	require.NoError(t, err)
+	assert.Equal(t, 75*time.Second, end.Sub(start))
+}
+
+func TestJobs_Status(t *testing.T) {
+	for _, tc := range []struct {
+		statusStr, jobStatus string
+		want                 config_operations.JobStatus
+	}{
+		{"PEND", "", config_operations.JobStatusPending},
+		{"ACT", "", config_operations.JobStatusActive},
+		{"PUSHSENT", "", config_operations.JobStatusActive},
+		{"FIN", "", config_operations.JobStatusFinished},
+		{"PUSHTIMEOUT", "", config_operations.JobStatusFinished},
+		{"", "1", config_operations.JobStatusActive},
+		{"", "2", config_operations.JobStatusFinished},
+		{"", "", config_operations.JobStatusUnknown},
+	} {
+		j := config_operations.Jobs{StatusStr: tc.statusStr, JobStatus: tc.jobStatus}
+		assert.Equal(t, tc.want, j.Status(), "%q/%q", tc.statusStr, tc.jobStatus)
+	}
+
+	running := config_operations.Jobs{StatusStr: "ACT", Percent: "n/a"}
+	assert.False(t, running.Failed())
+	_, ok := running.PercentComplete()
+	assert.False(t, ok)
+	end, err := running.EndTime()
+	assert.NoError(t, err)
+	assert.True(t, end.IsZero())
+
+	invalid := config_operations.Jobs{StartTs: "yesterday"}
+	_, err = invalid.StartTime()
+	assert.Error(t, err)
+}
+
+func TestReadConfigVersionTimes(t *testing.T) {
+	resp := &http.Response{Body: io.NopCloser(strings.NewReader(`{"data": [{
+		"admin": "admin@example.com", "description": "", "id": 7, "scope": "Mobile Users",
+		"version": "v7", "date": "2024-01-12T08:19:52Z",
+		"created": 1705047592, "updated": 1705047659123, "deleted": 0
+	}], "limit": 200, "offset": 0, "total": 1}`))}
+
+	times, err := config_operations.ReadConfigVersionTimes(resp)
+	require.NoError(t, err)
+	require.Contains(t, times, int32(7))
+	assert.Equal(t, time.Unix(1705047592, 0).UTC(), times[7].Created)
+	assert.Equal(t, time.UnixMilli(1705047659123).UTC(), times[7].Updated)
+	assert.True(t, times[7].Deleted.IsZero())
+
+	// The body is left for the caller.
+	var list config_operations.ConfigVersionsListResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+	require.Len(t, list.Data, 1)
+
+	// The float32 field is minutes off.
+	assert.Greater(t, time.Unix(int64(list.Data[0].Created), 0).Sub(times[7].Created).Abs(), time.Second)
+
+	resp = &http.Response{Body: io.NopCloser(strings.NewReader(`[{"id": 8, "created": 1705047592.5, "updated": 1705047592.5, "deleted": 1705047600}]`))}
+	times, err = config_operations.ReadConfigVersionTimes(resp)
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMilli(1705047592500).UTC(), times[8].Created)
+	assert.Equal(t, time.Unix(1705047600, 0).UTC(), times[8].Deleted)
+
+	resp = &http.Response{Body: io.NopCloser(strings.NewReader(`{"id": 9, "created": "yesterday"}`))}
+	_, err = config_operations.ReadConfigVersionTimes(resp)
+	assert.Error(t, err)
+}
@@ -0,0 +1,317 @@
+package configops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	"github.com/paloaltonetworks/scm-go/generated/config_operations"
+)
+
+// Default polling intervals of Job.Wait.
+const (
+	DefaultPollInterval    = 2 * time.Second
+	DefaultMaxPollInterval = 30 * time.Second
+	DefaultChildTimeout    = time.Minute
+)
+
+// PushOptions are the options of a push.
+type PushOptions struct {
+	// Description of the changes being pushed.
+	Description string
+
+	// Admin restricts the push to the changes of these administrators or
+	// service accounts.
+	Admin []string
+
+	// Devices are the serial numbers of the firewalls to push to.
+	Devices []string
+
+	// PollInterval is how long Wait first waits between polls.  It grows
+	// with each poll up to MaxPollInterval.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+
+	// ChildTimeout is how long Wait keeps polling for the child jobs of a
+	// finished job to appear, DefaultChildTimeout if not set.
+	ChildTimeout time.Duration
+
+	// Progress, if set, is called by Wait with the status of each poll.
+	Progress func(Status)
+}
+
+// Push pushes the candidate configuration of the given folders and returns
+// the push job.  Use Job.Wait to wait for it to finish.
+func (c *Client) Push(ctx context.Context, folders []string, opts PushOptions) (*Job, error) {
+	req := config_operations.NewPushCandidateConfigVersionsRequest()
+	if len(folders) != 0 {
+		req.SetFolder(folders)
+	}
+	if opts.Description != "" {
+		req.SetDescription(opts.Description)
+	}
+	if len(opts.Admin) != 0 {
+		req.SetAdmin(opts.Admin)
+	}
+	if len(opts.Devices) != 0 {
+		// Serial numbers do not fit in the float32 of the generated model,
+		// and may start with zeros: send them as strings.
+		req.AdditionalProperties = map[string]interface{}{"devices": opts.Devices}
+	}
+
+	resp, err := c.api.ConfigVersionsAPI.PushCandidateConfigVersions(ctx).PushCandidateConfigVersionsRequest(*req).Execute()
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var ans struct {
+		JobID json.RawMessage `json:"job_id"`
+	}
+	if err := json.Unmarshal(body, &ans); err != nil {
+		return nil, fmt.Errorf("invalid push response: %w", err)
+	}
+	id := strings.Trim(string(ans.JobID), `"`)
+	if id == "" || id == "null" {
+		return nil, fmt.Errorf("push response has no job ID: %s", body)
+	}
+
+	return c.Job(id, opts), nil
+}
+
+// Job returns a handle on the existing push job id, for example to resume
+// waiting on it.  Only the polling and progress options apply.
+func (c *Client) Job(id string, opts PushOptions) *Job {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.MaxPollInterval <= 0 {
+		opts.MaxPollInterval = DefaultMaxPollInterval
+	}
+	if opts.MaxPollInterval < opts.PollInterval {
+		opts.MaxPollInterval = opts.PollInterval
+	}
+	if opts.ChildTimeout <= 0 {
+		opts.ChildTimeout = DefaultChildTimeout
+	}
+	return &Job{id: id, c: c, opts: opts}
+}
+
+// Job is a push job, along with the per-device jobs it spawned.
+type Job struct {
+	id   string
+	c    *Client
+	opts PushOptions
+}
+
+// ID returns the ID of the job.
+func (j *Job) ID() string { return j.id }
+
+// Poll fetches the current status of the job and of its child jobs, going
+// through every page of the job list.
+func (j *Job) Poll(ctx context.Context) (Status, error) {
+	res, _, err := j.c.api.JobsAPI.GetJobsByID(ctx, j.id).Execute()
+	if err != nil {
+		return Status{}, err
+	}
+	if len(res.Data) == 0 {
+		return Status{}, fmt.Errorf("job %s not found", j.id)
+	}
+	s := Status{Job: res.Data[0], Expected: max(len(j.opts.Devices), 1)}
+
+	// The generated ListJobs request takes no offset and limit.
+	jobs := api.Paginate(ctx, func(ctx context.Context, offset, limit int32) ([]config_operations.Jobs, int32, error) {
+		q := url.Values{}
+		q.Set("offset", strconv.Itoa(int(offset)))
+		q.Set("limit", strconv.Itoa(int(limit)))
+
+		var page config_operations.JobsListResponse
+		if _, err := j.c.sdk.Client().Do(ctx, http.MethodGet, "/config/operations/v1/jobs", q, nil, &page); err != nil {
+			return nil, 0, err
+		}
+		return page.Data, page.Total, nil
+	})
+	for child, err := range jobs {
+		if err != nil {
+			return s, err
+		}
+		if child.ParentId == j.id && child.Id != j.id {
+			s.Children = append(s.Children, child)
+		}
+	}
+
+	return s, nil
+}
+
+// Wait polls the job until it and all of its child jobs are done, or until
+// ctx is done.  Child jobs may only be listed after their parent finished:
+// Wait keeps polling until the expected child jobs are present, for at most
+// ChildTimeout after the job finished.
+//
+// It returns the last status polled, along with a *PushError if any job
+// failed.
+func (j *Job) Wait(ctx context.Context) (Status, error) {
+	delay := j.opts.PollInterval
+	var finished time.Time
+	for {
+		s, err := j.Poll(ctx)
+		if err != nil {
+			return s, err
+		}
+		if j.opts.Progress != nil {
+			j.opts.Progress(s)
+		}
+		if s.Done() {
+			return s, s.Err()
+		}
+		if s.Job.Done() && s.childrenDone() {
+			// Only child jobs are missing.
+			if finished.IsZero() {
+				finished = time.Now()
+			} else if time.Since(finished) >= j.opts.ChildTimeout {
+				return s, s.Err()
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return s, ctx.Err()
+		case <-timer.C:
+		}
+		delay = min(delay*3/2, j.opts.MaxPollInterval)
+	}
+}
+
+// Status is the status of a push job and of its child jobs.
+type Status struct {
+	Job      config_operations.Jobs
+	Children []config_operations.Jobs
+
+	// Expected is the number of child jobs expected: one per device pushed
+	// to, or at least one.
+	Expected int
+}
+
+// Done returns whether the job and all of its child jobs are finished, the
+// expected child jobs being present unless the job failed.
+func (s Status) Done() bool {
+	if !s.Job.Done() || !s.childrenDone() {
+		return false
+	}
+	return s.Job.Failed() || len(s.Children) >= s.Expected
+}
+
+// childrenDone returns whether the child jobs listed are finished.
+func (s Status) childrenDone() bool {
+	for _, child := range s.Children {
+		if !child.Done() {
+			return false
+		}
+	}
+	return true
+}
+
+// Percent returns the completion percentage of the job.
+func (s Status) Percent() int {
+	p, _ := s.Job.PercentComplete()
+	return p
+}
+
+// Err returns a *PushError listing the failed jobs, if any.
+func (s Status) Err() error {
+	e := &PushError{JobID: s.Job.Id}
+	for _, child := range s.Children {
+		if child.Failed() {
+			e.Failures = append(e.Failures, newDeviceFailure(child))
+		}
+	}
+	if len(e.Failures) == 0 && s.Job.Failed() {
+		e.Failures = append(e.Failures, newDeviceFailure(s.Job))
+	}
+
+	if len(e.Failures) == 0 {
+		return nil
+	}
+	return e
+}
+
+// PushError is returned when a push job, or any of the jobs pushing to a
+// device, failed.
+type PushError struct {
+	JobID    string
+	Failures []DeviceFailure
+}
+
+func (e *PushError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "push job %s failed", e.JobID)
+	for i, f := range e.Failures {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(f.String())
+	}
+	return b.String()
+}
+
+// DeviceFailure is a failed job.
+type DeviceFailure struct {
+	JobID      string
+	DeviceName string
+	Status     string
+	Result     config_operations.JobResult
+	Summary    string
+
+	// Details is the raw details of the job, and Errors the errors listed
+	// in them.
+	Details string
+	Errors  []string
+}
+
+func newDeviceFailure(j config_operations.Jobs) DeviceFailure {
+	f := DeviceFailure{
+		JobID:      j.Id,
+		DeviceName: j.DeviceName,
+		Status:     j.StatusStr,
+		Result:     j.Result(),
+		Summary:    j.Summary,
+		Details:    j.GetDetails(),
+	}
+
+	var details struct {
+		Errors []string `json:"errors"`
+	}
+	if json.Unmarshal([]byte(f.Details), &details) == nil {
+		f.Errors = details.Errors
+	}
+	return f
+}
+
+func (f DeviceFailure) String() string {
+	name := f.DeviceName
+	if name == "" {
+		name = "job " + f.JobID
+	}
+	switch {
+	case len(f.Errors) != 0:
+		return fmt.Sprintf("%s: %s", name, strings.Join(f.Errors, ", "))
+	case f.Summary != "":
+		return fmt.Sprintf("%s: %s", name, f.Summary)
+	}
+	return fmt.Sprintf("%s: %s %s", name, f.Status, f.Result)
+}
@@ -0,0 +1,231 @@
+package configops_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/configops"
+)
+
+// jobServer serves a push job "100" whose child jobs for fw1 and fw2 are only
+// listed once it finished, on the second page of at most 200 jobs, and finish
+// at the next poll, fw2's child failing if fail is set.
+type jobServer struct {
+	*httptest.Server
+	fail bool
+
+	mu     sync.Mutex
+	polls  int
+	push   map[string]interface{}
+	loaded []int
+}
+
+// versions are the addresses of each configuration version.
+var versions = map[int][]map[string]interface{}{
+	5: {
+		{"id": "1", "name": "web", "folder": "Mobile Users", "ip_netmask": "10.0.0.1/32"},
+		{"id": "2", "name": "db", "folder": "Mobile Users", "ip_netmask": "10.0.0.2/32"},
+	},
+	7: {
+		{"id": "1", "name": "web", "folder": "Mobile Users", "ip_netmask": "10.0.0.10/32", "description": "moved"},
+		{"id": "3", "name": "cache", "folder": "Mobile Users", "fqdn": "cache.example.com"},
+	},
+}
+
+func newJobServer(t *testing.T, fail bool) *jobServer {
+	t.Helper()
+
+	s := &jobServer{fail: fail}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func job(id, parent, device, status, result, percent, details string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": id, "parent_id": parent, "device_name": device,
+		"status_str": status, "result_str": result, "percent": percent,
+		"details": details, "job_status": "", "job_result": "", "job_type": "53",
+		"type_str": "CommitAndPush", "start_ts": "", "end_ts": "", "summary": "", "uname": "admin@example.com",
+	}
+}
+
+func (s *jobServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/config/operations/v1/config-versions/candidate:push":
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &s.push)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"success":true,"job_id":"100","message":"CommitAndPush job enqueued with jobid 100"}`))
+	case "/config/operations/v1/jobs/100":
+		s.polls++
+		parent := job("100", "0", "", "ACT", "PEND", "50", "")
+		if s.polls >= 3 {
+			parent = job("100", "0", "", "FIN", "OK", "100", "")
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{parent}})
+	case "/config/operations/v1/jobs":
+		var jobs []interface{}
+		for i := range 250 {
+			jobs = append(jobs, job(strconv.Itoa(i), "0", "", "FIN", "OK", "100", ""))
+		}
+		switch {
+		case s.polls == 3:
+			jobs = append(jobs,
+				job("101", "100", "fw1", "PUSHSENT", "PEND", "0", ""),
+				job("102", "100", "fw2", "PUSHSENT", "PEND", "0", ""))
+		case s.polls > 3:
+			fw2 := job("102", "100", "fw2", "FIN", "OK", "100", "")
+			if s.fail {
+				fw2 = job("102", "100", "fw2", "PUSHFAIL", "FAIL", "100",
+					`{"errors":["Config push aborted, error: Failed to handle VPN clusters"]}`)
+			}
+			jobs = append(jobs, job("101", "100", "fw1", "FIN", "OK", "100", ""), fw2)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		offset = min(offset, len(jobs))
+		end := min(offset+200, len(jobs))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": jobs[offset:end], "limit": 200, "offset": offset, "total": len(jobs),
+		})
+	case "/config/operations/v1/config-versions/running":
+		w.Write([]byte(`{"data":[
+			{"date":"2024-01-12T08:19:52Z","device":"Mobile Users","version":5},
+			{"date":"2024-01-13T08:19:52Z","device":"Mobile Users","version":7},
+			{"date":"2024-01-13T08:19:52Z","device":"7951000388704","version":3}
+		]}`))
+	case "/config/operations/v1/config-versions:load":
+		var body struct {
+			Version int `json:"version"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.loaded = append(s.loaded, body.Version)
+		w.Write([]byte(`{}`))
+	case "/config/objects/v1/addresses":
+		data := []map[string]interface{}{}
+		if len(s.loaded) != 0 {
+			data = versions[s.loaded[len(s.loaded)-1]]
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data, "limit": 200, "offset": 0, "total": len(data)})
+	case "/config/security/v1/security-rules":
+		data := []map[string]interface{}{}
+		switch r.URL.Query().Get("position") {
+		case "pre":
+			data = append(data, map[string]interface{}{"id": "4", "name": "allow-web", "folder": "Mobile Users", "action": "allow"})
+		case "post":
+			data = append(data, map[string]interface{}{"id": "5", "name": "deny-all", "folder": "Mobile Users", "action": "deny"})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data, "limit": 200, "offset": 0, "total": len(data)})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newOps(t *testing.T, srv *httptest.Server) *configops.Client {
+	t.Helper()
+
+	c := &scm.Client{
+		Host:                 strings.TrimPrefix(srv.URL, "http://"),
+		Protocol:             "http",
+		TokenSource:          oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "jwt"}),
+		SkipLoggingTransport: true,
+	}
+	require.NoError(t, c.Setup())
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	return configops.New(scm.NewSDK(c))
+}
+
+func TestPush_Wait(t *testing.T) {
+	srv := newJobServer(t, false)
+	ops := newOps(t, srv.Server)
+	ctx := context.Background()
+
+	var progress []int
+	job, err := ops.Push(ctx, []string{"Mobile Users"}, configops.PushOptions{
+		Description:  "test",
+		Devices:      []string{"7951000388704", "007951000123456"},
+		PollInterval: time.Millisecond,
+		Progress:     func(s configops.Status) { progress = append(progress, s.Percent()) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "100", job.ID())
+
+	// Serial numbers are sent as is.
+	assert.Equal(t, []interface{}{"Mobile Users"}, srv.push["folder"])
+	assert.Equal(t, []interface{}{"7951000388704", "007951000123456"}, srv.push["devices"])
+
+	s, err := job.Wait(ctx)
+	require.NoError(t, err)
+	assert.True(t, s.Done())
+	assert.Len(t, s.Children, 2)
+	assert.Equal(t, []int{50, 50, 100, 100}, progress)
+}
+
+func TestPush_DeviceFailure(t *testing.T) {
+	srv := newJobServer(t, true)
+	ops := newOps(t, srv.Server)
+	ctx := context.Background()
+
+	job, err := ops.Push(ctx, []string{"Mobile Users"}, configops.PushOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+
+	_, err = job.Wait(ctx)
+	var pe *configops.PushError
+	require.True(t, errors.As(err, &pe), "%v", err)
+	assert.Equal(t, "100", pe.JobID)
+	require.Len(t, pe.Failures, 1)
+	assert.Equal(t, "fw2", pe.Failures[0].DeviceName)
+	assert.Equal(t, []string{"Config push aborted, error: Failed to handle VPN clusters"}, pe.Failures[0].Errors)
+	assert.Contains(t, err.Error(), "fw2: Config push aborted")
+}
+
+func TestJob_WaitCanceled(t *testing.T) {
+	srv := newJobServer(t, false)
+	ops := newOps(t, srv.Server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job := ops.Job("100", configops.PushOptions{
+		PollInterval: time.Hour,
+		Progress:     func(configops.Status) { cancel() },
+	})
+
+	s, err := job.Wait(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, s.Done())
+}
+
+func TestJob_WaitChildTimeout(t *testing.T) {
+	srv := newJobServer(t, false)
+	ops := newOps(t, srv.Server)
+
+	// The job finishes at the third poll, but its two child jobs never show
+	// up for a third device.
+	job := ops.Job("100", configops.PushOptions{
+		Devices:      []string{"fw1", "fw2", "fw3"},
+		PollInterval: time.Millisecond,
+		ChildTimeout: 20 * time.Millisecond,
+	})
+	s, err := job.Wait(context.Background())
+	require.NoError(t, err)
+	assert.False(t, s.Done())
+	assert.Len(t, s.Children, 2)
+	assert.Equal(t, 3, s.Expected)
+}
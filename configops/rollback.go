@@ -0,0 +1,120 @@
+package configops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/paloaltonetworks/scm-go/generated/config_operations"
+)
+
+// ErrNoRunningVersion is returned when a folder or firewall has no running
+// configuration version.
+var ErrNoRunningVersion = errors.New("no running configuration version")
+
+// RunningVersions returns the running configuration version of each folder
+// and firewall, keyed by folder name or firewall serial number.
+//
+// The running version of a scope is the last version successfully pushed to it.
+func (c *Client) RunningVersions(ctx context.Context) (map[string]config_operations.RunningVersions, error) {
+	res, _, err := c.api.ConfigVersionsAPI.GetRunningConfigVersions(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make(map[string]config_operations.RunningVersions, len(res.Data))
+	for _, v := range res.Data {
+		if cur, ok := ans[v.Device]; !ok || v.Version > cur.Version {
+			ans[v.Device] = v
+		}
+	}
+	return ans, nil
+}
+
+// RunningVersion returns the running configuration version of scope, a
+// folder name or a firewall serial number.
+func (c *Client) RunningVersion(ctx context.Context, scope string) (config_operations.RunningVersions, error) {
+	versions, err := c.RunningVersions(ctx)
+	if err != nil {
+		return config_operations.RunningVersions{}, err
+	}
+	v, ok := versions[scope]
+	if !ok {
+		return v, fmt.Errorf("%s: %w", scope, ErrNoRunningVersion)
+	}
+	return v, nil
+}
+
+// Load replaces the candidate configuration with the given version.
+func (c *Client) Load(ctx context.Context, version int32) error {
+	lc := config_operations.NewLoadConfig()
+	lc.SetVersion(version)
+	_, err := c.api.ConfigVersionsAPI.LoadConfigVersions(ctx).LoadConfig(*lc).Execute()
+	return err
+}
+
+// RollbackOptions are the options of a rollback.
+type RollbackOptions struct {
+	// Version to roll back to.  If zero, the running version of the scope
+	// is used, discarding the changes made to the candidate configuration
+	// since the last push.
+	Version int32
+
+	// Push pushes the version once loaded, and Wait then waits for the push
+	// to finish.
+	Push bool
+	Wait bool
+
+	// PushOptions are the options of the push.  The folder or device
+	// pushed to is the scope of the rollback.
+	PushOptions PushOptions
+}
+
+// Rollback loads a configuration version of scope, a folder name or a
+// firewall serial number, into the candidate configuration and optionally
+// pushes it.
+//
+// The push job is returned if there is one.  With Wait set, the error is the
+// one of Job.Wait.
+func (c *Client) Rollback(ctx context.Context, scope string, opts RollbackOptions) (*Job, error) {
+	version := opts.Version
+	if version == 0 {
+		v, err := c.RunningVersion(ctx, scope)
+		if err != nil {
+			return nil, err
+		}
+		version = v.Version
+	}
+
+	if err := c.Load(ctx, version); err != nil {
+		return nil, fmt.Errorf("loading version %d: %w", version, err)
+	}
+	if !opts.Push {
+		return nil, nil
+	}
+
+	po := opts.PushOptions
+	if po.Description == "" {
+		po.Description = fmt.Sprintf("Rollback of %s to version %d", scope, version)
+	}
+	var folders []string
+	if isSerialNumber(scope) {
+		po.Devices = append(po.Devices[:len(po.Devices):len(po.Devices)], scope)
+	} else {
+		folders = []string{scope}
+	}
+
+	job, err := c.Push(ctx, folders, po)
+	if err != nil || !opts.Wait {
+		return job, err
+	}
+	_, err = job.Wait(ctx)
+	return job, err
+}
+
+// isSerialNumber returns whether scope is a firewall serial number rather
+// than a folder name.
+func isSerialNumber(scope string) bool {
+	return scope != "" && strings.Trim(scope, "0123456789") == ""
+}
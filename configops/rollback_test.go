@@ -0,0 +1,111 @@
+package configops_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/configops"
+)
+
+func TestRunningVersion(t *testing.T) {
+	srv := newJobServer(t, false)
+	ops := newOps(t, srv.Server)
+	ctx := context.Background()
+
+	v, err := ops.RunningVersion(ctx, "Mobile Users")
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), v.Version)
+
+	_, err = ops.RunningVersion(ctx, "Remote Networks")
+	assert.ErrorIs(t, err, configops.ErrNoRunningVersion)
+}
+
+func TestRollback(t *testing.T) {
+	srv := newJobServer(t, false)
+	ops := newOps(t, srv.Server)
+	ctx := context.Background()
+
+	// To the running version, without pushing.
+	job, err := ops.Rollback(ctx, "Mobile Users", configops.RollbackOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, job)
+
+	// To an older version, pushing it to the firewall.
+	job, err = ops.Rollback(ctx, "007951000388704", configops.RollbackOptions{
+		Version:     5,
+		Push:        true,
+		Wait:        true,
+		PushOptions: configops.PushOptions{PollInterval: time.Millisecond},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "100", job.ID())
+
+	assert.Equal(t, []int{7, 5}, srv.loaded)
+	assert.Equal(t, []interface{}{"007951000388704"}, srv.push["devices"])
+	assert.Nil(t, srv.push["folder"])
+	assert.Equal(t, "Rollback of 007951000388704 to version 5", srv.push["description"])
+}
+
+func TestDiffVersions(t *testing.T) {
+	srv := newJobServer(t, false)
+	ops := newOps(t, srv.Server)
+
+	// The candidate configuration is only replaced when allowed.
+	_, err := ops.DiffVersions(context.Background(), "Mobile Users", 5, 7, configops.DiffVersionsOptions{})
+	assert.ErrorIs(t, err, configops.ErrDiscardsCandidate)
+	assert.Empty(t, srv.loaded)
+
+	diff, err := ops.DiffVersions(context.Background(), "Mobile Users", 5, 7, configops.DiffVersionsOptions{
+		Resources:        []string{"/config/objects/v1/addresses"},
+		DiscardCandidate: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{5, 7}, srv.loaded)
+
+	require.Len(t, diff, 3)
+	assert.Equal(t, configops.Added, diff[0].Type)
+	assert.Equal(t, "cache", diff[0].Name)
+	assert.Equal(t, configops.Removed, diff[1].Type)
+	assert.Equal(t, "db", diff[1].Name)
+	assert.Equal(t, configops.Modified, diff[2].Type)
+	assert.Equal(t, "web", diff[2].Name)
+	assert.Equal(t, []string{"description", "ip_netmask"}, diff[2].Fields)
+	assert.Equal(t, "modified /config/objects/v1/addresses web (description, ip_netmask)", diff[2].String())
+}
+
+func TestDiffCandidate(t *testing.T) {
+	srv := newJobServer(t, false)
+	ops := newOps(t, srv.Server)
+	ctx := context.Background()
+
+	require.NoError(t, ops.Load(ctx, 5))
+	running, err := ops.Snapshot(ctx, "Mobile Users", "/config/objects/v1/addresses", "/config/security/v1/security-rules")
+	require.NoError(t, err)
+
+	// Rules are snapshotted at both positions.
+	rules := running.Objects["/config/security/v1/security-rules"]
+	require.Len(t, rules, 2)
+	assert.Equal(t, "pre", rules["allow-web"]["position"])
+	assert.Equal(t, "post", rules["deny-all"]["position"])
+
+	// The candidate configuration changes, and is compared without loading
+	// anything.
+	require.NoError(t, ops.Load(ctx, 7))
+	diff, err := ops.DiffCandidate(ctx, running)
+	require.NoError(t, err)
+	assert.Equal(t, []int{5, 7}, srv.loaded)
+
+	var changes []string
+	for _, c := range diff {
+		changes = append(changes, c.String())
+	}
+	assert.Equal(t, []string{
+		"added /config/objects/v1/addresses cache",
+		"removed /config/objects/v1/addresses db",
+		"modified /config/objects/v1/addresses web (description, ip_netmask)",
+	}, changes)
+}
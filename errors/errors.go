@@ -84,6 +84,8 @@ type BaseError struct {
 	Code       string
 	Message    string
 	Details    map[string]interface{}
+	RequestID  string // The _request_id reported by the API, if any
+	Cause      error  // The underlying error, if any
 }
 
 // Error implements the error interface
@@ -114,6 +116,9 @@ func (e *BaseError) ErrorDetails() map[string]interface{} {
 	return e.Details
 }
 
+// Unwrap returns the underlying error so errors.As can reach it
+func (e *BaseError) Unwrap() error { return e.Cause }
+
 // ============================================================================
 // Client Errors (4xx)
 // ============================================================================
@@ -0,0 +1,94 @@
+package errors
+
+import (
+	"net/http"
+	"runtime"
+	"sync"
+	"weak"
+)
+
+// The errors of the generated API clients keep the status line and body of
+// the response, but not its header.  The transport of the scm API clients
+// records the header of each error response with RecordResponse, and
+// FromGenerated finds it back from the status and body of the error, so that
+// the typed error has the Retry-After and X-Request-ID of the response.
+
+// maxRecordedResponses bounds the number of error responses whose header is
+// kept for FromGenerated.
+const maxRecordedResponses = 256
+
+// recordedResponse is an error response recorded by RecordResponse.
+type recordedResponse struct {
+	statusCode int
+	body       string
+	header     http.Header
+}
+
+var (
+	recordedMu sync.Mutex
+	recorded   []recordedResponse
+
+	// generated holds the typed error of each error of the generated API
+	// clients, by weak pointer to the error.  generatedMu serializes the
+	// building of the typed errors.
+	generated   sync.Map
+	generatedMu sync.Mutex
+)
+
+// RecordResponse records the header of an error response, whose body is
+// body, for FromGenerated.  Only the last responses are kept.
+func RecordResponse(statusCode int, header http.Header, body []byte) {
+	if statusCode < 400 {
+		return
+	}
+	recordedMu.Lock()
+	defer recordedMu.Unlock()
+	if len(recorded) == maxRecordedResponses {
+		recorded = append(recorded[:0], recorded[1:]...)
+	}
+	recorded = append(recorded, recordedResponse{statusCode, string(body), header.Clone()})
+}
+
+// recordedHeader returns and forgets the header of the last recorded
+// response with the given status code and body, or nil if there is none.
+func recordedHeader(statusCode int, body []byte) http.Header {
+	recordedMu.Lock()
+	defer recordedMu.Unlock()
+	for i := len(recorded) - 1; i >= 0; i-- {
+		if r := recorded[i]; r.statusCode == statusCode && r.body == string(body) {
+			recorded = append(recorded[:i], recorded[i+1:]...)
+			return r.header
+		}
+	}
+	return nil
+}
+
+// FromGenerated returns the typed error of e, an error of a generated API
+// client whose message starts with the response status (see
+// FromStatusMessage) and whose response body is body.  The header of the
+// response is the one recorded with RecordResponse, if any.
+//
+// The typed error is built on the first call: later calls for the same e
+// return the same error, so that the pointers found by errors.As do not
+// change.  FromGenerated returns nil if msg does not start with a status code
+// indicating an error.
+func FromGenerated[E any](e *E, msg string, body []byte) ScmError {
+	key := weak.Make(e)
+	if se, ok := generated.Load(key); ok {
+		return se.(ScmError)
+	}
+	statusCode, ok := messageStatus(msg)
+	if !ok || statusCode < 400 {
+		return nil
+	}
+
+	generatedMu.Lock()
+	defer generatedMu.Unlock()
+	if se, ok := generated.Load(key); ok {
+		return se.(ScmError)
+	}
+	se := FromResponse(statusCode, recordedHeader(statusCode, body), body, nil)
+	generated.Store(key, se)
+	runtime.AddCleanup(e, func(key weak.Pointer[E]) { generated.Delete(key) }, key)
+	return se
+}
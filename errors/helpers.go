@@ -1,8 +1,20 @@
 package errors
 
+import stderrors "errors"
+
 // Helper functions for checking and extracting specific error types.
 // These functions provide a type-safe way to inspect errors without
-// needing to use type assertions directly.
+// needing to use type assertions directly. Wrapped errors are unwrapped,
+// so the helpers also work on errors returned through fmt.Errorf("%w").
+
+// find returns the first ScmError in err's chain, or nil if there is none.
+func find(err error) ScmError {
+	var se ScmError
+	if stderrors.As(err, &se) {
+		return se
+	}
+	return nil
+}
 
 // ============================================================================
 // Type Check Helpers (Is* functions)
@@ -13,8 +25,7 @@ func IsScmError(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(ScmError)
-	return ok
+	return find(err) != nil
 }
 
 // IsClientError checks if the error is a client error (4xx).
@@ -22,12 +33,12 @@ func IsClientError(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*ClientError)
+	_, ok := find(err).(*ClientError)
 	if ok {
 		return true
 	}
 	// Check embedded types
-	switch err.(type) {
+	switch find(err).(type) {
 	case *AuthenticationError, *AuthorizationError, *BadRequestError,
 		*NotFoundError, *ConflictError, *MethodNotAllowedError,
 		*RequestTimeoutError, *TooManyRequestsError, *SessionTimedOutError,
@@ -45,12 +56,12 @@ func IsServerError(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*ServerError)
+	_, ok := find(err).(*ServerError)
 	if ok {
 		return true
 	}
 	// Check embedded types
-	switch err.(type) {
+	switch find(err).(type) {
 	case *InternalServerError, *BadGatewayError, *ServiceUnavailableError, *GatewayTimeoutError:
 		return true
 	}
@@ -62,7 +73,7 @@ func IsAuthenticationError(err error) bool {
 	if err == nil {
 		return false
 	}
-	switch err.(type) {
+	switch find(err).(type) {
 	case *AuthenticationError, *NotAuthenticatedError, *InvalidCredentialError, *KeyExpiredError:
 		return true
 	}
@@ -74,7 +85,7 @@ func IsNotAuthenticated(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*NotAuthenticatedError)
+	_, ok := find(err).(*NotAuthenticatedError)
 	return ok
 }
 
@@ -83,7 +94,7 @@ func IsInvalidCredential(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*InvalidCredentialError)
+	_, ok := find(err).(*InvalidCredentialError)
 	return ok
 }
 
@@ -92,7 +103,7 @@ func IsKeyExpired(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*KeyExpiredError)
+	_, ok := find(err).(*KeyExpiredError)
 	return ok
 }
 
@@ -101,7 +112,7 @@ func IsAuthorizationError(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*AuthorizationError)
+	_, ok := find(err).(*AuthorizationError)
 	return ok
 }
 
@@ -110,7 +121,7 @@ func IsBadRequest(err error) bool {
 	if err == nil {
 		return false
 	}
-	switch err.(type) {
+	switch find(err).(type) {
 	case *BadRequestError, *InvalidObjectError, *MissingQueryParameterError,
 		*InvalidQueryParameterError, *MalformedCommandError:
 		return true
@@ -123,7 +134,7 @@ func IsInvalidObject(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*InvalidObjectError)
+	_, ok := find(err).(*InvalidObjectError)
 	return ok
 }
 
@@ -132,7 +143,7 @@ func IsMissingQueryParameter(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*MissingQueryParameterError)
+	_, ok := find(err).(*MissingQueryParameterError)
 	return ok
 }
 
@@ -141,7 +152,7 @@ func IsInvalidQueryParameter(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*InvalidQueryParameterError)
+	_, ok := find(err).(*InvalidQueryParameterError)
 	return ok
 }
 
@@ -150,7 +161,7 @@ func IsMalformedCommand(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*MalformedCommandError)
+	_, ok := find(err).(*MalformedCommandError)
 	return ok
 }
 
@@ -159,7 +170,7 @@ func IsNotFound(err error) bool {
 	if err == nil {
 		return false
 	}
-	switch err.(type) {
+	switch find(err).(type) {
 	case *NotFoundError, *ObjectNotPresentError:
 		return true
 	}
@@ -171,7 +182,7 @@ func IsObjectNotPresent(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*ObjectNotPresentError)
+	_, ok := find(err).(*ObjectNotPresentError)
 	return ok
 }
 
@@ -180,7 +191,7 @@ func IsConflict(err error) bool {
 	if err == nil {
 		return false
 	}
-	switch err.(type) {
+	switch find(err).(type) {
 	case *ConflictError, *NameNotUniqueError, *ObjectNotUniqueError, *ReferenceNotZeroError:
 		return true
 	}
@@ -192,7 +203,7 @@ func IsNameNotUnique(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*NameNotUniqueError)
+	_, ok := find(err).(*NameNotUniqueError)
 	return ok
 }
 
@@ -201,7 +212,7 @@ func IsObjectNotUnique(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*ObjectNotUniqueError)
+	_, ok := find(err).(*ObjectNotUniqueError)
 	return ok
 }
 
@@ -210,7 +221,7 @@ func IsReferenceNotZero(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*ReferenceNotZeroError)
+	_, ok := find(err).(*ReferenceNotZeroError)
 	return ok
 }
 
@@ -219,7 +230,7 @@ func IsMethodNotAllowed(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*MethodNotAllowedError)
+	_, ok := find(err).(*MethodNotAllowedError)
 	return ok
 }
 
@@ -228,7 +239,7 @@ func IsRequestTimeout(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*RequestTimeoutError)
+	_, ok := find(err).(*RequestTimeoutError)
 	return ok
 }
 
@@ -237,7 +248,7 @@ func IsTooManyRequests(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*TooManyRequestsError)
+	_, ok := find(err).(*TooManyRequestsError)
 	return ok
 }
 
@@ -246,7 +257,7 @@ func IsSessionTimedOut(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*SessionTimedOutError)
+	_, ok := find(err).(*SessionTimedOutError)
 	return ok
 }
 
@@ -255,7 +266,7 @@ func IsInternalServerError(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*InternalServerError)
+	_, ok := find(err).(*InternalServerError)
 	return ok
 }
 
@@ -264,7 +275,7 @@ func IsBadGateway(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*BadGatewayError)
+	_, ok := find(err).(*BadGatewayError)
 	return ok
 }
 
@@ -273,7 +284,7 @@ func IsServiceUnavailable(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*ServiceUnavailableError)
+	_, ok := find(err).(*ServiceUnavailableError)
 	return ok
 }
 
@@ -282,7 +293,7 @@ func IsGatewayTimeout(err error) bool {
 	if err == nil {
 		return false
 	}
-	_, ok := err.(*GatewayTimeoutError)
+	_, ok := find(err).(*GatewayTimeoutError)
 	return ok
 }
 
@@ -296,7 +307,7 @@ func AsObjectNotPresent(err error) (*ObjectNotPresentError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*ObjectNotPresentError)
+	e, ok := find(err).(*ObjectNotPresentError)
 	return e, ok
 }
 
@@ -306,7 +317,7 @@ func AsNameNotUnique(err error) (*NameNotUniqueError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*NameNotUniqueError)
+	e, ok := find(err).(*NameNotUniqueError)
 	return e, ok
 }
 
@@ -316,7 +327,7 @@ func AsObjectNotUnique(err error) (*ObjectNotUniqueError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*ObjectNotUniqueError)
+	e, ok := find(err).(*ObjectNotUniqueError)
 	return e, ok
 }
 
@@ -326,7 +337,7 @@ func AsReferenceNotZero(err error) (*ReferenceNotZeroError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*ReferenceNotZeroError)
+	e, ok := find(err).(*ReferenceNotZeroError)
 	return e, ok
 }
 
@@ -336,7 +347,7 @@ func AsTooManyRequests(err error) (*TooManyRequestsError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*TooManyRequestsError)
+	e, ok := find(err).(*TooManyRequestsError)
 	return e, ok
 }
 
@@ -346,7 +357,7 @@ func AsInvalidObject(err error) (*InvalidObjectError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*InvalidObjectError)
+	e, ok := find(err).(*InvalidObjectError)
 	return e, ok
 }
 
@@ -356,7 +367,7 @@ func AsMissingQueryParameter(err error) (*MissingQueryParameterError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*MissingQueryParameterError)
+	e, ok := find(err).(*MissingQueryParameterError)
 	return e, ok
 }
 
@@ -366,7 +377,7 @@ func AsInvalidQueryParameter(err error) (*InvalidQueryParameterError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*InvalidQueryParameterError)
+	e, ok := find(err).(*InvalidQueryParameterError)
 	return e, ok
 }
 
@@ -376,6 +387,19 @@ func AsMethodNotAllowed(err error) (*MethodNotAllowedError, bool) {
 	if err == nil {
 		return nil, false
 	}
-	e, ok := err.(*MethodNotAllowedError)
+	e, ok := find(err).(*MethodNotAllowedError)
 	return e, ok
 }
+
+// ============================================================================
+// Other Helpers
+// ============================================================================
+
+// RequestID returns the _request_id the API reported with the error, or ""
+// if there is none.
+func RequestID(err error) string {
+	if b, ok := find(err).(baseError); ok {
+		return b.base().RequestID
+	}
+	return ""
+}
@@ -0,0 +1,97 @@
+// Command errorsgen makes the GenericOpenAPIError of each generated API
+// client unwrap to the typed error of the errors package matching the
+// response.
+//
+// Usage:
+//
+//	go run ./internal/errorsgen -generated ../generated
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+const out = "errors_gen.go"
+
+func main() {
+	generated := flag.String("generated", "../generated", "directory holding the generated API clients")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*generated, "*", "client.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, file := range files {
+		name, err := load(file)
+		if err != nil {
+			log.Fatalf("%s: %s", file, err)
+		}
+		if err = write(filepath.Join(filepath.Dir(file), out), name); err != nil {
+			log.Fatalf("%s: %s", file, err)
+		}
+	}
+}
+
+// load parses the client.go file of a generated package, and returns the
+// name of the package.
+func load(file string) (string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return "", err
+	}
+
+	found := false
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == "GenericOpenAPIError" {
+					found = true
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv != nil && d.Name.Name == "Unwrap" {
+				return "", fmt.Errorf("GenericOpenAPIError already has an Unwrap method")
+			}
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("no GenericOpenAPIError")
+	}
+	return f.Name.Name, nil
+}
+
+// write writes the Unwrap method of the package's GenericOpenAPIError to
+// file.
+func write(file, name string) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by errorsgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", name)
+	fmt.Fprintf(&b, "import scmErrors \"github.com/paloaltonetworks/scm-go/errors\"\n\n")
+	fmt.Fprintf(&b, "// Unwrap returns the typed error of the scmErrors package for the error\n")
+	fmt.Fprintf(&b, "// response, so that errors.As and the scmErrors helpers see it.  The same\n")
+	fmt.Fprintf(&b, "// error is returned on every call; its header is the one recorded by the\n")
+	fmt.Fprintf(&b, "// scm API clients (see scmErrors.FromGenerated).  It returns nil if e is not\n")
+	fmt.Fprintf(&b, "// the error of a 4xx or 5xx response.\n")
+	fmt.Fprintf(&b, "func (e *GenericOpenAPIError) Unwrap() error {\n")
+	fmt.Fprintf(&b, "if se := scmErrors.FromGenerated(e, e.error, e.body); se != nil {\n")
+	fmt.Fprintf(&b, "return se\n")
+	fmt.Fprintf(&b, "}\n")
+	fmt.Fprintf(&b, "return nil\n")
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, src, 0644)
+}
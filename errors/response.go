@@ -0,0 +1,256 @@
+package errors
+
+//go:generate go run ./internal/errorsgen -generated ../generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Mapping of SCM API error responses onto the typed error hierarchy.
+//
+// The SCM API reports failures using the `_errors` envelope:
+//
+//	{
+//	    "_errors": [
+//	        {
+//	            "code": "E006",
+//	            "message": "Name Not Unique",
+//	            "details": {"errorType": "Name Not Unique", "message": "..."}
+//	        }
+//	    ],
+//	    "_request_id": "123e4567-e89b-12d3-a456-426655440000"
+//	}
+//
+// The error type is taken from the first entry's details.errorType (or its
+// message when no errorType is present), falling back to the HTTP status code.
+
+// apiErrorEntry is a single entry of the `_errors` list.
+type apiErrorEntry struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details"`
+	Help    string      `json:"help"`
+}
+
+// apiErrorResponse is the error envelope returned by the SCM API.
+type apiErrorResponse struct {
+	Errors    []apiErrorEntry `json:"_errors"`
+	RequestID string          `json:"_request_id"`
+}
+
+// baseError gives access to the embedded BaseError of any typed error.
+type baseError interface {
+	base() *BaseError
+}
+
+func (e *BaseError) base() *BaseError { return e }
+
+// FromResponse builds the typed error for an SCM API error response.
+//
+// The cause, typically the generated client's GenericOpenAPIError, remains
+// reachable through errors.As. FromResponse returns nil if the status code
+// does not indicate an error.
+func FromResponse(statusCode int, header http.Header, body []byte, cause error) ScmError {
+	if statusCode < 400 {
+		return nil
+	}
+
+	var envelope apiErrorResponse
+	_ = json.Unmarshal(body, &envelope)
+
+	var entry apiErrorEntry
+	if len(envelope.Errors) > 0 {
+		entry = envelope.Errors[0]
+	}
+
+	details := make(map[string]interface{})
+	var errorType, detailMessage string
+	switch d := entry.Details.(type) {
+	case map[string]interface{}:
+		for k, v := range d {
+			details[k] = v
+		}
+		errorType, _ = d["errorType"].(string)
+		detailMessage = joinMessage(d["message"])
+	case string:
+		detailMessage = d
+	}
+	if entry.Code != "" {
+		details["code"] = entry.Code
+	}
+	if entry.Help != "" {
+		details["help"] = entry.Help
+	}
+	if len(envelope.Errors) > 1 {
+		details["errors"] = envelope.Errors[1:]
+	}
+
+	kind := errorType
+	if kind == "" {
+		kind = entry.Message
+	}
+	se := typedError(statusCode, kind, header)
+
+	message := entry.Message
+	if message == "" {
+		message = errorType
+	}
+	if detailMessage != "" && detailMessage != message {
+		if message != "" {
+			message += " - "
+		}
+		message += detailMessage
+	}
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+
+	b := se.(baseError).base()
+	b.StatusCode = statusCode
+	b.Message = message
+	b.Details = details
+	b.RequestID = envelope.RequestID
+	if b.RequestID == "" && header != nil {
+		b.RequestID = header.Get("X-Request-ID")
+	}
+	b.Cause = cause
+	if entry.Code != "" {
+		b.Code = entry.Code
+	}
+
+	return se
+}
+
+// FromStatusMessage builds the typed error for an SCM API error response
+// from an error message starting with the response status, such as
+// "409 Conflict", and the response body.  The errors of the generated API
+// clients have such messages.
+//
+// FromStatusMessage returns nil if msg does not start with a status code
+// indicating an error.
+func FromStatusMessage(msg string, body []byte) ScmError {
+	statusCode, ok := messageStatus(msg)
+	if !ok {
+		return nil
+	}
+	return FromResponse(statusCode, nil, body, nil)
+}
+
+// messageStatus returns the status code starting msg.
+func messageStatus(msg string) (int, bool) {
+	code, _, _ := strings.Cut(msg, " ")
+	statusCode, err := strconv.Atoi(code)
+	if err != nil || len(code) != 3 {
+		return 0, false
+	}
+	return statusCode, true
+}
+
+// typedError returns the zero-configured typed error for the given SCM error
+// type, or the generic error for the status code if the type is unknown.
+func typedError(statusCode int, kind string, header http.Header) ScmError {
+	name, arg, _ := strings.Cut(kind, ":")
+	arg = strings.TrimSpace(arg)
+
+	switch strings.ToLower(strings.TrimSpace(strings.ReplaceAll(name, "_", " "))) {
+	case "not authenticated":
+		return NewNotAuthenticatedError("")
+	case "invalid credential", "invalid credentials":
+		return NewInvalidCredentialError("")
+	case "key expired":
+		return NewKeyExpiredError("")
+	case "unauthorized", "forbidden":
+		return NewAuthorizationError("")
+	case "invalid object":
+		return NewInvalidObjectError(arg, "")
+	case "missing query parameter":
+		return NewMissingQueryParameterError(arg)
+	case "invalid query parameter":
+		param, value, _ := strings.Cut(arg, "=")
+		return NewInvalidQueryParameterError(param, value, "")
+	case "malformed command", "invalid command", "bad xpath":
+		return NewMalformedCommandError("")
+	case "object not present", "object not found":
+		return NewObjectNotPresentError("", "")
+	case "name not unique":
+		return NewNameNotUniqueError("")
+	case "object not unique", "object already exists":
+		return NewObjectNotUniqueError("", "")
+	case "reference not zero":
+		return NewReferenceNotZeroError("", 0)
+	case "session timeout", "session timed out":
+		return NewSessionTimedOutError("")
+	}
+
+	switch statusCode {
+	case http.StatusBadRequest:
+		return &BadRequestError{}
+	case http.StatusUnauthorized:
+		return &AuthenticationError{}
+	case http.StatusForbidden:
+		return NewAuthorizationError("")
+	case http.StatusNotFound:
+		return &NotFoundError{}
+	case http.StatusMethodNotAllowed:
+		return NewMethodNotAllowedError("")
+	case http.StatusRequestTimeout:
+		return NewRequestTimeoutError("")
+	case http.StatusConflict:
+		return &ConflictError{}
+	case http.StatusTooManyRequests:
+		return NewTooManyRequestsError(retryAfter(header), "")
+	case http.StatusInternalServerError:
+		return NewInternalServerError("")
+	case http.StatusBadGateway:
+		return NewBadGatewayError("")
+	case http.StatusServiceUnavailable:
+		return NewServiceUnavailableError("")
+	case http.StatusGatewayTimeout:
+		return NewGatewayTimeoutError("")
+	}
+
+	if statusCode >= 500 {
+		return &ServerError{}
+	}
+	return &ClientError{}
+}
+
+// retryAfter returns the Retry-After header value in seconds.
+func retryAfter(header http.Header) int {
+	if header == nil {
+		return 0
+	}
+	v := strings.TrimSpace(header.Get("Retry-After"))
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return secs
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return int((d + time.Second - 1) / time.Second)
+		}
+	}
+	return 0
+}
+
+// joinMessage flattens a details message, which may be a string or a list.
+func joinMessage(v interface{}) string {
+	switch m := v.(type) {
+	case string:
+		return m
+	case []interface{}:
+		parts := make([]string, 0, len(m))
+		for _, p := range m {
+			parts = append(parts, fmt.Sprintf("%v", p))
+		}
+		return strings.Join(parts, "; ")
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ============================================================================
+// FromResponse Tests
+// ============================================================================
+
+func errorBody(code, message, errorType string) []byte {
+	return []byte(fmt.Sprintf(`{"_errors":[{"code":%q,"message":%q,"details":{"errorType":%q,"message":"detail text"}}],"_request_id":"req-123"}`, code, message, errorType))
+}
+
+func TestFromResponse_ErrorTypes(t *testing.T) {
+	tests := []struct {
+		status    int
+		errorType string
+		check     func(error) bool
+	}{
+		{409, "Name Not Unique", IsNameNotUnique},
+		{409, "Object Not Unique", IsObjectNotUnique},
+		{409, "Reference Not Zero", IsReferenceNotZero},
+		{404, "Object Not Present", IsObjectNotPresent},
+		{400, "Invalid Object", IsInvalidObject},
+		{400, "Malformed Command", IsMalformedCommand},
+		{401, "Not Authenticated", IsNotAuthenticated},
+		{401, "Invalid Credential", IsInvalidCredential},
+		{401, "Key Expired", IsKeyExpired},
+		{403, "Unauthorized", IsAuthorizationError},
+		{504, "Session Timeout", IsSessionTimedOut},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.errorType, func(t *testing.T) {
+			err := FromResponse(tt.status, nil, errorBody("E999", "Some message", tt.errorType), nil)
+			require.NotNil(t, err)
+			assert.True(t, tt.check(err))
+			assert.Equal(t, tt.status, err.HTTPStatusCode())
+			assert.Equal(t, "E999", err.ErrorCode())
+		})
+	}
+}
+
+func TestFromResponse_MessageFallback(t *testing.T) {
+	body := []byte(`{"_errors":[{"code":"E003","details":{},"message":"Missing Query Parameter: name"}],"_request_id":"req-123"}`)
+	err := FromResponse(400, nil, body, nil)
+
+	paramErr, ok := AsMissingQueryParameter(err)
+	require.True(t, ok)
+	assert.Equal(t, "name", paramErr.ParameterName)
+	assert.Equal(t, "Missing Query Parameter: name", paramErr.ErrorMessage())
+}
+
+func TestFromResponse_StatusFallback(t *testing.T) {
+	assert.True(t, IsBadRequest(FromResponse(400, nil, []byte(`{}`), nil)))
+	assert.True(t, IsAuthenticationError(FromResponse(401, nil, nil, nil)))
+	assert.True(t, IsNotFound(FromResponse(404, nil, []byte("not json"), nil)))
+	assert.True(t, IsConflict(FromResponse(409, nil, nil, nil)))
+	assert.True(t, IsMethodNotAllowed(FromResponse(405, nil, nil, nil)))
+	assert.True(t, IsServiceUnavailable(FromResponse(503, nil, nil, nil)))
+	assert.True(t, IsClientError(FromResponse(418, nil, nil, nil)))
+	assert.True(t, IsServerError(FromResponse(501, nil, nil, nil)))
+	assert.Nil(t, FromResponse(302, nil, nil, nil))
+}
+
+func TestFromResponse_Details(t *testing.T) {
+	header := http.Header{}
+	header.Set("Retry-After", "30")
+	err := FromResponse(429, header, errorBody("E012", "Too Many Requests", ""), nil)
+
+	rateErr, ok := AsTooManyRequests(err)
+	require.True(t, ok)
+	assert.Equal(t, 30, rateErr.RetryAfter)
+	assert.Equal(t, "req-123", rateErr.RequestID)
+	assert.Equal(t, "Too Many Requests - detail text", rateErr.ErrorMessage())
+	assert.Equal(t, "E012", rateErr.ErrorDetails()["code"])
+}
+
+func TestFromResponse_RequestIDHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Request-ID", "hdr-456")
+	err := FromResponse(500, header, nil, nil)
+
+	require.NotNil(t, err)
+	assert.Equal(t, "hdr-456", err.(*InternalServerError).RequestID)
+	assert.Equal(t, "Internal Server Error", err.ErrorMessage())
+
+	assert.Equal(t, "hdr-456", RequestID(fmt.Errorf("wrapped: %w", err)))
+	assert.Empty(t, RequestID(stderrors.New("not an SCM error")))
+}
+
+type causeError struct{ body string }
+
+func (e *causeError) Error() string { return e.body }
+
+func TestFromResponse_Unwrap(t *testing.T) {
+	cause := &causeError{body: "409 Conflict"}
+	var err error = FromResponse(409, nil, errorBody("E006", "Name Not Unique", ""), cause)
+	err = fmt.Errorf("creating address: %w", err)
+
+	// The helpers see through wrapping.
+	assert.True(t, IsNameNotUnique(err))
+	assert.True(t, IsConflict(err))
+	assert.True(t, IsScmError(err))
+
+	// The standard library sees both the typed error and its cause.
+	var nameErr *NameNotUniqueError
+	require.True(t, stderrors.As(err, &nameErr))
+	var got *causeError
+	require.True(t, stderrors.As(err, &got))
+	assert.Same(t, cause, got)
+}
+
+func TestFromStatusMessage(t *testing.T) {
+	err := FromStatusMessage("409 Conflict", errorBody("E006", "Name Not Unique", ""))
+	require.NotNil(t, err)
+	assert.True(t, IsNameNotUnique(err))
+	assert.Equal(t, "req-123", RequestID(err))
+
+	err = FromStatusMessage("502 Bad Gateway", []byte("<html></html>"))
+	assert.True(t, IsBadGateway(err))
+
+	for _, msg := range []string{"", "200 OK", "invalid character '<' looking for beginning of value", "4096 bytes"} {
+		assert.Nil(t, FromStatusMessage(msg, nil), msg)
+	}
+}
+
+func TestFromGenerated(t *testing.T) {
+	type apiError struct{ msg string }
+
+	header := http.Header{"Retry-After": {"30"}, "X-Request-Id": {"hdr-1"}}
+	RecordResponse(http.StatusTooManyRequests, header, []byte("{}"))
+
+	e := &apiError{"429 Too Many Requests"}
+	err := FromGenerated(e, e.msg, []byte("{}"))
+	rateErr, ok := AsTooManyRequests(err)
+	require.True(t, ok)
+	assert.Equal(t, 30, rateErr.RetryAfter)
+	assert.Equal(t, "hdr-1", RequestID(err))
+
+	// The typed error is built once.
+	again, ok := AsTooManyRequests(FromGenerated(e, e.msg, []byte("{}")))
+	require.True(t, ok)
+	assert.Same(t, rateErr, again)
+
+	// The recorded header is used by one error only.
+	other := &apiError{"429 Too Many Requests"}
+	rateErr, ok = AsTooManyRequests(FromGenerated(other, other.msg, []byte("{}")))
+	require.True(t, ok)
+	assert.Zero(t, rateErr.RetryAfter)
+
+	assert.Nil(t, FromGenerated(&apiError{}, "invalid character '<'", nil))
+}
@@ -0,0 +1,226 @@
+package config_operations
+
+// The typed accessors of the Jobs model, and the times of the config
+// versions.  This file is not produced by OpenAPI Generator, so regenerating
+// the client keeps it.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JobStatus is the state of a job.
+type JobStatus string
+
+// Known job states.
+const (
+	JobStatusUnknown  JobStatus = ""
+	JobStatusPending  JobStatus = "pending"
+	JobStatusActive   JobStatus = "active"
+	JobStatusFinished JobStatus = "finished"
+)
+
+// JobResult is the outcome of a job, as found in its result_str.
+type JobResult string
+
+// Known job results.
+const (
+	JobResultOK        JobResult = "OK"
+	JobResultFail      JobResult = "FAIL"
+	JobResultPending   JobResult = "PEND"
+	JobResultWaiting   JobResult = "WAIT"
+	JobResultCancelled JobResult = "CANCELLED"
+	JobResultTimeout   JobResult = "TIMEOUT"
+)
+
+// Status returns the state of the job, from its status_str, or from its
+// numeric job_status (0, 1 or 2) if status_str is not set.
+//
+// Jobs whose push failed, was aborted or timed out are finished.
+func (o *Jobs) Status() JobStatus {
+	switch o.StatusStr {
+	case "PEND":
+		return JobStatusPending
+	case "ACT", "PUSHSENT":
+		return JobStatusActive
+	case "FIN", "PUSHFAIL", "PUSHABORT", "PUSHTIMEOUT":
+		return JobStatusFinished
+	case "":
+		switch strings.TrimSpace(o.JobStatus) {
+		case "0":
+			return JobStatusPending
+		case "1":
+			return JobStatusActive
+		case "2":
+			return JobStatusFinished
+		}
+	}
+	return JobStatusUnknown
+}
+
+// Result returns the outcome of the job.
+func (o *Jobs) Result() JobResult {
+	return JobResult(o.ResultStr)
+}
+
+// Done returns whether the job is finished.
+func (o *Jobs) Done() bool {
+	return o.Status() == JobStatusFinished
+}
+
+// Failed returns whether the job is finished and did not succeed.
+func (o *Jobs) Failed() bool {
+	if !o.Done() {
+		return false
+	}
+	switch o.Result() {
+	case JobResultFail, JobResultCancelled, JobResultTimeout:
+		return true
+	}
+	return o.StatusStr != "FIN" && o.StatusStr != ""
+}
+
+// PercentComplete returns the completion percentage of the job, and whether
+// it could be parsed.
+func (o *Jobs) PercentComplete() (int, bool) {
+	p, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(o.Percent), "%"))
+	if err != nil {
+		return 0, false
+	}
+	return p, true
+}
+
+// StartTime returns the time at which the job was created.  It is the zero
+// time if start_ts is empty.
+func (o *Jobs) StartTime() (time.Time, error) {
+	return parseJobTime(o.StartTs)
+}
+
+// EndTime returns the time at which the job finished.  It is the zero time
+// if end_ts is empty, as it is for unfinished jobs.
+func (o *Jobs) EndTime() (time.Time, error) {
+	return parseJobTime(o.EndTs)
+}
+
+// jobTimeLayouts are the layouts of the job timestamps.
+var jobTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006/01/02 15:04:05",
+}
+
+// parseJobTime parses a job timestamp, either formatted or in seconds since
+// the epoch.  Timestamps without a time zone are in UTC.
+func parseJobTime(v string) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range jobTimeLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	if f, err := strconv.ParseFloat(v, 64); err == nil {
+		return epochTime(f), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid job timestamp %q", v)
+}
+
+// ConfigVersionTimes are the times of a config version.
+type ConfigVersionTimes struct {
+	// Created is the time at which the version was created.
+	Created time.Time
+
+	// Updated is the time at which the version was last updated.
+	Updated time.Time
+
+	// Deleted is the time at which the version was deleted, or the zero
+	// time if it was not.
+	Deleted time.Time
+}
+
+// ReadConfigVersionTimes returns the times of the config versions of resp,
+// the response of ListConfigVersions or GetConfigVersionsByID, by version ID.
+// The created, updated and deleted timestamps are decoded from the response
+// body, as the float32 fields of ConfigVersion are off by up to two minutes
+// for current dates.  The body can still be read afterwards.
+func ReadConfigVersionTimes(resp *http.Response) (map[int32]ConfigVersionTimes, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return parseConfigVersionTimes(body)
+}
+
+// parseConfigVersionTimes returns the times of the config versions of body,
+// a list response, an array of config versions or a single one.
+func parseConfigVersionTimes(body []byte) (map[int32]ConfigVersionTimes, error) {
+	type version struct {
+		Id                        int32
+		Created, Updated, Deleted json.Number
+	}
+	var versions []version
+	body = bytes.TrimSpace(body)
+	switch {
+	case bytes.HasPrefix(body, []byte("[")):
+		if err := json.Unmarshal(body, &versions); err != nil {
+			return nil, err
+		}
+	default:
+		var v struct {
+			version
+			Data *[]version
+		}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, err
+		}
+		if v.Data != nil {
+			versions = *v.Data
+		} else {
+			versions = []version{v.version}
+		}
+	}
+
+	ans := make(map[int32]ConfigVersionTimes, len(versions))
+	for _, v := range versions {
+		var times ConfigVersionTimes
+		for _, t := range []struct {
+			name string
+			v    json.Number
+			dst  *time.Time
+		}{{"created", v.Created, &times.Created}, {"updated", v.Updated, &times.Updated}, {"deleted", v.Deleted, &times.Deleted}} {
+			if t.v == "" {
+				continue
+			}
+			f, err := t.v.Float64()
+			if err != nil {
+				return nil, fmt.Errorf("config version %d: invalid %s timestamp %q", v.Id, t.name, t.v)
+			}
+			if f != 0 {
+				*t.dst = epochTime(f)
+			}
+		}
+		ans[v.Id] = times
+	}
+	return ans, nil
+}
+
+// epochTime returns the time v seconds (or milliseconds, for values too
+// large to be seconds) after the epoch.
+func epochTime(v float64) time.Time {
+	if v > 1e11 {
+		return time.UnixMicro(int64(math.Round(v * 1e3))).UTC()
+	}
+	return time.UnixMicro(int64(math.Round(v * 1e6))).UTC()
+}
@@ -0,0 +1,157 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package config_operations
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var configVersionsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ConfigVersionsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ConfigVersionsListResponse) Validate() error {
+	return configVersionsListResponseSchema.Validate(o)
+}
+
+var jobsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the JobsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o JobsListResponse) Validate() error {
+	return jobsListResponseSchema.Validate(o)
+}
+
+var pushCandidateConfigVersionsRequestSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"devices": {Items: &validate.Rule{MaxLength: 16}},
+		"folder":  {Items: &validate.Rule{Pattern: `^[a-zA-Z\d-_\. ]+$`, MaxLength: 64}},
+	},
+}
+
+// Validate checks o against the constraints of the PushCandidateConfigVersions_request schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PushCandidateConfigVersionsRequest) Validate() error {
+	return pushCandidateConfigVersionsRequestSchema.Validate(o)
+}
+
+var configVersionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"admin":       {Required: true},
+		"created":     {Required: true},
+		"date":        {Required: true},
+		"deleted":     {Required: true},
+		"description": {Required: true},
+		"id":          {Required: true},
+		"scope":       {Required: true},
+		"updated":     {Required: true},
+		"version":     {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the config-version schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ConfigVersion) Validate() error {
+	return configVersionSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var jobsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device_name": {Required: true},
+		"end_ts":      {Required: true},
+		"id":          {Required: true},
+		"job_result":  {Required: true},
+		"job_status":  {Required: true},
+		"job_type":    {Required: true},
+		"parent_id":   {Required: true},
+		"percent":     {Required: true},
+		"result_str":  {Required: true, Enum: []string{"OK", "FAIL", "PEND", "WAIT", "CANCELLED", "TIMEOUT"}},
+		"start_ts":    {Required: true},
+		"status_str":  {Required: true, Enum: []string{"ACT", "FIN", "PEND", "PUSHSENT", "PUSHFAIL", "PUSHABORT", "PUSHTIMEOUT"}},
+		"summary":     {Required: true},
+		"type_str":    {Required: true, Enum: []string{"CommitAll", "CommitAndPush", "NGFW-Bootstrap-Push", "Validate"}},
+		"uname":       {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the jobs schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Jobs) Validate() error {
+	return jobsSchema.Validate(o)
+}
+
+var jobsResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the jobs-response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o JobsResponse) Validate() error {
+	return jobsResponseSchema.Validate(o)
+}
+
+var loadConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the load-config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LoadConfig) Validate() error {
+	return loadConfigSchema.Validate(o)
+}
+
+var runningConfigVersionsResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the running-config-versions-response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RunningConfigVersionsResponse) Validate() error {
+	return runningConfigVersionsResponseSchema.Validate(o)
+}
+
+var runningVersionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"date":    {Required: true},
+		"device":  {Required: true},
+		"version": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the running-versions schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RunningVersions) Validate() error {
+	return runningVersionsSchema.Validate(o)
+}
@@ -0,0 +1,98 @@
+// Code generated by scopegen. DO NOT EDIT.
+
+package config_setup
+
+import (
+	"context"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Variables) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Variables) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiCreateVariableRequest) Scope(s api.Scope) ApiCreateVariableRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListVariablesRequest) Scope(s api.Scope) ApiListVariablesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// FetchFoldersInScope is FetchFolders for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *FoldersAPIService) FetchFoldersInScope(ctx context.Context, name string, s api.Scope) (*Folders, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchFolders(ctx, name, folder, snippet, device)
+}
+
+// FetchLabelsInScope is FetchLabels for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LabelsAPIService) FetchLabelsInScope(ctx context.Context, name string, s api.Scope) (*Labels, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLabels(ctx, name, folder, snippet, device)
+}
+
+// FetchSnippetCategoriesInScope is FetchSnippetCategories for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SnippetCategoriesAPIService) FetchSnippetCategoriesInScope(ctx context.Context, name string, s api.Scope) (*SnippetCategories, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSnippetCategories(ctx, name, folder, snippet, device)
+}
+
+// FetchSnippetsInScope is FetchSnippets for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SnippetsAPIService) FetchSnippetsInScope(ctx context.Context, name string, s api.Scope) (*Snippets, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSnippets(ctx, name, folder, snippet, device)
+}
+
+// FetchVariablesInScope is FetchVariables for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *VariablesAPIService) FetchVariablesInScope(ctx context.Context, name string, s api.Scope) (*Variables, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchVariables(ctx, name, folder, snippet, device)
+}
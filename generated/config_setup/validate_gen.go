@@ -0,0 +1,590 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package config_setup
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var foldersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the FoldersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o FoldersListResponse) Validate() error {
+	return foldersListResponseSchema.Validate(o)
+}
+
+var labelsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LabelsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LabelsListResponse) Validate() error {
+	return labelsListResponseSchema.Validate(o)
+}
+
+var snippetCategoriesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SnippetCategoriesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetCategoriesListResponse) Validate() error {
+	return snippetCategoriesListResponseSchema.Validate(o)
+}
+
+var snippetsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SnippetsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetsListResponse) Validate() error {
+	return snippetsListResponseSchema.Validate(o)
+}
+
+var variablesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the VariablesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VariablesListResponse) Validate() error {
+	return variablesListResponseSchema.Validate(o)
+}
+
+var addSubscriberRequestPayloadInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"snippet_id":   {Required: true},
+		"snippet_name": {Required: true},
+		"tsg_id":       {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the add_subscriber_request_payload_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AddSubscriberRequestPayloadInner) Validate() error {
+	return addSubscriberRequestPayloadInnerSchema.Validate(o)
+}
+
+var commonSnippetSnapshotPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the common_snippet_snapshot_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CommonSnippetSnapshotPayload) Validate() error {
+	return commonSnippetSnapshotPayloadSchema.Validate(o)
+}
+
+var compareSnippetSnapshotConfigPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"comparing_version": {Required: true},
+		"id":                {Required: true},
+		"version":           {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the compare_snippet_snapshot_config_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CompareSnippetSnapshotConfigPayload) Validate() error {
+	return compareSnippetSnapshotConfigPayloadSchema.Validate(o)
+}
+
+var compareTloPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"object_id":  {Required: true},
+		"snippet_id": {Required: true},
+		"version":    {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the compare_tlo_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CompareTloPayload) Validate() error {
+	return compareTloPayloadSchema.Validate(o)
+}
+
+var deletedSubscriberSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the deleted_subscriber schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DeletedSubscriber) Validate() error {
+	return deletedSubscriberSchema.Validate(o)
+}
+
+var devicesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"folder": {Required: true},
+		"name":   {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the devices schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Devices) Validate() error {
+	return devicesSchema.Validate(o)
+}
+
+var devicesPutSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the devices-put schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DevicesPut) Validate() error {
+	return devicesPutSchema.Validate(o)
+}
+
+var devicesAvailableLicensessInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the devices_available_licensess_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DevicesAvailableLicensessInner) Validate() error {
+	return devicesAvailableLicensessInnerSchema.Validate(o)
+}
+
+var devicesInstalledLicensesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the devices_installed_licenses_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DevicesInstalledLicensesInner) Validate() error {
+	return devicesInstalledLicensesInnerSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var foldersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":   {Required: true},
+		"parent": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the folders schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Folders) Validate() error {
+	return foldersSchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var labelsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the labels schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Labels) Validate() error {
+	return labelsSchema.Validate(o)
+}
+
+var propertyItemSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the property_item schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PropertyItem) Validate() error {
+	return propertyItemSchema.Validate(o)
+}
+
+var saveSnippetSnapshotConfigResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the save_snippet_snapshot_config_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SaveSnippetSnapshotConfigResponse) Validate() error {
+	return saveSnippetSnapshotConfigResponseSchema.Validate(o)
+}
+
+var saveSnippetSnapshotConfigResponseResultSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the save_snippet_snapshot_config_response_result schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SaveSnippetSnapshotConfigResponseResult) Validate() error {
+	return saveSnippetSnapshotConfigResponseResultSchema.Validate(o)
+}
+
+var saveSnippetSnapshotPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {Required: true},
+		"id":          {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the save_snippet_snapshot_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SaveSnippetSnapshotPayload) Validate() error {
+	return saveSnippetSnapshotPayloadSchema.Validate(o)
+}
+
+var snippetAuditHistorySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_audit_history schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetAuditHistory) Validate() error {
+	return snippetAuditHistorySchema.Validate(o)
+}
+
+var snippetAuditPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_audit_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetAuditPayload) Validate() error {
+	return snippetAuditPayloadSchema.Validate(o)
+}
+
+var snippetCategoriesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_categories schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetCategories) Validate() error {
+	return snippetCategoriesSchema.Validate(o)
+}
+
+var snippetShareInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_share_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetShareInfo) Validate() error {
+	return snippetShareInfoSchema.Validate(o)
+}
+
+var snippetShareLoadPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"id": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the snippet_share_load_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetShareLoadPayload) Validate() error {
+	return snippetShareLoadPayloadSchema.Validate(o)
+}
+
+var snippetSharePropertySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_share_property schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetShareProperty) Validate() error {
+	return snippetSharePropertySchema.Validate(o)
+}
+
+var snippetShareUploadPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"id": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the snippet_share_upload_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetShareUploadPayload) Validate() error {
+	return snippetShareUploadPayloadSchema.Validate(o)
+}
+
+var snippetSnapshotCompareEntrySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"operations": {Enum: []string{"edit", "create"}},
+	},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_compare_entry schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotCompareEntry) Validate() error {
+	return snippetSnapshotCompareEntrySchema.Validate(o)
+}
+
+var snippetSnapshotDiffResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_diff_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotDiffResponse) Validate() error {
+	return snippetSnapshotDiffResponseSchema.Validate(o)
+}
+
+var snippetSnapshotDiffResponseAfterSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_diff_response_after schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotDiffResponseAfter) Validate() error {
+	return snippetSnapshotDiffResponseAfterSchema.Validate(o)
+}
+
+var snippetSnapshotDiffResponseBeforeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_diff_response_before schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotDiffResponseBefore) Validate() error {
+	return snippetSnapshotDiffResponseBeforeSchema.Validate(o)
+}
+
+var snippetSnapshotLoadSnippetPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"id":      {Required: true},
+		"version": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_load_snippet_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotLoadSnippetPayload) Validate() error {
+	return snippetSnapshotLoadSnippetPayloadSchema.Validate(o)
+}
+
+var snippetSnapshotLoadSnippetResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_load_snippet_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotLoadSnippetResponse) Validate() error {
+	return snippetSnapshotLoadSnippetResponseSchema.Validate(o)
+}
+
+var snippetSnapshotPublishRequestSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_publish_request schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotPublishRequest) Validate() error {
+	return snippetSnapshotPublishRequestSchema.Validate(o)
+}
+
+var snippetSnapshotPublishResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_publish_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotPublishResponse) Validate() error {
+	return snippetSnapshotPublishResponseSchema.Validate(o)
+}
+
+var snippetSnapshotSubscriberComparePayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"id":        {Required: true},
+		"tenant_id": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_subscriber_compare_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotSubscriberComparePayload) Validate() error {
+	return snippetSnapshotSubscriberComparePayloadSchema.Validate(o)
+}
+
+var snippetSnapshotSubscriberCompareResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_subscriber_compare_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotSubscriberCompareResponse) Validate() error {
+	return snippetSnapshotSubscriberCompareResponseSchema.Validate(o)
+}
+
+var snippetSnapshotSubscriberCompareResponsePublisherSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the snippet_snapshot_subscriber_compare_response_publisher schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SnippetSnapshotSubscriberCompareResponsePublisher) Validate() error {
+	return snippetSnapshotSubscriberCompareResponsePublisherSchema.Validate(o)
+}
+
+var snippetsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+		"type": {Enum: []string{"predefined", "custom", "readonly"}},
+	},
+}
+
+// Validate checks o against the constraints of the snippets schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Snippets) Validate() error {
+	return snippetsSchema.Validate(o)
+}
+
+var subscriberPropertyPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"snippet_id":   {Required: true},
+		"snippet_name": {Required: true},
+		"tsg_id":       {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the subscriber_property_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SubscriberPropertyPayload) Validate() error {
+	return subscriberPropertyPayloadSchema.Validate(o)
+}
+
+var tenantTrustInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the tenant_trust_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TenantTrustInfo) Validate() error {
+	return tenantTrustInfoSchema.Validate(o)
+}
+
+var trustInfoWithSharedSnippetsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the trust_info_with_shared_snippets schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrustInfoWithSharedSnippets) Validate() error {
+	return trustInfoWithSharedSnippetsSchema.Validate(o)
+}
+
+var trustedTenantOverviewSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the trusted_tenant_overview schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrustedTenantOverview) Validate() error {
+	return trustedTenantOverviewSchema.Validate(o)
+}
+
+var trustedTenantOverviewPublisherSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the trusted_tenant_overview_publisher schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrustedTenantOverviewPublisher) Validate() error {
+	return trustedTenantOverviewPublisherSchema.Validate(o)
+}
+
+var trustsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the trusts schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Trusts) Validate() error {
+	return trustsSchema.Validate(o)
+}
+
+var trustsValidationPayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"donor_tenant_name":     {Required: true},
+		"psk":                   {Required: true},
+		"recipient_tenant_name": {Required: true},
+		"trust_id":              {Required: true},
+		"tsg":                   {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the trusts_validation_payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrustsValidationPayload) Validate() error {
+	return trustsValidationPayloadSchema.Validate(o)
+}
+
+var usedFoldersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the used_folders schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UsedFolders) Validate() error {
+	return usedFoldersSchema.Validate(o)
+}
+
+var variablesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d_\-. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d_\-. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d_\-. ]+$`, MaxLength: 64},
+		"type":    {Required: true, Enum: []string{"percent", "count", "ip-netmask", "zone", "ip-range", "ip-wildcard", "device-priority", "device-id", "egress-max", "as-number", "fqdn", "port", "link-tag", "group-id", "rate", "router-id", "qos-profile", "timer"}},
+		"value":   {Required: true},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the variables schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Variables) Validate() error {
+	return variablesSchema.Validate(o)
+}
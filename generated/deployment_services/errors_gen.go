@@ -0,0 +1,17 @@
+// Code generated by errorsgen. DO NOT EDIT.
+
+package deployment_services
+
+import scmErrors "github.com/paloaltonetworks/scm-go/errors"
+
+// Unwrap returns the typed error of the scmErrors package for the error
+// response, so that errors.As and the scmErrors helpers see it.  The same
+// error is returned on every call; its header is the one recorded by the
+// scm API clients (see scmErrors.FromGenerated).  It returns nil if e is not
+// the error of a 4xx or 5xx response.
+func (e *GenericOpenAPIError) Unwrap() error {
+	if se := scmErrors.FromGenerated(e, e.error, e.body); se != nil {
+		return se
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Code generated by scopegen. DO NOT EDIT.
+
+package deployment_services
+
+import (
+	"context"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// FetchBandwidthAllocationsInScope is FetchBandwidthAllocations for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *BandwidthAllocationsAPIService) FetchBandwidthAllocationsInScope(ctx context.Context, name string, s api.Scope) (*BandwidthAllocations, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchBandwidthAllocations(ctx, name, folder, snippet, device)
+}
+
+// FetchInternalDNSServersInScope is FetchInternalDNSServers for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *InternalDNSServersAPIService) FetchInternalDNSServersInScope(ctx context.Context, name string, s api.Scope) (*InternalDnsServers, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchInternalDNSServers(ctx, name, folder, snippet, device)
+}
+
+// FetchRemoteNetworksInScope is FetchRemoteNetworks for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *RemoteNetworksAPIService) FetchRemoteNetworksInScope(ctx context.Context, name string, s api.Scope) (*RemoteNetworks, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchRemoteNetworks(ctx, name, folder, snippet, device)
+}
+
+// FetchServiceConnectionGroupsInScope is FetchServiceConnectionGroups for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ServiceConnectionGroupsAPIService) FetchServiceConnectionGroupsInScope(ctx context.Context, name string, s api.Scope) (*ServiceConnectionGroups, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchServiceConnectionGroups(ctx, name, folder, snippet, device)
+}
+
+// FetchServiceConnectionsInScope is FetchServiceConnections for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ServiceConnectionsAPIService) FetchServiceConnectionsInScope(ctx context.Context, name string, s api.Scope) (*ServiceConnections, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchServiceConnections(ctx, name, folder, snippet, device)
+}
+
+// FetchSitesInScope is FetchSites for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SitesAPIService) FetchSitesInScope(ctx context.Context, name string, s api.Scope) (*Sites, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSites(ctx, name, folder, snippet, device)
+}
+
+// FetchTrafficSteeringRulesInScope is FetchTrafficSteeringRules for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *TrafficSteeringRulesAPIService) FetchTrafficSteeringRulesInScope(ctx context.Context, name string, s api.Scope) (*TrafficSteeringRules, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchTrafficSteeringRules(ctx, name, folder, snippet, device)
+}
@@ -0,0 +1,470 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package deployment_services
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var bandwidthAllocationsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the BandwidthAllocationsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BandwidthAllocationsListResponse) Validate() error {
+	return bandwidthAllocationsListResponseSchema.Validate(o)
+}
+
+var internalDNSServersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the InternalDNSServersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternalDNSServersListResponse) Validate() error {
+	return internalDNSServersListResponseSchema.Validate(o)
+}
+
+var remoteNetworksListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RemoteNetworksListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RemoteNetworksListResponse) Validate() error {
+	return remoteNetworksListResponseSchema.Validate(o)
+}
+
+var serviceConnectionGroupsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ServiceConnectionGroupsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnectionGroupsListResponse) Validate() error {
+	return serviceConnectionGroupsListResponseSchema.Validate(o)
+}
+
+var serviceConnectionsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ServiceConnectionsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnectionsListResponse) Validate() error {
+	return serviceConnectionsListResponseSchema.Validate(o)
+}
+
+var sitesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SitesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SitesListResponse) Validate() error {
+	return sitesListResponseSchema.Validate(o)
+}
+
+var trafficSteeringRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the TrafficSteeringRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrafficSteeringRulesListResponse) Validate() error {
+	return trafficSteeringRulesListResponseSchema.Validate(o)
+}
+
+var bandwidthAllocationsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"allocated_bandwidth": {Required: true},
+		"name":                {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the bandwidth-allocations schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BandwidthAllocations) Validate() error {
+	return bandwidthAllocationsSchema.Validate(o)
+}
+
+var bandwidthAllocationsQosSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bandwidth_allocations_qos schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BandwidthAllocationsQos) Validate() error {
+	return bandwidthAllocationsQosSchema.Validate(o)
+}
+
+var bgpRoutingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"backbone_routing": {Enum: []string{"no-asymmetric-routing", "asymmetric-routing-only", "asymmetric-routing-with-load-share"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp-routing schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouting) Validate() error {
+	return bgpRoutingSchema.Validate(o)
+}
+
+var bgpRoutingRoutingPreferenceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_routing_routing_preference schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRoutingRoutingPreference) Validate() error {
+	return bgpRoutingRoutingPreferenceSchema.Validate(o)
+}
+
+var editSharedInfrastructureSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the edit-shared-infrastructure-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EditSharedInfrastructureSettings) Validate() error {
+	return editSharedInfrastructureSettingsSchema.Validate(o)
+}
+
+var editSharedInfrastructureSettingsConnectorApplicationBlocksSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"member": {MaxItems: 100, Items: &validate.Rule{Pattern: `^(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\/(?:[0-9]|[1-2][0-9]|3[0-2])$`}},
+	},
+}
+
+// Validate checks o against the constraints of the edit_shared_infrastructure_settings_connector_application_blocks schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EditSharedInfrastructureSettingsConnectorApplicationBlocks) Validate() error {
+	return editSharedInfrastructureSettingsConnectorApplicationBlocksSchema.Validate(o)
+}
+
+var editSharedInfrastructureSettingsConnectorConnectorBlocksSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"member": {MaxItems: 100, Items: &validate.Rule{Pattern: `^(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\/(?:[0-9]|[1-2][0-9]|3[0-2])$`}},
+	},
+}
+
+// Validate checks o against the constraints of the edit_shared_infrastructure_settings_connector_connector_blocks schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EditSharedInfrastructureSettingsConnectorConnectorBlocks) Validate() error {
+	return editSharedInfrastructureSettingsConnectorConnectorBlocksSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var internalDnsServersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"domain_name": {Required: true},
+		"name":        {Required: true},
+		"primary":     {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the internal-dns-servers schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternalDnsServers) Validate() error {
+	return internalDnsServersSchema.Validate(o)
+}
+
+var locationsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"latitude":  {Minimum: validate.Bound(-90), Maximum: validate.Bound(90)},
+		"longitude": {Minimum: validate.Bound(-180), Maximum: validate.Bound(180)},
+	},
+}
+
+// Validate checks o against the constraints of the locations schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Locations) Validate() error {
+	return locationsSchema.Validate(o)
+}
+
+var remoteNetworksSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ecmp_load_balancing": {Enum: []string{"enable", "disable"}},
+		"folder":              {Required: true},
+		"license_type":        {Required: true, MinLength: 1},
+		"name":                {Required: true, MaxLength: 63},
+		"region":              {Required: true, MinLength: 1},
+	},
+}
+
+// Validate checks o against the constraints of the remote-networks schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RemoteNetworks) Validate() error {
+	return remoteNetworksSchema.Validate(o)
+}
+
+var remoteNetworksProtocolBgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"peering_type": {Enum: []string{"exchange-v4-over-v4", "exchange-v4-v6-over-v4", "exchange-v4-over-v4-v6-over-v6", "exchange-v6-over-v6"}},
+	},
+}
+
+// Validate checks o against the constraints of the remote-networks-protocol-bgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RemoteNetworksProtocolBgp) Validate() error {
+	return remoteNetworksProtocolBgpSchema.Validate(o)
+}
+
+var remoteNetworksEcmpTunnelsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ipsec_tunnel": {Required: true},
+		"name":         {Required: true},
+		"protocol":     {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the remote_networks_ecmp_tunnels_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RemoteNetworksEcmpTunnelsInner) Validate() error {
+	return remoteNetworksEcmpTunnelsInnerSchema.Validate(o)
+}
+
+var remoteNetworksEcmpTunnelsInnerProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the remote_networks_ecmp_tunnels_inner_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RemoteNetworksEcmpTunnelsInnerProtocol) Validate() error {
+	return remoteNetworksEcmpTunnelsInnerProtocolSchema.Validate(o)
+}
+
+var remoteNetworksProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the remote_networks_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RemoteNetworksProtocol) Validate() error {
+	return remoteNetworksProtocolSchema.Validate(o)
+}
+
+var remoteNetworksProtocolBgpPeerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the remote_networks_protocol_bgp_peer schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RemoteNetworksProtocolBgpPeer) Validate() error {
+	return remoteNetworksProtocolBgpPeerSchema.Validate(o)
+}
+
+var serviceConnectionGroupsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":   {Required: true},
+		"target": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the service-connection-groups schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnectionGroups) Validate() error {
+	return serviceConnectionGroupsSchema.Validate(o)
+}
+
+var serviceConnectionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ipsec_tunnel":        {Required: true},
+		"name":                {Required: true},
+		"no_export_community": {Enum: []string{"Disabled", "Enabled-In", "Enabled-Out", "Enabled-Both"}},
+		"onboarding_type":     {Enum: []string{"classic"}},
+		"region":              {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the service-connections schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnections) Validate() error {
+	return serviceConnectionsSchema.Validate(o)
+}
+
+var serviceConnectionsBgpPeerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_connections_bgp_peer schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnectionsBgpPeer) Validate() error {
+	return serviceConnectionsBgpPeerSchema.Validate(o)
+}
+
+var serviceConnectionsProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_connections_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnectionsProtocol) Validate() error {
+	return serviceConnectionsProtocolSchema.Validate(o)
+}
+
+var serviceConnectionsProtocolBgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"peer_as": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the service_connections_protocol_bgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnectionsProtocolBgp) Validate() error {
+	return serviceConnectionsProtocolBgpSchema.Validate(o)
+}
+
+var serviceConnectionsQosSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_connections_qos schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceConnectionsQos) Validate() error {
+	return serviceConnectionsQosSchema.Validate(o)
+}
+
+var sharedInfrastructureSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the shared-infrastructure-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SharedInfrastructureSettings) Validate() error {
+	return sharedInfrastructureSettingsSchema.Validate(o)
+}
+
+var sitesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"license_type": {MaxLength: 63, Enum: []string{"FWAAS-SITE-25Mbps", "FWAAS-SITE-50Mbps", "FWAAS-SITE-250Mbps", "FWAAS-SITE-1000Mbps", "FWAAS-SITE-2500Mbps"}},
+		"name":         {Required: true, MaxLength: 63},
+		"type":         {Enum: []string{"prisma-sdwan", "third-party-branch", "third-party-discovered"}},
+	},
+}
+
+// Validate checks o against the constraints of the sites schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Sites) Validate() error {
+	return sitesSchema.Validate(o)
+}
+
+var sitesMembersInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"mode": {Required: true, Enum: []string{"active", "backup"}},
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the sites_members_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SitesMembersInner) Validate() error {
+	return sitesMembersInnerSchema.Validate(o)
+}
+
+var sitesQosSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the sites_qos schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SitesQos) Validate() error {
+	return sitesQosSchema.Validate(o)
+}
+
+var trafficSteeringRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":    {Required: true},
+		"service": {Required: true},
+		"source":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the traffic-steering-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrafficSteeringRules) Validate() error {
+	return trafficSteeringRulesSchema.Validate(o)
+}
+
+var trafficSteeringRulesActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the traffic_steering_rules_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrafficSteeringRulesAction) Validate() error {
+	return trafficSteeringRulesActionSchema.Validate(o)
+}
+
+var trafficSteeringRulesActionForwardSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the traffic_steering_rules_action_forward schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrafficSteeringRulesActionForward) Validate() error {
+	return trafficSteeringRulesActionForwardSchema.Validate(o)
+}
+
+var trafficSteeringRulesActionForwardForwardSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the traffic_steering_rules_action_forward_forward schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrafficSteeringRulesActionForwardForward) Validate() error {
+	return trafficSteeringRulesActionForwardForwardSchema.Validate(o)
+}
@@ -0,0 +1,416 @@
+// Code generated by scopegen. DO NOT EDIT.
+
+package device_settings
+
+import (
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AuthenticationSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AuthenticationSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ContentIdSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ContentIdSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *DeviceRedistributionCollector) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *DeviceRedistributionCollector) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *GeneralSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *GeneralSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *HaConfigurations) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *HaConfigurations) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *HaDevices) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *HaDevices) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ManagementInterface) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ManagementInterface) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *MotdBannerSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *MotdBannerSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ServiceRoute) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ServiceRoute) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ServiceSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ServiceSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SessionSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SessionSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SessionTimeouts) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SessionTimeouts) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *TcpSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *TcpSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *UpdateSchedule) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *UpdateSchedule) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *VpnSettings) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *VpnSettings) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAuthenticationSettingsRequest) Scope(s api.Scope) ApiListAuthenticationSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListContentIDSettingsRequest) Scope(s api.Scope) ApiListContentIDSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListDeviceRedistributionCollectorSettingsRequest) Scope(s api.Scope) ApiListDeviceRedistributionCollectorSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListGeneralSettingsRequest) Scope(s api.Scope) ApiListGeneralSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListHADevicesRequest) Scope(s api.Scope) ApiListHADevicesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLoginBannerSettingsRequest) Scope(s api.Scope) ApiListLoginBannerSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListManagementInterfaceSettingsRequest) Scope(s api.Scope) ApiListManagementInterfaceSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListServiceRouteSettingsRequest) Scope(s api.Scope) ApiListServiceRouteSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListServiceSettingsRequest) Scope(s api.Scope) ApiListServiceSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSessionSettingsRequest) Scope(s api.Scope) ApiListSessionSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSessionTimeoutsSettingsRequest) Scope(s api.Scope) ApiListSessionTimeoutsSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListTCPSettingsRequest) Scope(s api.Scope) ApiListTCPSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListUpdateScheduleSettingsRequest) Scope(s api.Scope) ApiListUpdateScheduleSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListVPNSettingsRequest) Scope(s api.Scope) ApiListVPNSettingsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
@@ -0,0 +1,1208 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package device_settings
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var listHADevices200ResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ListHADevices_200_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ListHADevices200Response) Validate() error {
+	return listHADevices200ResponseSchema.Validate(o)
+}
+
+var authenticationSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the authentication-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationSettings) Validate() error {
+	return authenticationSettingsSchema.Validate(o)
+}
+
+var authenticationSettingsAuthenticationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_settings_authentication schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationSettingsAuthentication) Validate() error {
+	return authenticationSettingsAuthenticationSchema.Validate(o)
+}
+
+var contentIdSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the content-id-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ContentIdSettings) Validate() error {
+	return contentIdSettingsSchema.Validate(o)
+}
+
+var contentIdSettingsContentIdSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"x_forwarded_for": {Minimum: validate.Bound(0), Maximum: validate.Bound(2)},
+	},
+}
+
+// Validate checks o against the constraints of the content_id_settings_content_id schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ContentIdSettingsContentId) Validate() error {
+	return contentIdSettingsContentIdSchema.Validate(o)
+}
+
+var contentIdSettingsContentIdApplicationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the content_id_settings_content_id_application schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ContentIdSettingsContentIdApplication) Validate() error {
+	return contentIdSettingsContentIdApplicationSchema.Validate(o)
+}
+
+var deviceRedistributionCollectorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the device-redistribution-collector schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DeviceRedistributionCollector) Validate() error {
+	return deviceRedistributionCollectorSchema.Validate(o)
+}
+
+var deviceRedistributionCollectorRedistributionCollectorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the device_redistribution_collector_redistribution_collector schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DeviceRedistributionCollectorRedistributionCollector) Validate() error {
+	return deviceRedistributionCollectorRedistributionCollectorSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var generalSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the general-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GeneralSettings) Validate() error {
+	return generalSettingsSchema.Validate(o)
+}
+
+var generalSettingsGeneralSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"locale": {Enum: []string{"en", "es", "ja", "fr", "zh_CN", "zh_TW"}},
+	},
+}
+
+// Validate checks o against the constraints of the general_settings_general schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GeneralSettingsGeneral) Validate() error {
+	return generalSettingsGeneralSchema.Validate(o)
+}
+
+var generalSettingsGeneralGeoLocationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the general_settings_general_geo_location schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GeneralSettingsGeneralGeoLocation) Validate() error {
+	return generalSettingsGeneralGeoLocationSchema.Validate(o)
+}
+
+var generalSettingsGeneralSettingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the general_settings_general_setting schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GeneralSettingsGeneralSetting) Validate() error {
+	return generalSettingsGeneralSettingSchema.Validate(o)
+}
+
+var generalSettingsGeneralSettingManagementSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the general_settings_general_setting_management schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GeneralSettingsGeneralSettingManagement) Validate() error {
+	return generalSettingsGeneralSettingManagementSchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var haConfigurationsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":    {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":    {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"group":     {Required: true},
+		"interface": {Required: true},
+		"snippet":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ha-configurations schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurations) Validate() error {
+	return haConfigurationsSchema.Validate(o)
+}
+
+var haDevicesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ha-devices schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaDevices) Validate() error {
+	return haDevicesSchema.Validate(o)
+}
+
+var haConfigurationsGroupSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"election_option":       {Required: true},
+		"group_id":              {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(63)},
+		"mode":                  {Required: true},
+		"monitoring":            {Required: true},
+		"peer_ip":               {Required: true},
+		"peer_serial":           {Required: true},
+		"state_synchronization": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroup) Validate() error {
+	return haConfigurationsGroupSchema.Validate(o)
+}
+
+var haConfigurationsGroupElectionOptionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device_priority": {Minimum: validate.Bound(1), Maximum: validate.Bound(2)},
+		"ha_role":         {Enum: []string{"primary", "secondary"}},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_election_option schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupElectionOption) Validate() error {
+	return haConfigurationsGroupElectionOptionSchema.Validate(o)
+}
+
+var haConfigurationsGroupModeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_mode schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMode) Validate() error {
+	return haConfigurationsGroupModeSchema.Validate(o)
+}
+
+var haConfigurationsGroupModeActivePassiveSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"monitor_fail_hold_down_time": {Minimum: validate.Bound(1000), Maximum: validate.Bound(60000)},
+		"passive_link_state":          {Enum: []string{"shutdown", "auto"}},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_mode_active_passive schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupModeActivePassive) Validate() error {
+	return haConfigurationsGroupModeActivePassiveSchema.Validate(o)
+}
+
+var haConfigurationsGroupMonitoringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_monitoring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMonitoring) Validate() error {
+	return haConfigurationsGroupMonitoringSchema.Validate(o)
+}
+
+var haConfigurationsGroupMonitoringLinkMonitoringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"failure_condition": {Enum: []string{"any", "all"}},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_monitoring_link_monitoring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMonitoringLinkMonitoring) Validate() error {
+	return haConfigurationsGroupMonitoringLinkMonitoringSchema.Validate(o)
+}
+
+var haConfigurationsGroupMonitoringLinkMonitoringLinkGroupInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"failure_condition": {Enum: []string{"any", "all"}},
+		"name":              {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_monitoring_link_monitoring_link_group_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMonitoringLinkMonitoringLinkGroupInner) Validate() error {
+	return haConfigurationsGroupMonitoringLinkMonitoringLinkGroupInnerSchema.Validate(o)
+}
+
+var haConfigurationsGroupMonitoringPathMonitoringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"failure_condition": {Enum: []string{"any", "all"}},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_monitoring_path_monitoring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMonitoringPathMonitoring) Validate() error {
+	return haConfigurationsGroupMonitoringPathMonitoringSchema.Validate(o)
+}
+
+var haConfigurationsGroupMonitoringPathMonitoringPathGroupSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_monitoring_path_monitoring_path_group schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMonitoringPathMonitoringPathGroup) Validate() error {
+	return haConfigurationsGroupMonitoringPathMonitoringPathGroupSchema.Validate(o)
+}
+
+var haConfigurationsGroupMonitoringPathMonitoringPathGroupLogicalRouterInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"failure_condition": {Enum: []string{"any", "all"}},
+		"name":              {Required: true},
+		"ping_count":        {Minimum: validate.Bound(3), Maximum: validate.Bound(10)},
+		"ping_interval":     {Minimum: validate.Bound(200), Maximum: validate.Bound(60000)},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_monitoring_path_monitoring_path_group_logical_router_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMonitoringPathMonitoringPathGroupLogicalRouterInner) Validate() error {
+	return haConfigurationsGroupMonitoringPathMonitoringPathGroupLogicalRouterInnerSchema.Validate(o)
+}
+
+var haConfigurationsGroupMonitoringPathMonitoringPathGroupLogicalRouterInnerDestinationIpGroupInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"failure_condition": {Enum: []string{"any", "all"}},
+		"name":              {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_monitoring_path_monitoring_path_group_logical_router_inner_destination_ip_group_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupMonitoringPathMonitoringPathGroupLogicalRouterInnerDestinationIpGroupInner) Validate() error {
+	return haConfigurationsGroupMonitoringPathMonitoringPathGroupLogicalRouterInnerDestinationIpGroupInnerSchema.Validate(o)
+}
+
+var haConfigurationsGroupStateSynchronizationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"transport": {Enum: []string{"ethernet", "ip", "udp"}},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_state_synchronization schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupStateSynchronization) Validate() error {
+	return haConfigurationsGroupStateSynchronizationSchema.Validate(o)
+}
+
+var haConfigurationsGroupStateSynchronizationHa2KeepAliveSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":    {Enum: []string{"log-only", "split-datapath"}},
+		"threshold": {Minimum: validate.Bound(5000), Maximum: validate.Bound(60000)},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_group_state_synchronization_ha2_keep_alive schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsGroupStateSynchronizationHa2KeepAlive) Validate() error {
+	return haConfigurationsGroupStateSynchronizationHa2KeepAliveSchema.Validate(o)
+}
+
+var haConfigurationsInterfaceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ha1": {Required: true},
+		"ha2": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_interface schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsInterface) Validate() error {
+	return haConfigurationsInterfaceSchema.Validate(o)
+}
+
+var haConfigurationsInterfaceHa1Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"monitor_hold_time": {Required: true, Minimum: validate.Bound(1000), Maximum: validate.Bound(60000)},
+		"port":              {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_interface_ha1 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsInterfaceHa1) Validate() error {
+	return haConfigurationsInterfaceHa1Schema.Validate(o)
+}
+
+var haConfigurationsInterfaceHa1BackupSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ha_configurations_interface_ha1_backup schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsInterfaceHa1Backup) Validate() error {
+	return haConfigurationsInterfaceHa1BackupSchema.Validate(o)
+}
+
+var haConfigurationsInterfaceHa2Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ip_address": {Required: true},
+		"netmask":    {Required: true},
+		"port":       {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ha_configurations_interface_ha2 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsInterfaceHa2) Validate() error {
+	return haConfigurationsInterfaceHa2Schema.Validate(o)
+}
+
+var haConfigurationsInterfaceHa2BackupSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ha_configurations_interface_ha2_backup schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaConfigurationsInterfaceHa2Backup) Validate() error {
+	return haConfigurationsInterfaceHa2BackupSchema.Validate(o)
+}
+
+var haDevicesHaDevicesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ha_devices_ha_devices_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HaDevicesHaDevicesInner) Validate() error {
+	return haDevicesHaDevicesInnerSchema.Validate(o)
+}
+
+var managementInterfaceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the management-interface schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ManagementInterface) Validate() error {
+	return managementInterfaceSchema.Validate(o)
+}
+
+var managementInterfaceManagementInterfaceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"speed_duplex": {Enum: []string{"auto-negotiate", "10Mbps-half-duplex", "10Mbps-full-duplex", "100Mbps-half-duplex", "100Mbps-full-duplex", "1Gbps-half-duplex", "1Gbps-full-duplex"}},
+	},
+}
+
+// Validate checks o against the constraints of the management_interface_management_interface schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ManagementInterfaceManagementInterface) Validate() error {
+	return managementInterfaceManagementInterfaceSchema.Validate(o)
+}
+
+var managementInterfaceManagementInterfaceMgmtTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the management_interface_management_interface_mgmt_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ManagementInterfaceManagementInterfaceMgmtType) Validate() error {
+	return managementInterfaceManagementInterfaceMgmtTypeSchema.Validate(o)
+}
+
+var managementInterfaceManagementInterfaceMgmtTypeDhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the management_interface_management_interface_mgmt_type_dhcp_client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ManagementInterfaceManagementInterfaceMgmtTypeDhcpClient) Validate() error {
+	return managementInterfaceManagementInterfaceMgmtTypeDhcpClientSchema.Validate(o)
+}
+
+var managementInterfaceManagementInterfacePermittedIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the management_interface_management_interface_permitted_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ManagementInterfaceManagementInterfacePermittedIpInner) Validate() error {
+	return managementInterfaceManagementInterfacePermittedIpInnerSchema.Validate(o)
+}
+
+var managementInterfaceManagementInterfaceServiceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the management_interface_management_interface_service schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ManagementInterfaceManagementInterfaceService) Validate() error {
+	return managementInterfaceManagementInterfaceServiceSchema.Validate(o)
+}
+
+var motdBannerSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the motd-banner-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MotdBannerSettings) Validate() error {
+	return motdBannerSettingsSchema.Validate(o)
+}
+
+var motdBannerSettingsMotdAndBannerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"banner_footer_color":      {Enum: []string{"color1", "color2", "color3", "color4", "color5", "color6", "color7", "color8", "color9", "color10", "color11", "color12", "color13", "color14", "color15", "color16", "color17"}},
+		"banner_footer_text_color": {Enum: []string{"color1", "color2", "color3", "color4", "color5", "color6", "color7", "color8", "color9", "color10", "color11", "color12", "color13", "color14", "color15", "color16", "color17"}},
+		"banner_header_color":      {Enum: []string{"color1", "color2", "color3", "color4", "color5", "color6", "color7", "color8", "color9", "color10", "color11", "color12", "color13", "color14", "color15", "color16", "color17"}},
+		"banner_header_text_color": {Enum: []string{"color1", "color2", "color3", "color4", "color5", "color6", "color7", "color8", "color9", "color10", "color11", "color12", "color13", "color14", "color15", "color16", "color17"}},
+		"motd_color":               {Enum: []string{"color1", "color2", "color3", "color4", "color5", "color6", "color7", "color8", "color9", "color10", "color11", "color12", "color13", "color14", "color15", "color16", "color17"}},
+		"severity":                 {Enum: []string{"warning", "question", "error", "info"}},
+	},
+}
+
+// Validate checks o against the constraints of the motd_banner_settings_motd_and_banner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MotdBannerSettingsMotdAndBanner) Validate() error {
+	return motdBannerSettingsMotdAndBannerSchema.Validate(o)
+}
+
+var serviceRouteSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the service-route schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceRoute) Validate() error {
+	return serviceRouteSchema.Validate(o)
+}
+
+var serviceSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the service-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettings) Validate() error {
+	return serviceSettingsSchema.Validate(o)
+}
+
+var serviceRouteRouteSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_route_route schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceRouteRoute) Validate() error {
+	return serviceRouteRouteSchema.Validate(o)
+}
+
+var serviceRouteRouteDestinationInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_route_route_destination_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceRouteRouteDestinationInner) Validate() error {
+	return serviceRouteRouteDestinationInnerSchema.Validate(o)
+}
+
+var serviceRouteRouteDestinationInnerSourceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_route_route_destination_inner_source schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceRouteRouteDestinationInnerSource) Validate() error {
+	return serviceRouteRouteDestinationInnerSourceSchema.Validate(o)
+}
+
+var serviceRouteRouteServiceInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Enum: []string{"autofocus", "crl-status", "data-services", "ddns", "deployments", "dns", "edl-updates", "email", "hsm", "http", "iot", "kerberos", "ldap", "mdm", "mfa", "netflow", "ntp", "paloalto-networks-services", "panorama", "panorama-log-forwarding", "proxy", "radius", "scep", "snmp", "syslog", "tacplus", "uid-agent", "url-updates", "vmmonitor", "wildfire-private", "ztp"}},
+	},
+}
+
+// Validate checks o against the constraints of the service_route_route_service_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceRouteRouteServiceInner) Validate() error {
+	return serviceRouteRouteServiceInnerSchema.Validate(o)
+}
+
+var serviceRouteRouteServiceInnerSourceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_route_route_service_inner_source schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceRouteRouteServiceInnerSource) Validate() error {
+	return serviceRouteRouteServiceInnerSourceSchema.Validate(o)
+}
+
+var serviceRouteRouteServiceInnerSourceV6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_route_route_service_inner_source_v6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceRouteRouteServiceInnerSourceV6) Validate() error {
+	return serviceRouteRouteServiceInnerSourceV6Schema.Validate(o)
+}
+
+var serviceSettingsServicesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServices) Validate() error {
+	return serviceSettingsServicesSchema.Validate(o)
+}
+
+var serviceSettingsServicesDnsSettingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_dns_setting schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesDnsSetting) Validate() error {
+	return serviceSettingsServicesDnsSettingSchema.Validate(o)
+}
+
+var serviceSettingsServicesDnsSettingServersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_dns_setting_servers schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesDnsSettingServers) Validate() error {
+	return serviceSettingsServicesDnsSettingServersSchema.Validate(o)
+}
+
+var serviceSettingsServicesNtpServersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_ntp_servers schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesNtpServers) Validate() error {
+	return serviceSettingsServicesNtpServersSchema.Validate(o)
+}
+
+var serviceSettingsServicesNtpServersPrimaryNtpServerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_ntp_servers_primary_ntp_server schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesNtpServersPrimaryNtpServer) Validate() error {
+	return serviceSettingsServicesNtpServersPrimaryNtpServerSchema.Validate(o)
+}
+
+var serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_ntp_servers_primary_ntp_server_authentication_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesNtpServersPrimaryNtpServerAuthenticationType) Validate() error {
+	return serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSchema.Validate(o)
+}
+
+var serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_ntp_servers_primary_ntp_server_authentication_type_symmetric_key schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKey) Validate() error {
+	return serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeySchema.Validate(o)
+}
+
+var serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeyAlgorithmSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_ntp_servers_primary_ntp_server_authentication_type_symmetric_key_algorithm schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeyAlgorithm) Validate() error {
+	return serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeyAlgorithmSchema.Validate(o)
+}
+
+var serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeyAlgorithmMd5Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the service_settings_services_ntp_servers_primary_ntp_server_authentication_type_symmetric_key_algorithm_md5 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeyAlgorithmMd5) Validate() error {
+	return serviceSettingsServicesNtpServersPrimaryNtpServerAuthenticationTypeSymmetricKeyAlgorithmMd5Schema.Validate(o)
+}
+
+var sessionSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the session-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionSettings) Validate() error {
+	return sessionSettingsSchema.Validate(o)
+}
+
+var sessionTimeoutsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the session-timeouts schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionTimeouts) Validate() error {
+	return sessionTimeoutsSchema.Validate(o)
+}
+
+var sessionSettingsSessionSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"accelerated_aging_scaling_factor":                 {Minimum: validate.Bound(2), Maximum: validate.Bound(16)},
+		"accelerated_aging_threshold":                      {Minimum: validate.Bound(50), Maximum: validate.Bound(99)},
+		"icmp_unreachable_rate":                            {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"max_pending_mcast_pkts_per_session":               {Minimum: validate.Bound(1), Maximum: validate.Bound(2000)},
+		"packet_buffer_protection_activate":                {Minimum: validate.Bound(0), Maximum: validate.Bound(99)},
+		"packet_buffer_protection_alert":                   {Minimum: validate.Bound(0), Maximum: validate.Bound(99)},
+		"packet_buffer_protection_block_countdown":         {Minimum: validate.Bound(0), Maximum: validate.Bound(99)},
+		"packet_buffer_protection_block_duration_time":     {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"packet_buffer_protection_block_hold_time":         {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+		"packet_buffer_protection_latency_activate":        {Minimum: validate.Bound(1), Maximum: validate.Bound(20000)},
+		"packet_buffer_protection_latency_alert":           {Minimum: validate.Bound(1), Maximum: validate.Bound(20000)},
+		"packet_buffer_protection_latency_block_countdown": {Minimum: validate.Bound(1), Maximum: validate.Bound(20000)},
+		"packet_buffer_protection_latency_max_tolerate":    {Minimum: validate.Bound(1), Maximum: validate.Bound(20000)},
+	},
+}
+
+// Validate checks o against the constraints of the session_settings_session_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionSettingsSessionSettings) Validate() error {
+	return sessionSettingsSessionSettingsSchema.Validate(o)
+}
+
+var sessionSettingsSessionSettingsConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the session_settings_session_settings_config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionSettingsSessionSettingsConfig) Validate() error {
+	return sessionSettingsSessionSettingsConfigSchema.Validate(o)
+}
+
+var sessionSettingsSessionSettingsIcmpv6RateLimitSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"bucket_size": {Minimum: validate.Bound(10), Maximum: validate.Bound(65535)},
+		"packet_rate": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the session_settings_session_settings_icmpv6_rate_limit schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionSettingsSessionSettingsIcmpv6RateLimit) Validate() error {
+	return sessionSettingsSessionSettingsIcmpv6RateLimitSchema.Validate(o)
+}
+
+var sessionSettingsSessionSettingsJumboFrameSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"mtu": {Minimum: validate.Bound(512), Maximum: validate.Bound(9216)},
+	},
+}
+
+// Validate checks o against the constraints of the session_settings_session_settings_jumbo_frame schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionSettingsSessionSettingsJumboFrame) Validate() error {
+	return sessionSettingsSessionSettingsJumboFrameSchema.Validate(o)
+}
+
+var sessionSettingsSessionSettingsNatSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"dipp_oversub": {Enum: []string{"1x", "2x", "4x", "8x"}},
+	},
+}
+
+// Validate checks o against the constraints of the session_settings_session_settings_nat schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionSettingsSessionSettingsNat) Validate() error {
+	return sessionSettingsSessionSettingsNatSchema.Validate(o)
+}
+
+var sessionSettingsSessionSettingsNat64Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ipv6_min_network_mtu": {Minimum: validate.Bound(1280), Maximum: validate.Bound(9216)},
+	},
+}
+
+// Validate checks o against the constraints of the session_settings_session_settings_nat64 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionSettingsSessionSettingsNat64) Validate() error {
+	return sessionSettingsSessionSettingsNat64Schema.Validate(o)
+}
+
+var sessionTimeoutsSessionTimeoutsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"timeout_captive_portal":     {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"timeout_default":            {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"timeout_discard_default":    {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"timeout_discard_tcp":        {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"timeout_discard_udp":        {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"timeout_icmp":               {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"timeout_scan":               {Minimum: validate.Bound(5), Maximum: validate.Bound(30)},
+		"timeout_tcp":                {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+		"timeout_tcp_half_closed":    {Minimum: validate.Bound(1), Maximum: validate.Bound(604800)},
+		"timeout_tcp_time_wait":      {Minimum: validate.Bound(1), Maximum: validate.Bound(600)},
+		"timeout_tcp_unverified_rst": {Minimum: validate.Bound(1), Maximum: validate.Bound(600)},
+		"timeout_tcphandshake":       {Minimum: validate.Bound(1), Maximum: validate.Bound(60)},
+		"timeout_tcpinit":            {Minimum: validate.Bound(1), Maximum: validate.Bound(60)},
+		"timeout_udp":                {Minimum: validate.Bound(1), Maximum: validate.Bound(1.5999999e+07)},
+	},
+}
+
+// Validate checks o against the constraints of the session_timeouts_session_timeouts schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SessionTimeoutsSessionTimeouts) Validate() error {
+	return sessionTimeoutsSessionTimeoutsSchema.Validate(o)
+}
+
+var tcpSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the tcp-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TcpSettings) Validate() error {
+	return tcpSettingsSchema.Validate(o)
+}
+
+var tcpSettingsTcpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"asymmetric_path":        {Enum: []string{"drop", "bypass"}},
+		"siptcp_cleartext_proxy": {Enum: []string{"0", "2", "3"}},
+		"urgent_data":            {Enum: []string{"clear", "oobinline"}},
+	},
+}
+
+// Validate checks o against the constraints of the tcp_settings_tcp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TcpSettingsTcp) Validate() error {
+	return tcpSettingsTcpSchema.Validate(o)
+}
+
+var updateScheduleSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the update-schedule schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateSchedule) Validate() error {
+	return updateScheduleSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"anti_virus": {Required: true},
+		"threats":    {Required: true},
+		"wildfire":   {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateSchedule) Validate() error {
+	return updateScheduleUpdateScheduleSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleAntiVirusSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"recurring": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_anti_virus schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleAntiVirus) Validate() error {
+	return updateScheduleUpdateScheduleAntiVirusSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleAntiVirusRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"sync_to_peer": {Required: true},
+		"threshold":    {Minimum: validate.Bound(1), Maximum: validate.Bound(336)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_anti_virus_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleAntiVirusRecurring) Validate() error {
+	return updateScheduleUpdateScheduleAntiVirusRecurringSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleAntiVirusRecurringDailySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Required: true, Pattern: `^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]$`},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_anti_virus_recurring_daily schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleAntiVirusRecurringDaily) Validate() error {
+	return updateScheduleUpdateScheduleAntiVirusRecurringDailySchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleAntiVirusRecurringHourlySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(59)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_anti_virus_recurring_hourly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleAntiVirusRecurringHourly) Validate() error {
+	return updateScheduleUpdateScheduleAntiVirusRecurringHourlySchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleAntiVirusRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":      {Enum: []string{"download-only", "download-and-install"}},
+		"at":          {Pattern: `^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]$`},
+		"day_of_week": {Enum: []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_anti_virus_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleAntiVirusRecurringWeekly) Validate() error {
+	return updateScheduleUpdateScheduleAntiVirusRecurringWeeklySchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleThreatsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"recurring": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_threats schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleThreats) Validate() error {
+	return updateScheduleUpdateScheduleThreatsSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleThreatsRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"new_app_threshold": {Minimum: validate.Bound(1), Maximum: validate.Bound(336)},
+		"sync_to_peer":      {Required: true},
+		"threshold":         {Minimum: validate.Bound(1), Maximum: validate.Bound(336)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_threats_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleThreatsRecurring) Validate() error {
+	return updateScheduleUpdateScheduleThreatsRecurringSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleThreatsRecurringDailySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Required: true, Pattern: `^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]$`},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_threats_recurring_daily schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleThreatsRecurringDaily) Validate() error {
+	return updateScheduleUpdateScheduleThreatsRecurringDailySchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleThreatsRecurringEvery30MinsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Minimum: validate.Bound(0), Maximum: validate.Bound(29)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_threats_recurring_every_30_mins schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleThreatsRecurringEvery30Mins) Validate() error {
+	return updateScheduleUpdateScheduleThreatsRecurringEvery30MinsSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleThreatsRecurringHourlySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(59)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_threats_recurring_hourly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleThreatsRecurringHourly) Validate() error {
+	return updateScheduleUpdateScheduleThreatsRecurringHourlySchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleThreatsRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":      {Enum: []string{"download-only", "download-and-install"}},
+		"at":          {Required: true, Pattern: `^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]$`},
+		"day_of_week": {Required: true, Enum: []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_threats_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleThreatsRecurringWeekly) Validate() error {
+	return updateScheduleUpdateScheduleThreatsRecurringWeeklySchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleWildfireSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"recurring": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_wildfire schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleWildfire) Validate() error {
+	return updateScheduleUpdateScheduleWildfireSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleWildfireRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_wildfire_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleWildfireRecurring) Validate() error {
+	return updateScheduleUpdateScheduleWildfireRecurringSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleWildfireRecurringEvery15MinsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Minimum: validate.Bound(0), Maximum: validate.Bound(14)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_wildfire_recurring_every_15_mins schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleWildfireRecurringEvery15Mins) Validate() error {
+	return updateScheduleUpdateScheduleWildfireRecurringEvery15MinsSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleWildfireRecurringEvery30MinsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Minimum: validate.Bound(0), Maximum: validate.Bound(29)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_wildfire_recurring_every_30_mins schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleWildfireRecurringEvery30Mins) Validate() error {
+	return updateScheduleUpdateScheduleWildfireRecurringEvery30MinsSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleWildfireRecurringEveryHourSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+		"at":     {Minimum: validate.Bound(0), Maximum: validate.Bound(59)},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_wildfire_recurring_every_hour schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleWildfireRecurringEveryHour) Validate() error {
+	return updateScheduleUpdateScheduleWildfireRecurringEveryHourSchema.Validate(o)
+}
+
+var updateScheduleUpdateScheduleWildfireRecurringEveryMinSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"download-only", "download-and-install"}},
+	},
+}
+
+// Validate checks o against the constraints of the update_schedule_update_schedule_wildfire_recurring_every_min schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UpdateScheduleUpdateScheduleWildfireRecurringEveryMin) Validate() error {
+	return updateScheduleUpdateScheduleWildfireRecurringEveryMinSchema.Validate(o)
+}
+
+var vpnSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the vpn-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VpnSettings) Validate() error {
+	return vpnSettingsSchema.Validate(o)
+}
+
+var vpnSettingsVpnSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vpn_settings_vpn schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VpnSettingsVpn) Validate() error {
+	return vpnSettingsVpnSchema.Validate(o)
+}
+
+var vpnSettingsVpnIkev2Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"certificate_cache_size": {Minimum: validate.Bound(0), Maximum: validate.Bound(4000)},
+		"cookie_threshold":       {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+		"max_half_opened_sa":     {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the vpn_settings_vpn_ikev2 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VpnSettingsVpnIkev2) Validate() error {
+	return vpnSettingsVpnIkev2Schema.Validate(o)
+}
@@ -0,0 +1,640 @@
+// Code generated by pagergen. DO NOT EDIT.
+
+package identity_services
+
+import (
+	"context"
+	"iter"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// All returns an iterator over every AuthenticationPortals object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAuthenticationPortals(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAuthenticationPortalsRequest) All(opts ...api.PageOptions) iter.Seq2[AuthenticationPortals, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AuthenticationPortals, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAuthenticationPortalsAll returns an iterator over every AuthenticationPortals object.
+//
+// It is a shorthand for ListAuthenticationPortals(ctx).All(opts...), see ApiListAuthenticationPortalsRequest.All.
+func (a *AuthenticationPortalsAPIService) ListAuthenticationPortalsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AuthenticationPortals, error] {
+	return a.ListAuthenticationPortals(ctx).All(opts...)
+}
+
+// All returns an iterator over every AuthenticationProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAuthenticationProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAuthenticationProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[AuthenticationProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AuthenticationProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAuthenticationProfilesAll returns an iterator over every AuthenticationProfiles object.
+//
+// It is a shorthand for ListAuthenticationProfiles(ctx).All(opts...), see ApiListAuthenticationProfilesRequest.All.
+func (a *AuthenticationProfilesAPIService) ListAuthenticationProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AuthenticationProfiles, error] {
+	return a.ListAuthenticationProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every AuthenticationRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAuthenticationRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAuthenticationRulesRequest) All(opts ...api.PageOptions) iter.Seq2[AuthenticationRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AuthenticationRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAuthenticationRulesAll returns an iterator over every AuthenticationRules object.
+//
+// It is a shorthand for ListAuthenticationRules(ctx).All(opts...), see ApiListAuthenticationRulesRequest.All.
+func (a *AuthenticationRulesAPIService) ListAuthenticationRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AuthenticationRules, error] {
+	return a.ListAuthenticationRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every AuthenticationSequences object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAuthenticationSequences(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAuthenticationSequencesRequest) All(opts ...api.PageOptions) iter.Seq2[AuthenticationSequences, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AuthenticationSequences, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAuthenticationSequencesAll returns an iterator over every AuthenticationSequences object.
+//
+// It is a shorthand for ListAuthenticationSequences(ctx).All(opts...), see ApiListAuthenticationSequencesRequest.All.
+func (a *AuthenticationSequencesAPIService) ListAuthenticationSequencesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AuthenticationSequences, error] {
+	return a.ListAuthenticationSequences(ctx).All(opts...)
+}
+
+// All returns an iterator over every CertificateProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListCertificateProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListCertificateProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[CertificateProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]CertificateProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListCertificateProfilesAll returns an iterator over every CertificateProfiles object.
+//
+// It is a shorthand for ListCertificateProfiles(ctx).All(opts...), see ApiListCertificateProfilesRequest.All.
+func (a *CertificateProfilesAPIService) ListCertificateProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[CertificateProfiles, error] {
+	return a.ListCertificateProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every CertificatesGet object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListCertificates(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListCertificatesRequest) All(opts ...api.PageOptions) iter.Seq2[CertificatesGet, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]CertificatesGet, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListCertificatesAll returns an iterator over every CertificatesGet object.
+//
+// It is a shorthand for ListCertificates(ctx).All(opts...), see ApiListCertificatesRequest.All.
+func (a *CertificatesAPIService) ListCertificatesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[CertificatesGet, error] {
+	return a.ListCertificates(ctx).All(opts...)
+}
+
+// All returns an iterator over every KerberosServerProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListKerberosServerProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListKerberosServerProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[KerberosServerProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]KerberosServerProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListKerberosServerProfilesAll returns an iterator over every KerberosServerProfiles object.
+//
+// It is a shorthand for ListKerberosServerProfiles(ctx).All(opts...), see ApiListKerberosServerProfilesRequest.All.
+func (a *KerberosServerProfilesAPIService) ListKerberosServerProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[KerberosServerProfiles, error] {
+	return a.ListKerberosServerProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every LdapServerProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLDAPServerProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLDAPServerProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[LdapServerProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LdapServerProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLDAPServerProfilesAll returns an iterator over every LdapServerProfiles object.
+//
+// It is a shorthand for ListLDAPServerProfiles(ctx).All(opts...), see ApiListLDAPServerProfilesRequest.All.
+func (a *LDAPServerProfilesAPIService) ListLDAPServerProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LdapServerProfiles, error] {
+	return a.ListLDAPServerProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every LocalUserGroups object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLocalUserGroups(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLocalUserGroupsRequest) All(opts ...api.PageOptions) iter.Seq2[LocalUserGroups, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LocalUserGroups, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLocalUserGroupsAll returns an iterator over every LocalUserGroups object.
+//
+// It is a shorthand for ListLocalUserGroups(ctx).All(opts...), see ApiListLocalUserGroupsRequest.All.
+func (a *LocalUserGroupsAPIService) ListLocalUserGroupsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LocalUserGroups, error] {
+	return a.ListLocalUserGroups(ctx).All(opts...)
+}
+
+// All returns an iterator over every LocalUsers object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLocalUsers(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLocalUsersRequest) All(opts ...api.PageOptions) iter.Seq2[LocalUsers, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LocalUsers, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLocalUsersAll returns an iterator over every LocalUsers object.
+//
+// It is a shorthand for ListLocalUsers(ctx).All(opts...), see ApiListLocalUsersRequest.All.
+func (a *LocalUsersAPIService) ListLocalUsersAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LocalUsers, error] {
+	return a.ListLocalUsers(ctx).All(opts...)
+}
+
+// All returns an iterator over every MfaServers object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListMFAServers(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListMFAServersRequest) All(opts ...api.PageOptions) iter.Seq2[MfaServers, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]MfaServers, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListMFAServersAll returns an iterator over every MfaServers object.
+//
+// It is a shorthand for ListMFAServers(ctx).All(opts...), see ApiListMFAServersRequest.All.
+func (a *MFAServersAPIService) ListMFAServersAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[MfaServers, error] {
+	return a.ListMFAServers(ctx).All(opts...)
+}
+
+// All returns an iterator over every OcspResponders object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListOCSPResponders(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListOCSPRespondersRequest) All(opts ...api.PageOptions) iter.Seq2[OcspResponders, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]OcspResponders, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListOCSPRespondersAll returns an iterator over every OcspResponders object.
+//
+// It is a shorthand for ListOCSPResponders(ctx).All(opts...), see ApiListOCSPRespondersRequest.All.
+func (a *OCSPRespondersAPIService) ListOCSPRespondersAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[OcspResponders, error] {
+	return a.ListOCSPResponders(ctx).All(opts...)
+}
+
+// All returns an iterator over every RadiusServerProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListRADIUSServerProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListRADIUSServerProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[RadiusServerProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]RadiusServerProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListRADIUSServerProfilesAll returns an iterator over every RadiusServerProfiles object.
+//
+// It is a shorthand for ListRADIUSServerProfiles(ctx).All(opts...), see ApiListRADIUSServerProfilesRequest.All.
+func (a *RADIUSServerProfilesAPIService) ListRADIUSServerProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[RadiusServerProfiles, error] {
+	return a.ListRADIUSServerProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every SamlServerProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSAMLServerProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSAMLServerProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[SamlServerProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SamlServerProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSAMLServerProfilesAll returns an iterator over every SamlServerProfiles object.
+//
+// It is a shorthand for ListSAMLServerProfiles(ctx).All(opts...), see ApiListSAMLServerProfilesRequest.All.
+func (a *SAMLServerProfilesAPIService) ListSAMLServerProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SamlServerProfiles, error] {
+	return a.ListSAMLServerProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every ScepProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSCEPProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSCEPProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[ScepProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ScepProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSCEPProfilesAll returns an iterator over every ScepProfiles object.
+//
+// It is a shorthand for ListSCEPProfiles(ctx).All(opts...), see ApiListSCEPProfilesRequest.All.
+func (a *SCEPProfilesAPIService) ListSCEPProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ScepProfiles, error] {
+	return a.ListSCEPProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every TacacsServerProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListTACACSServerProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListTACACSServerProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[TacacsServerProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]TacacsServerProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListTACACSServerProfilesAll returns an iterator over every TacacsServerProfiles object.
+//
+// It is a shorthand for ListTACACSServerProfiles(ctx).All(opts...), see ApiListTACACSServerProfilesRequest.All.
+func (a *TACACSServerProfilesAPIService) ListTACACSServerProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[TacacsServerProfiles, error] {
+	return a.ListTACACSServerProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every TlsServiceProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListTLSServiceProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListTLSServiceProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[TlsServiceProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]TlsServiceProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListTLSServiceProfilesAll returns an iterator over every TlsServiceProfiles object.
+//
+// It is a shorthand for ListTLSServiceProfiles(ctx).All(opts...), see ApiListTLSServiceProfilesRequest.All.
+func (a *TLSServiceProfilesAPIService) ListTLSServiceProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[TlsServiceProfiles, error] {
+	return a.ListTLSServiceProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every TrustedCertificateAuthorities object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListTrustedCertificateAuthorities(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListTrustedCertificateAuthoritiesRequest) All(opts ...api.PageOptions) iter.Seq2[TrustedCertificateAuthorities, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]TrustedCertificateAuthorities, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListTrustedCertificateAuthoritiesAll returns an iterator over every TrustedCertificateAuthorities object.
+//
+// It is a shorthand for ListTrustedCertificateAuthorities(ctx).All(opts...), see ApiListTrustedCertificateAuthoritiesRequest.All.
+func (a *TrustedCertificateAuthoritiesAPIService) ListTrustedCertificateAuthoritiesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[TrustedCertificateAuthorities, error] {
+	return a.ListTrustedCertificateAuthorities(ctx).All(opts...)
+}
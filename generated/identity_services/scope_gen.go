@@ -0,0 +1,700 @@
+// Code generated by scopegen. DO NOT EDIT.
+
+package identity_services
+
+import (
+	"context"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AuthenticationPortals) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AuthenticationPortals) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AuthenticationProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AuthenticationProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AuthenticationRules) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AuthenticationRules) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AuthenticationSequences) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AuthenticationSequences) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *CertificateProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *CertificateProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *CertificatesGet) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *CertificatesGet) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *CertificatesImport) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *CertificatesImport) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *CertificatesPost) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *CertificatesPost) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *KerberosServerProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *KerberosServerProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LdapServerProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LdapServerProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LocalUserGroups) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LocalUserGroups) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LocalUsers) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LocalUsers) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *MfaServers) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *MfaServers) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *OcspResponders) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *OcspResponders) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *RadiusServerProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *RadiusServerProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SamlServerProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SamlServerProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ScepProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ScepProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *TacacsServerProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *TacacsServerProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *TlsServiceProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *TlsServiceProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAuthenticationPortalsRequest) Scope(s api.Scope) ApiListAuthenticationPortalsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAuthenticationProfilesRequest) Scope(s api.Scope) ApiListAuthenticationProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAuthenticationRulesRequest) Scope(s api.Scope) ApiListAuthenticationRulesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAuthenticationSequencesRequest) Scope(s api.Scope) ApiListAuthenticationSequencesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListCertificateProfilesRequest) Scope(s api.Scope) ApiListCertificateProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListCertificatesRequest) Scope(s api.Scope) ApiListCertificatesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListKerberosServerProfilesRequest) Scope(s api.Scope) ApiListKerberosServerProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLDAPServerProfilesRequest) Scope(s api.Scope) ApiListLDAPServerProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLocalUserGroupsRequest) Scope(s api.Scope) ApiListLocalUserGroupsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLocalUsersRequest) Scope(s api.Scope) ApiListLocalUsersRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListMFAServersRequest) Scope(s api.Scope) ApiListMFAServersRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListOCSPRespondersRequest) Scope(s api.Scope) ApiListOCSPRespondersRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListRADIUSServerProfilesRequest) Scope(s api.Scope) ApiListRADIUSServerProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSAMLServerProfilesRequest) Scope(s api.Scope) ApiListSAMLServerProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSCEPProfilesRequest) Scope(s api.Scope) ApiListSCEPProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListTACACSServerProfilesRequest) Scope(s api.Scope) ApiListTACACSServerProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListTLSServiceProfilesRequest) Scope(s api.Scope) ApiListTLSServiceProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListTrustedCertificateAuthoritiesRequest) Scope(s api.Scope) ApiListTrustedCertificateAuthoritiesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// FetchAuthenticationProfilesInScope is FetchAuthenticationProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AuthenticationProfilesAPIService) FetchAuthenticationProfilesInScope(ctx context.Context, name string, s api.Scope) (*AuthenticationProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAuthenticationProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchAuthenticationRulesInScope is FetchAuthenticationRules for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AuthenticationRulesAPIService) FetchAuthenticationRulesInScope(ctx context.Context, name string, s api.Scope) (*AuthenticationRules, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAuthenticationRules(ctx, name, folder, snippet, device)
+}
+
+// FetchAuthenticationSequencesInScope is FetchAuthenticationSequences for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AuthenticationSequencesAPIService) FetchAuthenticationSequencesInScope(ctx context.Context, name string, s api.Scope) (*AuthenticationSequences, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAuthenticationSequences(ctx, name, folder, snippet, device)
+}
+
+// FetchCertificateProfilesInScope is FetchCertificateProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *CertificateProfilesAPIService) FetchCertificateProfilesInScope(ctx context.Context, name string, s api.Scope) (*CertificateProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchCertificateProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchCertificatesInScope is FetchCertificates for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *CertificatesAPIService) FetchCertificatesInScope(ctx context.Context, name string, s api.Scope) (*CertificatesGet, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchCertificates(ctx, name, folder, snippet, device)
+}
+
+// FetchKerberosServerProfilesInScope is FetchKerberosServerProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *KerberosServerProfilesAPIService) FetchKerberosServerProfilesInScope(ctx context.Context, name string, s api.Scope) (*KerberosServerProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchKerberosServerProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchLDAPServerProfilesInScope is FetchLDAPServerProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LDAPServerProfilesAPIService) FetchLDAPServerProfilesInScope(ctx context.Context, name string, s api.Scope) (*LdapServerProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLDAPServerProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchLocalUserGroupsInScope is FetchLocalUserGroups for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LocalUserGroupsAPIService) FetchLocalUserGroupsInScope(ctx context.Context, name string, s api.Scope) (*LocalUserGroups, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLocalUserGroups(ctx, name, folder, snippet, device)
+}
+
+// FetchLocalUsersInScope is FetchLocalUsers for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LocalUsersAPIService) FetchLocalUsersInScope(ctx context.Context, name string, s api.Scope) (*LocalUsers, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLocalUsers(ctx, name, folder, snippet, device)
+}
+
+// FetchMFAServersInScope is FetchMFAServers for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *MFAServersAPIService) FetchMFAServersInScope(ctx context.Context, name string, s api.Scope) (*MfaServers, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchMFAServers(ctx, name, folder, snippet, device)
+}
+
+// FetchOCSPRespondersInScope is FetchOCSPResponders for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *OCSPRespondersAPIService) FetchOCSPRespondersInScope(ctx context.Context, name string, s api.Scope) (*OcspResponders, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchOCSPResponders(ctx, name, folder, snippet, device)
+}
+
+// FetchRADIUSServerProfilesInScope is FetchRADIUSServerProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *RADIUSServerProfilesAPIService) FetchRADIUSServerProfilesInScope(ctx context.Context, name string, s api.Scope) (*RadiusServerProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchRADIUSServerProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchSAMLServerProfilesInScope is FetchSAMLServerProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SAMLServerProfilesAPIService) FetchSAMLServerProfilesInScope(ctx context.Context, name string, s api.Scope) (*SamlServerProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSAMLServerProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchSCEPProfilesInScope is FetchSCEPProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SCEPProfilesAPIService) FetchSCEPProfilesInScope(ctx context.Context, name string, s api.Scope) (*ScepProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSCEPProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchTACACSServerProfilesInScope is FetchTACACSServerProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *TACACSServerProfilesAPIService) FetchTACACSServerProfilesInScope(ctx context.Context, name string, s api.Scope) (*TacacsServerProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchTACACSServerProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchTLSServiceProfilesInScope is FetchTLSServiceProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *TLSServiceProfilesAPIService) FetchTLSServiceProfilesInScope(ctx context.Context, name string, s api.Scope) (*TlsServiceProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchTLSServiceProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchTrustedCertificateAuthoritiesInScope is FetchTrustedCertificateAuthorities for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *TrustedCertificateAuthoritiesAPIService) FetchTrustedCertificateAuthoritiesInScope(ctx context.Context, name string, s api.Scope) (*TrustedCertificateAuthorities, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchTrustedCertificateAuthorities(ctx, name, folder, snippet, device)
+}
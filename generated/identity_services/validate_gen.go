@@ -0,0 +1,1122 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package identity_services
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var authenticationPortalsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AuthenticationPortalsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationPortalsListResponse) Validate() error {
+	return authenticationPortalsListResponseSchema.Validate(o)
+}
+
+var authenticationProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AuthenticationProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesListResponse) Validate() error {
+	return authenticationProfilesListResponseSchema.Validate(o)
+}
+
+var authenticationRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AuthenticationRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationRulesListResponse) Validate() error {
+	return authenticationRulesListResponseSchema.Validate(o)
+}
+
+var authenticationSequencesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AuthenticationSequencesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationSequencesListResponse) Validate() error {
+	return authenticationSequencesListResponseSchema.Validate(o)
+}
+
+var certificateProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the CertificateProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificateProfilesListResponse) Validate() error {
+	return certificateProfilesListResponseSchema.Validate(o)
+}
+
+var certificatesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the CertificatesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificatesListResponse) Validate() error {
+	return certificatesListResponseSchema.Validate(o)
+}
+
+var kerberosServerProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the KerberosServerProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o KerberosServerProfilesListResponse) Validate() error {
+	return kerberosServerProfilesListResponseSchema.Validate(o)
+}
+
+var lDAPServerProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LDAPServerProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LDAPServerProfilesListResponse) Validate() error {
+	return lDAPServerProfilesListResponseSchema.Validate(o)
+}
+
+var localUserGroupsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LocalUserGroupsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LocalUserGroupsListResponse) Validate() error {
+	return localUserGroupsListResponseSchema.Validate(o)
+}
+
+var localUsersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LocalUsersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LocalUsersListResponse) Validate() error {
+	return localUsersListResponseSchema.Validate(o)
+}
+
+var mFAServersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the MFAServersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MFAServersListResponse) Validate() error {
+	return mFAServersListResponseSchema.Validate(o)
+}
+
+var oCSPRespondersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the OCSPRespondersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o OCSPRespondersListResponse) Validate() error {
+	return oCSPRespondersListResponseSchema.Validate(o)
+}
+
+var rADIUSServerProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RADIUSServerProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RADIUSServerProfilesListResponse) Validate() error {
+	return rADIUSServerProfilesListResponseSchema.Validate(o)
+}
+
+var sAMLServerProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SAMLServerProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SAMLServerProfilesListResponse) Validate() error {
+	return sAMLServerProfilesListResponseSchema.Validate(o)
+}
+
+var sCEPProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SCEPProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SCEPProfilesListResponse) Validate() error {
+	return sCEPProfilesListResponseSchema.Validate(o)
+}
+
+var tACACSServerProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the TACACSServerProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TACACSServerProfilesListResponse) Validate() error {
+	return tACACSServerProfilesListResponseSchema.Validate(o)
+}
+
+var tLSServiceProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the TLSServiceProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TLSServiceProfilesListResponse) Validate() error {
+	return tLSServiceProfilesListResponseSchema.Validate(o)
+}
+
+var trustedCertificateAuthoritiesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the TrustedCertificateAuthoritiesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrustedCertificateAuthoritiesListResponse) Validate() error {
+	return trustedCertificateAuthoritiesListResponseSchema.Validate(o)
+}
+
+var authenticationPortalsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"gp_udp_port":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"idle_timer":    {Minimum: validate.Bound(1), Maximum: validate.Bound(1440)},
+		"redirect_host": {Required: true},
+		"snippet":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"timer":         {Minimum: validate.Bound(1), Maximum: validate.Bound(1440)},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the authentication-portals schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationPortals) Validate() error {
+	return authenticationPortalsSchema.Validate(o)
+}
+
+var authenticationProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":            {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":            {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":              {Required: true},
+		"snippet":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"user_domain":       {MaxLength: 63},
+		"username_modifier": {Enum: []string{"%USERINPUT%", "%USERINPUT%@%USERDOMAIN%", "%USERDOMAIN%\\\\%USERINPUT%"}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the authentication-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfiles) Validate() error {
+	return authenticationProfilesSchema.Validate(o)
+}
+
+var authenticationRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"destination": {Required: true},
+		"from":        {Required: true},
+		"name":        {Required: true},
+		"service":     {Required: true},
+		"source":      {Required: true},
+		"timeout":     {Minimum: validate.Bound(1), Maximum: validate.Bound(1440)},
+		"to":          {Required: true},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the authentication-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationRules) Validate() error {
+	return authenticationRulesSchema.Validate(o)
+}
+
+var authenticationSequencesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the authentication-sequences schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationSequences) Validate() error {
+	return authenticationSequencesSchema.Validate(o)
+}
+
+var authenticationProfilesLockoutSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"failed_attempts": {Minimum: validate.Bound(0), Maximum: validate.Bound(10)},
+		"lockout_time":    {Minimum: validate.Bound(0), Maximum: validate.Bound(60)},
+	},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_lockout schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesLockout) Validate() error {
+	return authenticationProfilesLockoutSchema.Validate(o)
+}
+
+var authenticationProfilesMethodSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_method schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMethod) Validate() error {
+	return authenticationProfilesMethodSchema.Validate(o)
+}
+
+var authenticationProfilesMethodCloudSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_method_cloud schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMethodCloud) Validate() error {
+	return authenticationProfilesMethodCloudSchema.Validate(o)
+}
+
+var authenticationProfilesMethodKerberosSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_method_kerberos schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMethodKerberos) Validate() error {
+	return authenticationProfilesMethodKerberosSchema.Validate(o)
+}
+
+var authenticationProfilesMethodLdapSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_method_ldap schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMethodLdap) Validate() error {
+	return authenticationProfilesMethodLdapSchema.Validate(o)
+}
+
+var authenticationProfilesMethodRadiusSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_method_radius schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMethodRadius) Validate() error {
+	return authenticationProfilesMethodRadiusSchema.Validate(o)
+}
+
+var authenticationProfilesMethodSamlIdpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"attribute_name_usergroup":    {MinLength: 1, MaxLength: 63},
+		"attribute_name_username":     {MinLength: 1, MaxLength: 63},
+		"certificate_profile":         {MaxLength: 31},
+		"request_signing_certificate": {MaxLength: 64},
+		"server_profile":              {MaxLength: 63},
+	},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_method_saml_idp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMethodSamlIdp) Validate() error {
+	return authenticationProfilesMethodSamlIdpSchema.Validate(o)
+}
+
+var authenticationProfilesMethodTacplusSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_method_tacplus schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMethodTacplus) Validate() error {
+	return authenticationProfilesMethodTacplusSchema.Validate(o)
+}
+
+var authenticationProfilesMultiFactorAuthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_multi_factor_auth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesMultiFactorAuth) Validate() error {
+	return authenticationProfilesMultiFactorAuthSchema.Validate(o)
+}
+
+var authenticationProfilesSingleSignOnSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"kerberos_keytab": {MaxLength: 8192},
+		"realm":           {MaxLength: 127},
+	},
+}
+
+// Validate checks o against the constraints of the authentication_profiles_single_sign_on schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AuthenticationProfilesSingleSignOn) Validate() error {
+	return authenticationProfilesSingleSignOnSchema.Validate(o)
+}
+
+var certificateProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ca_certificates": {Required: true},
+		"device":          {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":          {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":            {Required: true, MaxLength: 63},
+		"snippet":         {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the certificate-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificateProfiles) Validate() error {
+	return certificateProfilesSchema.Validate(o)
+}
+
+var certificateProfilesCaCertificatesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the certificate_profiles_ca_certificates_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificateProfilesCaCertificatesInner) Validate() error {
+	return certificateProfilesCaCertificatesInnerSchema.Validate(o)
+}
+
+var certificateProfilesUsernameFieldSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"subject":     {Enum: []string{"common-name"}},
+		"subject_alt": {Enum: []string{"email"}},
+	},
+}
+
+// Validate checks o against the constraints of the certificate_profiles_username_field schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificateProfilesUsernameField) Validate() error {
+	return certificateProfilesUsernameFieldSchema.Validate(o)
+}
+
+var certificatesGetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the certificates-get schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificatesGet) Validate() error {
+	return certificatesGetSchema.Validate(o)
+}
+
+var certificatesImportSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"certificate_file": {Required: true},
+		"device":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"format":           {Required: true, Enum: []string{"pem", "pkcs12", "der"}},
+		"name":             {Required: true, MinLength: 1},
+		"snippet":          {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the certificates-import schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificatesImport) Validate() error {
+	return certificatesImportSchema.Validate(o)
+}
+
+var certificatesPostSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"algorithm":          {Required: true},
+		"certificate_name":   {Required: true, MinLength: 1},
+		"common_name":        {Required: true, MinLength: 1},
+		"device":             {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"digest":             {Required: true, Enum: []string{"sha1", "sha256", "sha384", "sha512", "md5"}},
+		"email":              {MaxLength: 255},
+		"folder":             {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"hostname":           {Items: &validate.Rule{MinLength: 1, MaxLength: 64}},
+		"ip":                 {Items: &validate.Rule{MinLength: 1, MaxLength: 64}},
+		"locality":           {MaxLength: 64},
+		"ocsp_responder_url": {MaxLength: 64},
+		"signed_by":          {Required: true, MaxLength: 64},
+		"snippet":            {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"state":              {MaxLength: 32},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the certificates-post schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificatesPost) Validate() error {
+	return certificatesPostSchema.Validate(o)
+}
+
+var certificatesPostAlgorithmSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ecdsa_number_of_bits": {Enum: []string{"245", "384", "2048", "3072", "4096"}},
+		"rsa_number_of_bits":   {Enum: []string{"512", "1024", "2048", "3072", "4096"}},
+	},
+}
+
+// Validate checks o against the constraints of the certificates_post_algorithm schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o CertificatesPostAlgorithm) Validate() error {
+	return certificatesPostAlgorithmSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var exportCertificatePayloadSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"format":     {Required: true},
+		"passphrase": {Enum: []string{"pkcs12", "pem", "der", "pkcs10"}},
+	},
+}
+
+// Validate checks o against the constraints of the export-certificate-payload schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExportCertificatePayload) Validate() error {
+	return exportCertificatePayloadSchema.Validate(o)
+}
+
+var exportCertificateResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the export-certificate-response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExportCertificateResponse) Validate() error {
+	return exportCertificateResponseSchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var kerberosServerProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"server":  {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the kerberos-server-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o KerberosServerProfiles) Validate() error {
+	return kerberosServerProfilesSchema.Validate(o)
+}
+
+var kerberosServerProfilesServerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"host": {Required: true},
+		"name": {Required: true},
+		"port": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the kerberos_server_profiles_server_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o KerberosServerProfilesServerInner) Validate() error {
+	return kerberosServerProfilesServerInnerSchema.Validate(o)
+}
+
+var ldapServerProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"base":           {MaxLength: 255},
+		"bind_dn":        {MaxLength: 255},
+		"bind_password":  {MaxLength: 121},
+		"bind_timelimit": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+		"device":         {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":         {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"ldap_type":      {Enum: []string{"active-directory", "e-directory", "sun", "other"}},
+		"name":           {Required: true},
+		"retry_interval": {Minimum: validate.Bound(60), Maximum: validate.Bound(3600)},
+		"server":         {Required: true},
+		"snippet":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"timelimit":      {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ldap-server-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LdapServerProfiles) Validate() error {
+	return ldapServerProfilesSchema.Validate(o)
+}
+
+var ldapServerProfilesServerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"port": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ldap_server_profiles_server_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LdapServerProfilesServerInner) Validate() error {
+	return ldapServerProfilesServerInnerSchema.Validate(o)
+}
+
+var localUserGroupsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, Pattern: `^[a-zA-Z0-9._-]+$`, MaxLength: 31},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the local-user-groups schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LocalUserGroups) Validate() error {
+	return localUserGroupsSchema.Validate(o)
+}
+
+var localUsersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":     {Required: true, MaxLength: 31},
+		"password": {Required: true, MaxLength: 63},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the local-users schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LocalUsers) Validate() error {
+	return localUsersSchema.Validate(o)
+}
+
+var mfaServersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"mfa_cert_profile": {Required: true},
+		"name":             {Required: true},
+		"snippet":          {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the mfa-servers schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MfaServers) Validate() error {
+	return mfaServersSchema.Validate(o)
+}
+
+var mfaServersMfaVendorTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the mfa_servers_mfa_vendor_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MfaServersMfaVendorType) Validate() error {
+	return mfaServersMfaVendorTypeSchema.Validate(o)
+}
+
+var mfaServersMfaVendorTypeDuoSecurityV2Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duo_api_host":        {Required: true, MinLength: 16},
+		"duo_baseuri":         {Required: true, MinLength: 2},
+		"duo_integration_key": {Required: true, MinLength: 16},
+		"duo_secret_key":      {Required: true, MinLength: 16},
+		"duo_timeout":         {Required: true, Minimum: validate.Bound(5), Maximum: validate.Bound(600)},
+	},
+}
+
+// Validate checks o against the constraints of the mfa_servers_mfa_vendor_type_duo_security_v2 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MfaServersMfaVendorTypeDuoSecurityV2) Validate() error {
+	return mfaServersMfaVendorTypeDuoSecurityV2Schema.Validate(o)
+}
+
+var mfaServersMfaVendorTypeOktaAdaptiveV1Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"okta_api_host": {Required: true, MinLength: 10},
+		"okta_baseuri":  {Required: true, MinLength: 2},
+		"okta_org":      {Required: true},
+		"okta_timeout":  {Required: true, Minimum: validate.Bound(5), Maximum: validate.Bound(600)},
+		"okta_token":    {Required: true, MinLength: 8},
+	},
+}
+
+// Validate checks o against the constraints of the mfa_servers_mfa_vendor_type_okta_adaptive_v1 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MfaServersMfaVendorTypeOktaAdaptiveV1) Validate() error {
+	return mfaServersMfaVendorTypeOktaAdaptiveV1Schema.Validate(o)
+}
+
+var mfaServersMfaVendorTypePingIdentityV1Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ping_api_host":       {Required: true, MinLength: 16},
+		"ping_baseuri":        {Required: true, MinLength: 2},
+		"ping_org_alias":      {MinLength: 8},
+		"ping_timeout":        {Required: true, Minimum: validate.Bound(5), Maximum: validate.Bound(600)},
+		"ping_token":          {Required: true, MinLength: 8},
+		"ping_use_base64_key": {Required: true, MinLength: 8},
+	},
+}
+
+// Validate checks o against the constraints of the mfa_servers_mfa_vendor_type_ping_identity_v1 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MfaServersMfaVendorTypePingIdentityV1) Validate() error {
+	return mfaServersMfaVendorTypePingIdentityV1Schema.Validate(o)
+}
+
+var mfaServersMfaVendorTypeRsaSecuridAccessV1Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"rsa_accessid":          {MinLength: 8},
+		"rsa_accesskey":         {MinLength: 8},
+		"rsa_api_host":          {MinLength: 10},
+		"rsa_assurancepolicyid": {MinLength: 3},
+		"rsa_baseuri":           {MinLength: 2},
+		"rsa_timeout":           {Minimum: validate.Bound(5), Maximum: validate.Bound(600)},
+	},
+}
+
+// Validate checks o against the constraints of the mfa_servers_mfa_vendor_type_rsa_securid_access_v1 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o MfaServersMfaVendorTypeRsaSecuridAccessV1) Validate() error {
+	return mfaServersMfaVendorTypeRsaSecuridAccessV1Schema.Validate(o)
+}
+
+var ocspRespondersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":    {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":    {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"host_name": {Required: true, MinLength: 1, MaxLength: 255},
+		"name":      {Required: true, Pattern: `^[a-zA-Z0-9._-]+$`, MaxLength: 63},
+		"snippet":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ocsp-responders schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o OcspResponders) Validate() error {
+	return ocspRespondersSchema.Validate(o)
+}
+
+var radiusServerProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":     {Required: true},
+		"protocol": {Required: true},
+		"retries":  {Minimum: validate.Bound(1), Maximum: validate.Bound(5)},
+		"server":   {Required: true},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"timeout":  {Minimum: validate.Bound(1), Maximum: validate.Bound(120)},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the radius-server-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RadiusServerProfiles) Validate() error {
+	return radiusServerProfilesSchema.Validate(o)
+}
+
+var radiusServerProfilesProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the radius_server_profiles_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RadiusServerProfilesProtocol) Validate() error {
+	return radiusServerProfilesProtocolSchema.Validate(o)
+}
+
+var radiusServerProfilesProtocolEAPTTLSWithPAPSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the radius_server_profiles_protocol_EAP_TTLS_with_PAP schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RadiusServerProfilesProtocolEAPTTLSWithPAP) Validate() error {
+	return radiusServerProfilesProtocolEAPTTLSWithPAPSchema.Validate(o)
+}
+
+var radiusServerProfilesProtocolPEAPMSCHAPv2Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the radius_server_profiles_protocol_PEAP_MSCHAPv2 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RadiusServerProfilesProtocolPEAPMSCHAPv2) Validate() error {
+	return radiusServerProfilesProtocolPEAPMSCHAPv2Schema.Validate(o)
+}
+
+var radiusServerProfilesServerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"port":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"secret": {MaxLength: 128},
+	},
+}
+
+// Validate checks o against the constraints of the radius_server_profiles_server_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RadiusServerProfilesServerInner) Validate() error {
+	return radiusServerProfilesServerInnerSchema.Validate(o)
+}
+
+var ruleBasedMoveSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"destination": {Required: true, Enum: []string{"top", "bottom", "before", "after"}},
+		"rulebase":    {Required: true, Enum: []string{"pre", "post"}},
+	},
+}
+
+// Validate checks o against the constraints of the rule-based-move schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RuleBasedMove) Validate() error {
+	return ruleBasedMoveSchema.Validate(o)
+}
+
+var samlServerProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"certificate":    {Required: true, MaxLength: 63},
+		"device":         {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"entity_id":      {Required: true, MinLength: 1, MaxLength: 1024},
+		"folder":         {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"max_clock_skew": {Minimum: validate.Bound(1), Maximum: validate.Bound(900)},
+		"name":           {Required: true},
+		"slo_bindings":   {Enum: []string{"post", "redirect"}},
+		"slo_url":        {MinLength: 1, MaxLength: 255},
+		"snippet":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"sso_bindings":   {Required: true, Enum: []string{"post", "redirect"}},
+		"sso_url":        {Required: true, MinLength: 1, MaxLength: 255},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the saml-server-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SamlServerProfiles) Validate() error {
+	return samlServerProfilesSchema.Validate(o)
+}
+
+var scepProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"algorithm":        {Required: true},
+		"ca_identity_name": {Required: true},
+		"device":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"digest":           {Required: true, Enum: []string{"sha1", "sha256", "sha384", "sha512"}},
+		"folder":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":             {Required: true, MaxLength: 31},
+		"scep_ca_cert":     {Enum: []string{"Authentication Cookie CA", "Forward-Trust-CA", "Forward-Trust-CA-ECDSA", "Forward-UnTrust-CA", "Forward-UnTrust-CA-ECDSA", "Global Authentication Cookie CA", "GlobalSign-Root-CA", "Root CA"}},
+		"scep_challenge":   {Required: true},
+		"scep_client_cert": {Enum: []string{"Authentication Cookie CA", "Forward-Trust-CA", "Forward-Trust-CA-ECDSA", "Forward-UnTrust-CA", "Forward-UnTrust-CA-ECDSA", "Global Authentication Cookie CA", "GlobalSign-Root-CA", "Root CA"}},
+		"scep_url":         {Required: true},
+		"snippet":          {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"subject":          {Required: true},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the scep-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ScepProfiles) Validate() error {
+	return scepProfilesSchema.Validate(o)
+}
+
+var scepProfilesAlgorithmSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"rsa": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the scep_profiles_algorithm schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ScepProfilesAlgorithm) Validate() error {
+	return scepProfilesAlgorithmSchema.Validate(o)
+}
+
+var scepProfilesAlgorithmRsaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"rsa_nbits": {Required: true, Enum: []string{"1024", "2048", "3072"}},
+	},
+}
+
+// Validate checks o against the constraints of the scep_profiles_algorithm_rsa schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ScepProfilesAlgorithmRsa) Validate() error {
+	return scepProfilesAlgorithmRsaSchema.Validate(o)
+}
+
+var scepProfilesCertificateAttributesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the scep_profiles_certificate_attributes schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ScepProfilesCertificateAttributes) Validate() error {
+	return scepProfilesCertificateAttributesSchema.Validate(o)
+}
+
+var scepProfilesScepChallengeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"fixed": {MaxLength: 1024},
+	},
+}
+
+// Validate checks o against the constraints of the scep_profiles_scep_challenge schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ScepProfilesScepChallenge) Validate() error {
+	return scepProfilesScepChallengeSchema.Validate(o)
+}
+
+var scepProfilesScepChallengeDynamicSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"otp_server_url": {MaxLength: 255},
+		"password":       {MaxLength: 255},
+		"username":       {MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the scep_profiles_scep_challenge_dynamic schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ScepProfilesScepChallengeDynamic) Validate() error {
+	return scepProfilesScepChallengeDynamicSchema.Validate(o)
+}
+
+var tacacsServerProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":     {Required: true},
+		"protocol": {Required: true, Enum: []string{"CHAP", "PAP"}},
+		"server":   {Required: true},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"timeout":  {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the tacacs-server-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TacacsServerProfiles) Validate() error {
+	return tacacsServerProfilesSchema.Validate(o)
+}
+
+var tacacsServerProfilesServerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"port": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the tacacs_server_profiles_server_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TacacsServerProfilesServerInner) Validate() error {
+	return tacacsServerProfilesServerInnerSchema.Validate(o)
+}
+
+var tlsServiceProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"certificate":       {Required: true, MaxLength: 255},
+		"device":            {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":            {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":              {Required: true, Pattern: `^[a-zA-Z0-9._-]+$`, MaxLength: 127},
+		"protocol_settings": {Required: true},
+		"snippet":           {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the tls-service-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TlsServiceProfiles) Validate() error {
+	return tlsServiceProfilesSchema.Validate(o)
+}
+
+var tlsServiceProfilesProtocolSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"max_version": {Enum: []string{"tls1-0", "tls1-1", "tls1-2", "tls1-3"}},
+		"min_version": {Enum: []string{"tls1-0", "tls1-1", "tls1-2", "tls1-3"}},
+	},
+}
+
+// Validate checks o against the constraints of the tls_service_profiles_protocol_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TlsServiceProfilesProtocolSettings) Validate() error {
+	return tlsServiceProfilesProtocolSettingsSchema.Validate(o)
+}
+
+var trustedCertificateAuthoritiesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"common_name": {MaxLength: 255},
+		"name":        {MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the trusted-certificate-authorities schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TrustedCertificateAuthorities) Validate() error {
+	return trustedCertificateAuthoritiesSchema.Validate(o)
+}
@@ -0,0 +1,1620 @@
+// Code generated by pagergen. DO NOT EDIT.
+
+package network_services
+
+import (
+	"context"
+	"iter"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// All returns an iterator over every AggregateInterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAggregateInterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAggregateInterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[AggregateInterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AggregateInterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAggregateInterfacesAll returns an iterator over every AggregateInterfaces object.
+//
+// It is a shorthand for ListAggregateInterfaces(ctx).All(opts...), see ApiListAggregateInterfacesRequest.All.
+func (a *AggregateInterfacesAPIService) ListAggregateInterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AggregateInterfaces, error] {
+	return a.ListAggregateInterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every AutoVpnClusters object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAutoVPNClusters(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAutoVPNClustersRequest) All(opts ...api.PageOptions) iter.Seq2[AutoVpnClusters, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AutoVpnClusters, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAutoVPNClustersAll returns an iterator over every AutoVpnClusters object.
+//
+// It is a shorthand for ListAutoVPNClusters(ctx).All(opts...), see ApiListAutoVPNClustersRequest.All.
+func (a *AutoVPNClustersAPIService) ListAutoVPNClustersAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AutoVpnClusters, error] {
+	return a.ListAutoVPNClusters(ctx).All(opts...)
+}
+
+// All returns an iterator over every BgpAddressFamilyProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListBGPAddressFamilyProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListBGPAddressFamilyProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[BgpAddressFamilyProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]BgpAddressFamilyProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListBGPAddressFamilyProfilesAll returns an iterator over every BgpAddressFamilyProfiles object.
+//
+// It is a shorthand for ListBGPAddressFamilyProfiles(ctx).All(opts...), see ApiListBGPAddressFamilyProfilesRequest.All.
+func (a *BGPAddressFamilyProfilesAPIService) ListBGPAddressFamilyProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[BgpAddressFamilyProfiles, error] {
+	return a.ListBGPAddressFamilyProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every BgpAuthProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListBGPAuthenticationProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListBGPAuthenticationProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[BgpAuthProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]BgpAuthProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListBGPAuthenticationProfilesAll returns an iterator over every BgpAuthProfiles object.
+//
+// It is a shorthand for ListBGPAuthenticationProfiles(ctx).All(opts...), see ApiListBGPAuthenticationProfilesRequest.All.
+func (a *BGPAuthenticationProfilesAPIService) ListBGPAuthenticationProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[BgpAuthProfiles, error] {
+	return a.ListBGPAuthenticationProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every BgpFilteringProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListBGPFilteringProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListBGPFilteringProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[BgpFilteringProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]BgpFilteringProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListBGPFilteringProfilesAll returns an iterator over every BgpFilteringProfiles object.
+//
+// It is a shorthand for ListBGPFilteringProfiles(ctx).All(opts...), see ApiListBGPFilteringProfilesRequest.All.
+func (a *BGPFilteringProfilesAPIService) ListBGPFilteringProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[BgpFilteringProfiles, error] {
+	return a.ListBGPFilteringProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every BgpRedistributionProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListBGPRedistributionProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListBGPRedistributionProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[BgpRedistributionProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]BgpRedistributionProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListBGPRedistributionProfilesAll returns an iterator over every BgpRedistributionProfiles object.
+//
+// It is a shorthand for ListBGPRedistributionProfiles(ctx).All(opts...), see ApiListBGPRedistributionProfilesRequest.All.
+func (a *BGPRedistributionProfilesAPIService) ListBGPRedistributionProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[BgpRedistributionProfiles, error] {
+	return a.ListBGPRedistributionProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every BgpRouteMapRedistributions object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListBGPRouteMapRedistributions(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListBGPRouteMapRedistributionsRequest) All(opts ...api.PageOptions) iter.Seq2[BgpRouteMapRedistributions, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]BgpRouteMapRedistributions, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListBGPRouteMapRedistributionsAll returns an iterator over every BgpRouteMapRedistributions object.
+//
+// It is a shorthand for ListBGPRouteMapRedistributions(ctx).All(opts...), see ApiListBGPRouteMapRedistributionsRequest.All.
+func (a *BGPRouteMapRedistributionsAPIService) ListBGPRouteMapRedistributionsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[BgpRouteMapRedistributions, error] {
+	return a.ListBGPRouteMapRedistributions(ctx).All(opts...)
+}
+
+// All returns an iterator over every BgpRouteMaps object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListBGPRouteMaps(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListBGPRouteMapsRequest) All(opts ...api.PageOptions) iter.Seq2[BgpRouteMaps, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]BgpRouteMaps, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListBGPRouteMapsAll returns an iterator over every BgpRouteMaps object.
+//
+// It is a shorthand for ListBGPRouteMaps(ctx).All(opts...), see ApiListBGPRouteMapsRequest.All.
+func (a *BGPRouteMapsAPIService) ListBGPRouteMapsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[BgpRouteMaps, error] {
+	return a.ListBGPRouteMaps(ctx).All(opts...)
+}
+
+// All returns an iterator over every ConfigMatchList object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListConfigMatchList(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListConfigMatchListRequest) All(opts ...api.PageOptions) iter.Seq2[ConfigMatchList, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ConfigMatchList, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListConfigMatchListAll returns an iterator over every ConfigMatchList object.
+//
+// It is a shorthand for ListConfigMatchList(ctx).All(opts...), see ApiListConfigMatchListRequest.All.
+func (a *ConfigMatchListAPIService) ListConfigMatchListAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ConfigMatchList, error] {
+	return a.ListConfigMatchList(ctx).All(opts...)
+}
+
+// All returns an iterator over every DhcpInterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDHCPInterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDHCPInterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[DhcpInterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DhcpInterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDHCPInterfacesAll returns an iterator over every DhcpInterfaces object.
+//
+// It is a shorthand for ListDHCPInterfaces(ctx).All(opts...), see ApiListDHCPInterfacesRequest.All.
+func (a *DHCPInterfacesAPIService) ListDHCPInterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DhcpInterfaces, error] {
+	return a.ListDHCPInterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every DnsProxies object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDNSProxies(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDNSProxiesRequest) All(opts ...api.PageOptions) iter.Seq2[DnsProxies, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DnsProxies, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDNSProxiesAll returns an iterator over every DnsProxies object.
+//
+// It is a shorthand for ListDNSProxies(ctx).All(opts...), see ApiListDNSProxiesRequest.All.
+func (a *DNSProxiesAPIService) ListDNSProxiesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DnsProxies, error] {
+	return a.ListDNSProxies(ctx).All(opts...)
+}
+
+// All returns an iterator over every EthernetInterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListEthernetInterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListEthernetInterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[EthernetInterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]EthernetInterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListEthernetInterfacesAll returns an iterator over every EthernetInterfaces object.
+//
+// It is a shorthand for ListEthernetInterfaces(ctx).All(opts...), see ApiListEthernetInterfacesRequest.All.
+func (a *EthernetInterfacesAPIService) ListEthernetInterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[EthernetInterfaces, error] {
+	return a.ListEthernetInterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every GlobalprotectMatchList object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListGlobalprotectMatchList(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListGlobalprotectMatchListRequest) All(opts ...api.PageOptions) iter.Seq2[GlobalprotectMatchList, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]GlobalprotectMatchList, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListGlobalprotectMatchListAll returns an iterator over every GlobalprotectMatchList object.
+//
+// It is a shorthand for ListGlobalprotectMatchList(ctx).All(opts...), see ApiListGlobalprotectMatchListRequest.All.
+func (a *GlobalprotectMatchListAPIService) ListGlobalprotectMatchListAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[GlobalprotectMatchList, error] {
+	return a.ListGlobalprotectMatchList(ctx).All(opts...)
+}
+
+// All returns an iterator over every HipmatchMatchList object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListHipmatchMatchList(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListHipmatchMatchListRequest) All(opts ...api.PageOptions) iter.Seq2[HipmatchMatchList, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]HipmatchMatchList, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListHipmatchMatchListAll returns an iterator over every HipmatchMatchList object.
+//
+// It is a shorthand for ListHipmatchMatchList(ctx).All(opts...), see ApiListHipmatchMatchListRequest.All.
+func (a *HipmatchMatchListAPIService) ListHipmatchMatchListAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[HipmatchMatchList, error] {
+	return a.ListHipmatchMatchList(ctx).All(opts...)
+}
+
+// All returns an iterator over every IkeCryptoProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListIKECryptoProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListIKECryptoProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[IkeCryptoProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]IkeCryptoProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListIKECryptoProfilesAll returns an iterator over every IkeCryptoProfiles object.
+//
+// It is a shorthand for ListIKECryptoProfiles(ctx).All(opts...), see ApiListIKECryptoProfilesRequest.All.
+func (a *IKECryptoProfilesAPIService) ListIKECryptoProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[IkeCryptoProfiles, error] {
+	return a.ListIKECryptoProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every IkeGateways object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListIKEGateways(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListIKEGatewaysRequest) All(opts ...api.PageOptions) iter.Seq2[IkeGateways, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]IkeGateways, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListIKEGatewaysAll returns an iterator over every IkeGateways object.
+//
+// It is a shorthand for ListIKEGateways(ctx).All(opts...), see ApiListIKEGatewaysRequest.All.
+func (a *IKEGatewaysAPIService) ListIKEGatewaysAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[IkeGateways, error] {
+	return a.ListIKEGateways(ctx).All(opts...)
+}
+
+// All returns an iterator over every IpsecCryptoProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListIPsecCryptoProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListIPsecCryptoProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[IpsecCryptoProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]IpsecCryptoProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListIPsecCryptoProfilesAll returns an iterator over every IpsecCryptoProfiles object.
+//
+// It is a shorthand for ListIPsecCryptoProfiles(ctx).All(opts...), see ApiListIPsecCryptoProfilesRequest.All.
+func (a *IPsecCryptoProfilesAPIService) ListIPsecCryptoProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[IpsecCryptoProfiles, error] {
+	return a.ListIPsecCryptoProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every IpsecTunnels object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListIPsecTunnels(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListIPsecTunnelsRequest) All(opts ...api.PageOptions) iter.Seq2[IpsecTunnels, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]IpsecTunnels, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListIPsecTunnelsAll returns an iterator over every IpsecTunnels object.
+//
+// It is a shorthand for ListIPsecTunnels(ctx).All(opts...), see ApiListIPsecTunnelsRequest.All.
+func (a *IPsecTunnelsAPIService) ListIPsecTunnelsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[IpsecTunnels, error] {
+	return a.ListIPsecTunnels(ctx).All(opts...)
+}
+
+// All returns an iterator over every InterfaceManagementProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListInterfaceManagementProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListInterfaceManagementProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[InterfaceManagementProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]InterfaceManagementProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListInterfaceManagementProfilesAll returns an iterator over every InterfaceManagementProfiles object.
+//
+// It is a shorthand for ListInterfaceManagementProfiles(ctx).All(opts...), see ApiListInterfaceManagementProfilesRequest.All.
+func (a *InterfaceManagementProfilesAPIService) ListInterfaceManagementProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[InterfaceManagementProfiles, error] {
+	return a.ListInterfaceManagementProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every IptagMatchList object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListIptagMatchList(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListIptagMatchListRequest) All(opts ...api.PageOptions) iter.Seq2[IptagMatchList, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]IptagMatchList, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListIptagMatchListAll returns an iterator over every IptagMatchList object.
+//
+// It is a shorthand for ListIptagMatchList(ctx).All(opts...), see ApiListIptagMatchListRequest.All.
+func (a *IptagMatchListAPIService) ListIptagMatchListAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[IptagMatchList, error] {
+	return a.ListIptagMatchList(ctx).All(opts...)
+}
+
+// All returns an iterator over every LldpProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLLDPProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLLDPProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[LldpProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LldpProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLLDPProfilesAll returns an iterator over every LldpProfiles object.
+//
+// It is a shorthand for ListLLDPProfiles(ctx).All(opts...), see ApiListLLDPProfilesRequest.All.
+func (a *LLDPProfilesAPIService) ListLLDPProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LldpProfiles, error] {
+	return a.ListLLDPProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every Layer2Subinterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLayer2Subinterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLayer2SubinterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[Layer2Subinterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Layer2Subinterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLayer2SubinterfacesAll returns an iterator over every Layer2Subinterfaces object.
+//
+// It is a shorthand for ListLayer2Subinterfaces(ctx).All(opts...), see ApiListLayer2SubinterfacesRequest.All.
+func (a *Layer2SubinterfacesAPIService) ListLayer2SubinterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Layer2Subinterfaces, error] {
+	return a.ListLayer2Subinterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every Layer3Subinterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLayer3Subinterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLayer3SubinterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[Layer3Subinterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Layer3Subinterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLayer3SubinterfacesAll returns an iterator over every Layer3Subinterfaces object.
+//
+// It is a shorthand for ListLayer3Subinterfaces(ctx).All(opts...), see ApiListLayer3SubinterfacesRequest.All.
+func (a *Layer3SubinterfacesAPIService) ListLayer3SubinterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Layer3Subinterfaces, error] {
+	return a.ListLayer3Subinterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every LinkTags object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLinkTags(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLinkTagsRequest) All(opts ...api.PageOptions) iter.Seq2[LinkTags, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LinkTags, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLinkTagsAll returns an iterator over every LinkTags object.
+//
+// It is a shorthand for ListLinkTags(ctx).All(opts...), see ApiListLinkTagsRequest.All.
+func (a *LinkTagsAPIService) ListLinkTagsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LinkTags, error] {
+	return a.ListLinkTags(ctx).All(opts...)
+}
+
+// All returns an iterator over every LogicalRouters object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLogicalRouters(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLogicalRoutersRequest) All(opts ...api.PageOptions) iter.Seq2[LogicalRouters, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LogicalRouters, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLogicalRoutersAll returns an iterator over every LogicalRouters object.
+//
+// It is a shorthand for ListLogicalRouters(ctx).All(opts...), see ApiListLogicalRoutersRequest.All.
+func (a *LogicalRoutersAPIService) ListLogicalRoutersAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LogicalRouters, error] {
+	return a.ListLogicalRouters(ctx).All(opts...)
+}
+
+// All returns an iterator over every LoopbackInterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLoopbackInterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLoopbackInterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[LoopbackInterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LoopbackInterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLoopbackInterfacesAll returns an iterator over every LoopbackInterfaces object.
+//
+// It is a shorthand for ListLoopbackInterfaces(ctx).All(opts...), see ApiListLoopbackInterfacesRequest.All.
+func (a *LoopbackInterfacesAPIService) ListLoopbackInterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LoopbackInterfaces, error] {
+	return a.ListLoopbackInterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every NatRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListNatRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListNatRulesRequest) All(opts ...api.PageOptions) iter.Seq2[NatRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]NatRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListNatRulesAll returns an iterator over every NatRules object.
+//
+// It is a shorthand for ListNatRules(ctx).All(opts...), see ApiListNatRulesRequest.All.
+func (a *NATRulesAPIService) ListNatRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[NatRules, error] {
+	return a.ListNatRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every OspfAuthProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListOSPFAuthenticationProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListOSPFAuthenticationProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[OspfAuthProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]OspfAuthProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListOSPFAuthenticationProfilesAll returns an iterator over every OspfAuthProfiles object.
+//
+// It is a shorthand for ListOSPFAuthenticationProfiles(ctx).All(opts...), see ApiListOSPFAuthenticationProfilesRequest.All.
+func (a *OSPFAuthenticationProfilesAPIService) ListOSPFAuthenticationProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[OspfAuthProfiles, error] {
+	return a.ListOSPFAuthenticationProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every PbfRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListPBFRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListPBFRulesRequest) All(opts ...api.PageOptions) iter.Seq2[PbfRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]PbfRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListPBFRulesAll returns an iterator over every PbfRules object.
+//
+// It is a shorthand for ListPBFRules(ctx).All(opts...), see ApiListPBFRulesRequest.All.
+func (a *PBFRulesAPIService) ListPBFRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[PbfRules, error] {
+	return a.ListPBFRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every QosPolicyRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListQoSPolicyRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListQoSPolicyRulesRequest) All(opts ...api.PageOptions) iter.Seq2[QosPolicyRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]QosPolicyRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListQoSPolicyRulesAll returns an iterator over every QosPolicyRules object.
+//
+// It is a shorthand for ListQoSPolicyRules(ctx).All(opts...), see ApiListQoSPolicyRulesRequest.All.
+func (a *QoSRulesAPIService) ListQoSPolicyRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[QosPolicyRules, error] {
+	return a.ListQoSPolicyRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every QosProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListQoSProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListQoSProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[QosProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]QosProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListQoSProfilesAll returns an iterator over every QosProfiles object.
+//
+// It is a shorthand for ListQoSProfiles(ctx).All(opts...), see ApiListQoSProfilesRequest.All.
+func (a *QoSProfilesAPIService) ListQoSProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[QosProfiles, error] {
+	return a.ListQoSProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every RouteAccessLists object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListRouteAccessLists(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListRouteAccessListsRequest) All(opts ...api.PageOptions) iter.Seq2[RouteAccessLists, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]RouteAccessLists, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListRouteAccessListsAll returns an iterator over every RouteAccessLists object.
+//
+// It is a shorthand for ListRouteAccessLists(ctx).All(opts...), see ApiListRouteAccessListsRequest.All.
+func (a *RouteAccessListsAPIService) ListRouteAccessListsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[RouteAccessLists, error] {
+	return a.ListRouteAccessLists(ctx).All(opts...)
+}
+
+// All returns an iterator over every RouteCommunityLists object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListRouteCommunityLists(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListRouteCommunityListsRequest) All(opts ...api.PageOptions) iter.Seq2[RouteCommunityLists, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]RouteCommunityLists, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListRouteCommunityListsAll returns an iterator over every RouteCommunityLists object.
+//
+// It is a shorthand for ListRouteCommunityLists(ctx).All(opts...), see ApiListRouteCommunityListsRequest.All.
+func (a *RouteCommunityListsAPIService) ListRouteCommunityListsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[RouteCommunityLists, error] {
+	return a.ListRouteCommunityLists(ctx).All(opts...)
+}
+
+// All returns an iterator over every RoutePathAccessLists object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListRoutePathAccessLists(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListRoutePathAccessListsRequest) All(opts ...api.PageOptions) iter.Seq2[RoutePathAccessLists, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]RoutePathAccessLists, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListRoutePathAccessListsAll returns an iterator over every RoutePathAccessLists object.
+//
+// It is a shorthand for ListRoutePathAccessLists(ctx).All(opts...), see ApiListRoutePathAccessListsRequest.All.
+func (a *RoutePathAccessListsAPIService) ListRoutePathAccessListsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[RoutePathAccessLists, error] {
+	return a.ListRoutePathAccessLists(ctx).All(opts...)
+}
+
+// All returns an iterator over every RoutePrefixLists object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListRoutePrefixLists(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListRoutePrefixListsRequest) All(opts ...api.PageOptions) iter.Seq2[RoutePrefixLists, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]RoutePrefixLists, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListRoutePrefixListsAll returns an iterator over every RoutePrefixLists object.
+//
+// It is a shorthand for ListRoutePrefixLists(ctx).All(opts...), see ApiListRoutePrefixListsRequest.All.
+func (a *RoutePrefixListsAPIService) ListRoutePrefixListsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[RoutePrefixLists, error] {
+	return a.ListRoutePrefixLists(ctx).All(opts...)
+}
+
+// All returns an iterator over every SdwanErrorCorrectionProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSDWANErrorCorrectionProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSDWANErrorCorrectionProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[SdwanErrorCorrectionProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SdwanErrorCorrectionProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSDWANErrorCorrectionProfilesAll returns an iterator over every SdwanErrorCorrectionProfiles object.
+//
+// It is a shorthand for ListSDWANErrorCorrectionProfiles(ctx).All(opts...), see ApiListSDWANErrorCorrectionProfilesRequest.All.
+func (a *SDWANErrorCorrectionProfilesAPIService) ListSDWANErrorCorrectionProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SdwanErrorCorrectionProfiles, error] {
+	return a.ListSDWANErrorCorrectionProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every SdwanPathQualityProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSDWANPathQualityProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSDWANPathQualityProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[SdwanPathQualityProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SdwanPathQualityProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSDWANPathQualityProfilesAll returns an iterator over every SdwanPathQualityProfiles object.
+//
+// It is a shorthand for ListSDWANPathQualityProfiles(ctx).All(opts...), see ApiListSDWANPathQualityProfilesRequest.All.
+func (a *SDWANPathQualityProfilesAPIService) ListSDWANPathQualityProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SdwanPathQualityProfiles, error] {
+	return a.ListSDWANPathQualityProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every SdwanRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSDWANRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSDWANRulesRequest) All(opts ...api.PageOptions) iter.Seq2[SdwanRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SdwanRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSDWANRulesAll returns an iterator over every SdwanRules object.
+//
+// It is a shorthand for ListSDWANRules(ctx).All(opts...), see ApiListSDWANRulesRequest.All.
+func (a *SDWANRulesAPIService) ListSDWANRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SdwanRules, error] {
+	return a.ListSDWANRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every SdwanSaasQualityProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSDWANSaaSQualityProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSDWANSaaSQualityProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[SdwanSaasQualityProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SdwanSaasQualityProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSDWANSaaSQualityProfilesAll returns an iterator over every SdwanSaasQualityProfiles object.
+//
+// It is a shorthand for ListSDWANSaaSQualityProfiles(ctx).All(opts...), see ApiListSDWANSaaSQualityProfilesRequest.All.
+func (a *SDWANSaaSQualityProfilesAPIService) ListSDWANSaaSQualityProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SdwanSaasQualityProfiles, error] {
+	return a.ListSDWANSaaSQualityProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every SdwanTrafficDistributionProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSDWANTrafficDistributionProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSDWANTrafficDistributionProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[SdwanTrafficDistributionProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SdwanTrafficDistributionProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSDWANTrafficDistributionProfilesAll returns an iterator over every SdwanTrafficDistributionProfiles object.
+//
+// It is a shorthand for ListSDWANTrafficDistributionProfiles(ctx).All(opts...), see ApiListSDWANTrafficDistributionProfilesRequest.All.
+func (a *SDWANTrafficDistributionProfilesAPIService) ListSDWANTrafficDistributionProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SdwanTrafficDistributionProfiles, error] {
+	return a.ListSDWANTrafficDistributionProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every SystemMatchList object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSystemMatchList(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSystemMatchListRequest) All(opts ...api.PageOptions) iter.Seq2[SystemMatchList, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SystemMatchList, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSystemMatchListAll returns an iterator over every SystemMatchList object.
+//
+// It is a shorthand for ListSystemMatchList(ctx).All(opts...), see ApiListSystemMatchListRequest.All.
+func (a *SystemMatchListAPIService) ListSystemMatchListAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SystemMatchList, error] {
+	return a.ListSystemMatchList(ctx).All(opts...)
+}
+
+// All returns an iterator over every TunnelInterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListTunnelInterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListTunnelInterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[TunnelInterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]TunnelInterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListTunnelInterfacesAll returns an iterator over every TunnelInterfaces object.
+//
+// It is a shorthand for ListTunnelInterfaces(ctx).All(opts...), see ApiListTunnelInterfacesRequest.All.
+func (a *TunnelInterfacesAPIService) ListTunnelInterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[TunnelInterfaces, error] {
+	return a.ListTunnelInterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every UseridMatchList object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListUseridMatchList(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListUseridMatchListRequest) All(opts ...api.PageOptions) iter.Seq2[UseridMatchList, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]UseridMatchList, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListUseridMatchListAll returns an iterator over every UseridMatchList object.
+//
+// It is a shorthand for ListUseridMatchList(ctx).All(opts...), see ApiListUseridMatchListRequest.All.
+func (a *UseridMatchListAPIService) ListUseridMatchListAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[UseridMatchList, error] {
+	return a.ListUseridMatchList(ctx).All(opts...)
+}
+
+// All returns an iterator over every VlanInterfaces object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListVLANInterfaces(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListVLANInterfacesRequest) All(opts ...api.PageOptions) iter.Seq2[VlanInterfaces, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]VlanInterfaces, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListVLANInterfacesAll returns an iterator over every VlanInterfaces object.
+//
+// It is a shorthand for ListVLANInterfaces(ctx).All(opts...), see ApiListVLANInterfacesRequest.All.
+func (a *VLANInterfacesAPIService) ListVLANInterfacesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[VlanInterfaces, error] {
+	return a.ListVLANInterfaces(ctx).All(opts...)
+}
+
+// All returns an iterator over every ZoneProtectionProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListZoneProtectionProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListZoneProtectionProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[ZoneProtectionProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ZoneProtectionProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListZoneProtectionProfilesAll returns an iterator over every ZoneProtectionProfiles object.
+//
+// It is a shorthand for ListZoneProtectionProfiles(ctx).All(opts...), see ApiListZoneProtectionProfilesRequest.All.
+func (a *ZoneProtectionProfilesAPIService) ListZoneProtectionProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ZoneProtectionProfiles, error] {
+	return a.ListZoneProtectionProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every Zones object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListZones(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListZonesRequest) All(opts ...api.PageOptions) iter.Seq2[Zones, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Zones, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListZonesAll returns an iterator over every Zones object.
+//
+// It is a shorthand for ListZones(ctx).All(opts...), see ApiListZonesRequest.All.
+func (a *SecurityZonesAPIService) ListZonesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Zones, error] {
+	return a.ListZones(ctx).All(opts...)
+}
@@ -0,0 +1,1729 @@
+// Code generated by scopegen. DO NOT EDIT.
+
+package network_services
+
+import (
+	"context"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AggregateInterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AggregateInterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *BgpAddressFamilyProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *BgpAddressFamilyProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *BgpAuthProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *BgpAuthProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *BgpFilteringProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *BgpFilteringProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *BgpRedistributionProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *BgpRedistributionProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *BgpRouteMapRedistributions) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *BgpRouteMapRedistributions) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *BgpRouteMaps) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *BgpRouteMaps) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ConfigMatchList) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ConfigMatchList) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *DhcpInterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *DhcpInterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *DnsProxies) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *DnsProxies) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *EthernetInterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *EthernetInterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *GlobalprotectMatchList) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *GlobalprotectMatchList) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *HipmatchMatchList) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *HipmatchMatchList) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *IkeCryptoProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *IkeCryptoProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *IkeGateways) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *IkeGateways) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *InterfaceManagementProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *InterfaceManagementProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *IpsecCryptoProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *IpsecCryptoProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *IpsecTunnels) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *IpsecTunnels) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *IptagMatchList) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *IptagMatchList) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Layer2Subinterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Layer2Subinterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Layer3Subinterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Layer3Subinterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LinkTags) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LinkTags) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LldpProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LldpProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LogicalRouters) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LogicalRouters) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LoopbackInterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LoopbackInterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *NatRules) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *NatRules) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *OspfAuthProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *OspfAuthProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *PbfRules) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *PbfRules) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *QosPolicyRules) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *QosPolicyRules) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *QosProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *QosProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *RouteAccessLists) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *RouteAccessLists) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *RouteCommunityLists) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *RouteCommunityLists) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *RoutePathAccessLists) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *RoutePathAccessLists) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *RoutePrefixLists) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *RoutePrefixLists) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SdwanErrorCorrectionProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SdwanErrorCorrectionProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SdwanPathQualityProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SdwanPathQualityProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SdwanRules) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SdwanRules) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SdwanSaasQualityProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SdwanSaasQualityProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SdwanTrafficDistributionProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SdwanTrafficDistributionProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SystemMatchList) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SystemMatchList) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *TunnelInterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *TunnelInterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *UseridMatchList) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *UseridMatchList) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *VlanInterfaces) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *VlanInterfaces) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ZoneProtectionProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ZoneProtectionProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Zones) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Zones) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAggregateInterfacesRequest) Scope(s api.Scope) ApiListAggregateInterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListBGPAddressFamilyProfilesRequest) Scope(s api.Scope) ApiListBGPAddressFamilyProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListBGPAuthenticationProfilesRequest) Scope(s api.Scope) ApiListBGPAuthenticationProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListBGPFilteringProfilesRequest) Scope(s api.Scope) ApiListBGPFilteringProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListBGPRedistributionProfilesRequest) Scope(s api.Scope) ApiListBGPRedistributionProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListBGPRouteMapRedistributionsRequest) Scope(s api.Scope) ApiListBGPRouteMapRedistributionsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListBGPRouteMapsRequest) Scope(s api.Scope) ApiListBGPRouteMapsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListConfigMatchListRequest) Scope(s api.Scope) ApiListConfigMatchListRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListDHCPInterfacesRequest) Scope(s api.Scope) ApiListDHCPInterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListDNSProxiesRequest) Scope(s api.Scope) ApiListDNSProxiesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListEthernetInterfacesRequest) Scope(s api.Scope) ApiListEthernetInterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListGlobalprotectMatchListRequest) Scope(s api.Scope) ApiListGlobalprotectMatchListRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListHipmatchMatchListRequest) Scope(s api.Scope) ApiListHipmatchMatchListRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListIKECryptoProfilesRequest) Scope(s api.Scope) ApiListIKECryptoProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListIKEGatewaysRequest) Scope(s api.Scope) ApiListIKEGatewaysRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListIPsecCryptoProfilesRequest) Scope(s api.Scope) ApiListIPsecCryptoProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListIPsecTunnelsRequest) Scope(s api.Scope) ApiListIPsecTunnelsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListInterfaceManagementProfilesRequest) Scope(s api.Scope) ApiListInterfaceManagementProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListIptagMatchListRequest) Scope(s api.Scope) ApiListIptagMatchListRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLLDPProfilesRequest) Scope(s api.Scope) ApiListLLDPProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLayer2SubinterfacesRequest) Scope(s api.Scope) ApiListLayer2SubinterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLayer3SubinterfacesRequest) Scope(s api.Scope) ApiListLayer3SubinterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLinkTagsRequest) Scope(s api.Scope) ApiListLinkTagsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLogicalRoutersRequest) Scope(s api.Scope) ApiListLogicalRoutersRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLoopbackInterfacesRequest) Scope(s api.Scope) ApiListLoopbackInterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListNatRulesRequest) Scope(s api.Scope) ApiListNatRulesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListOSPFAuthenticationProfilesRequest) Scope(s api.Scope) ApiListOSPFAuthenticationProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListPBFRulesRequest) Scope(s api.Scope) ApiListPBFRulesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListQoSPolicyRulesRequest) Scope(s api.Scope) ApiListQoSPolicyRulesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListQoSProfilesRequest) Scope(s api.Scope) ApiListQoSProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListRouteAccessListsRequest) Scope(s api.Scope) ApiListRouteAccessListsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListRouteCommunityListsRequest) Scope(s api.Scope) ApiListRouteCommunityListsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListRoutePathAccessListsRequest) Scope(s api.Scope) ApiListRoutePathAccessListsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListRoutePrefixListsRequest) Scope(s api.Scope) ApiListRoutePrefixListsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSDWANErrorCorrectionProfilesRequest) Scope(s api.Scope) ApiListSDWANErrorCorrectionProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSDWANPathQualityProfilesRequest) Scope(s api.Scope) ApiListSDWANPathQualityProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSDWANRulesRequest) Scope(s api.Scope) ApiListSDWANRulesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSDWANSaaSQualityProfilesRequest) Scope(s api.Scope) ApiListSDWANSaaSQualityProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSDWANTrafficDistributionProfilesRequest) Scope(s api.Scope) ApiListSDWANTrafficDistributionProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSystemMatchListRequest) Scope(s api.Scope) ApiListSystemMatchListRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListTunnelInterfacesRequest) Scope(s api.Scope) ApiListTunnelInterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListUseridMatchListRequest) Scope(s api.Scope) ApiListUseridMatchListRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListVLANInterfacesRequest) Scope(s api.Scope) ApiListVLANInterfacesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListZoneProtectionProfilesRequest) Scope(s api.Scope) ApiListZoneProtectionProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListZonesRequest) Scope(s api.Scope) ApiListZonesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// FetchAggregateInterfacesInScope is FetchAggregateInterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AggregateInterfacesAPIService) FetchAggregateInterfacesInScope(ctx context.Context, name string, s api.Scope) (*AggregateInterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAggregateInterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchAutoVPNClustersInScope is FetchAutoVPNClusters for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AutoVPNClustersAPIService) FetchAutoVPNClustersInScope(ctx context.Context, name string, s api.Scope) (*AutoVpnClusters, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAutoVPNClusters(ctx, name, folder, snippet, device)
+}
+
+// FetchBGPAddressFamilyProfilesInScope is FetchBGPAddressFamilyProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *BGPAddressFamilyProfilesAPIService) FetchBGPAddressFamilyProfilesInScope(ctx context.Context, name string, s api.Scope) (*BgpAddressFamilyProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchBGPAddressFamilyProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchBGPAuthenticationProfilesInScope is FetchBGPAuthenticationProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *BGPAuthenticationProfilesAPIService) FetchBGPAuthenticationProfilesInScope(ctx context.Context, name string, s api.Scope) (*BgpAuthProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchBGPAuthenticationProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchBGPFilteringProfilesInScope is FetchBGPFilteringProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *BGPFilteringProfilesAPIService) FetchBGPFilteringProfilesInScope(ctx context.Context, name string, s api.Scope) (*BgpFilteringProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchBGPFilteringProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchBGPRedistributionProfilesInScope is FetchBGPRedistributionProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *BGPRedistributionProfilesAPIService) FetchBGPRedistributionProfilesInScope(ctx context.Context, name string, s api.Scope) (*BgpRedistributionProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchBGPRedistributionProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchBGPRouteMapRedistributionsInScope is FetchBGPRouteMapRedistributions for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *BGPRouteMapRedistributionsAPIService) FetchBGPRouteMapRedistributionsInScope(ctx context.Context, name string, s api.Scope) (*BgpRouteMapRedistributions, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchBGPRouteMapRedistributions(ctx, name, folder, snippet, device)
+}
+
+// FetchBGPRouteMapsInScope is FetchBGPRouteMaps for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *BGPRouteMapsAPIService) FetchBGPRouteMapsInScope(ctx context.Context, name string, s api.Scope) (*BgpRouteMaps, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchBGPRouteMaps(ctx, name, folder, snippet, device)
+}
+
+// FetchConfigMatchListInScope is FetchConfigMatchList for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ConfigMatchListAPIService) FetchConfigMatchListInScope(ctx context.Context, name string, s api.Scope) (*ConfigMatchList, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchConfigMatchList(ctx, name, folder, snippet, device)
+}
+
+// FetchDHCPInterfacesInScope is FetchDHCPInterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *DHCPInterfacesAPIService) FetchDHCPInterfacesInScope(ctx context.Context, name string, s api.Scope) (*DhcpInterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchDHCPInterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchDNSProxiesInScope is FetchDNSProxies for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *DNSProxiesAPIService) FetchDNSProxiesInScope(ctx context.Context, name string, s api.Scope) (*DnsProxies, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchDNSProxies(ctx, name, folder, snippet, device)
+}
+
+// FetchEthernetInterfacesInScope is FetchEthernetInterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *EthernetInterfacesAPIService) FetchEthernetInterfacesInScope(ctx context.Context, name string, s api.Scope) (*EthernetInterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchEthernetInterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchGlobalprotectMatchListInScope is FetchGlobalprotectMatchList for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *GlobalprotectMatchListAPIService) FetchGlobalprotectMatchListInScope(ctx context.Context, name string, s api.Scope) (*GlobalprotectMatchList, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchGlobalprotectMatchList(ctx, name, folder, snippet, device)
+}
+
+// FetchHipmatchMatchListInScope is FetchHipmatchMatchList for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *HipmatchMatchListAPIService) FetchHipmatchMatchListInScope(ctx context.Context, name string, s api.Scope) (*HipmatchMatchList, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchHipmatchMatchList(ctx, name, folder, snippet, device)
+}
+
+// FetchIKECryptoProfilesInScope is FetchIKECryptoProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *IKECryptoProfilesAPIService) FetchIKECryptoProfilesInScope(ctx context.Context, name string, s api.Scope) (*IkeCryptoProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchIKECryptoProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchIKEGatewaysInScope is FetchIKEGateways for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *IKEGatewaysAPIService) FetchIKEGatewaysInScope(ctx context.Context, name string, s api.Scope) (*IkeGateways, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchIKEGateways(ctx, name, folder, snippet, device)
+}
+
+// FetchIPsecCryptoProfilesInScope is FetchIPsecCryptoProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *IPsecCryptoProfilesAPIService) FetchIPsecCryptoProfilesInScope(ctx context.Context, name string, s api.Scope) (*IpsecCryptoProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchIPsecCryptoProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchIPsecTunnelsInScope is FetchIPsecTunnels for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *IPsecTunnelsAPIService) FetchIPsecTunnelsInScope(ctx context.Context, name string, s api.Scope) (*IpsecTunnels, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchIPsecTunnels(ctx, name, folder, snippet, device)
+}
+
+// FetchInterfaceManagementProfilesInScope is FetchInterfaceManagementProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *InterfaceManagementProfilesAPIService) FetchInterfaceManagementProfilesInScope(ctx context.Context, name string, s api.Scope) (*InterfaceManagementProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchInterfaceManagementProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchIptagMatchListInScope is FetchIptagMatchList for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *IptagMatchListAPIService) FetchIptagMatchListInScope(ctx context.Context, name string, s api.Scope) (*IptagMatchList, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchIptagMatchList(ctx, name, folder, snippet, device)
+}
+
+// FetchLLDPProfilesInScope is FetchLLDPProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LLDPProfilesAPIService) FetchLLDPProfilesInScope(ctx context.Context, name string, s api.Scope) (*LldpProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLLDPProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchLayer2SubinterfacesInScope is FetchLayer2Subinterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *Layer2SubinterfacesAPIService) FetchLayer2SubinterfacesInScope(ctx context.Context, name string, s api.Scope) (*Layer2Subinterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLayer2Subinterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchLayer3SubinterfacesInScope is FetchLayer3Subinterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *Layer3SubinterfacesAPIService) FetchLayer3SubinterfacesInScope(ctx context.Context, name string, s api.Scope) (*Layer3Subinterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLayer3Subinterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchLinkTagsInScope is FetchLinkTags for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LinkTagsAPIService) FetchLinkTagsInScope(ctx context.Context, name string, s api.Scope) (*LinkTags, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLinkTags(ctx, name, folder, snippet, device)
+}
+
+// FetchLogicalRoutersInScope is FetchLogicalRouters for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LogicalRoutersAPIService) FetchLogicalRoutersInScope(ctx context.Context, name string, s api.Scope) (*LogicalRouters, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLogicalRouters(ctx, name, folder, snippet, device)
+}
+
+// FetchLoopbackInterfacesInScope is FetchLoopbackInterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LoopbackInterfacesAPIService) FetchLoopbackInterfacesInScope(ctx context.Context, name string, s api.Scope) (*LoopbackInterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLoopbackInterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchNATRulesInScope is FetchNATRules for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *NATRulesAPIService) FetchNATRulesInScope(ctx context.Context, name string, s api.Scope) (*NatRules, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchNATRules(ctx, name, folder, snippet, device)
+}
+
+// FetchOSPFAuthenticationProfilesInScope is FetchOSPFAuthenticationProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *OSPFAuthenticationProfilesAPIService) FetchOSPFAuthenticationProfilesInScope(ctx context.Context, name string, s api.Scope) (*OspfAuthProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchOSPFAuthenticationProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchPBFRulesInScope is FetchPBFRules for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *PBFRulesAPIService) FetchPBFRulesInScope(ctx context.Context, name string, s api.Scope) (*PbfRules, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchPBFRules(ctx, name, folder, snippet, device)
+}
+
+// FetchQoSProfilesInScope is FetchQoSProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *QoSProfilesAPIService) FetchQoSProfilesInScope(ctx context.Context, name string, s api.Scope) (*QosProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchQoSProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchQoSRulesInScope is FetchQoSRules for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *QoSRulesAPIService) FetchQoSRulesInScope(ctx context.Context, name string, s api.Scope) (*QosPolicyRules, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchQoSRules(ctx, name, folder, snippet, device)
+}
+
+// FetchRouteAccessListsInScope is FetchRouteAccessLists for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *RouteAccessListsAPIService) FetchRouteAccessListsInScope(ctx context.Context, name string, s api.Scope) (*RouteAccessLists, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchRouteAccessLists(ctx, name, folder, snippet, device)
+}
+
+// FetchRouteCommunityListsInScope is FetchRouteCommunityLists for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *RouteCommunityListsAPIService) FetchRouteCommunityListsInScope(ctx context.Context, name string, s api.Scope) (*RouteCommunityLists, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchRouteCommunityLists(ctx, name, folder, snippet, device)
+}
+
+// FetchRoutePathAccessListsInScope is FetchRoutePathAccessLists for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *RoutePathAccessListsAPIService) FetchRoutePathAccessListsInScope(ctx context.Context, name string, s api.Scope) (*RoutePathAccessLists, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchRoutePathAccessLists(ctx, name, folder, snippet, device)
+}
+
+// FetchRoutePrefixListsInScope is FetchRoutePrefixLists for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *RoutePrefixListsAPIService) FetchRoutePrefixListsInScope(ctx context.Context, name string, s api.Scope) (*RoutePrefixLists, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchRoutePrefixLists(ctx, name, folder, snippet, device)
+}
+
+// FetchSDWANErrorCorrectionProfilesInScope is FetchSDWANErrorCorrectionProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SDWANErrorCorrectionProfilesAPIService) FetchSDWANErrorCorrectionProfilesInScope(ctx context.Context, name string, s api.Scope) (*SdwanErrorCorrectionProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSDWANErrorCorrectionProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchSDWANPathQualityProfilesInScope is FetchSDWANPathQualityProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SDWANPathQualityProfilesAPIService) FetchSDWANPathQualityProfilesInScope(ctx context.Context, name string, s api.Scope) (*SdwanPathQualityProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSDWANPathQualityProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchSDWANRulesInScope is FetchSDWANRules for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SDWANRulesAPIService) FetchSDWANRulesInScope(ctx context.Context, name string, s api.Scope) (*SdwanRules, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSDWANRules(ctx, name, folder, snippet, device)
+}
+
+// FetchSDWANSaaSQualityProfilesInScope is FetchSDWANSaaSQualityProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SDWANSaaSQualityProfilesAPIService) FetchSDWANSaaSQualityProfilesInScope(ctx context.Context, name string, s api.Scope) (*SdwanSaasQualityProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSDWANSaaSQualityProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchSDWANTrafficDistributionProfilesInScope is FetchSDWANTrafficDistributionProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SDWANTrafficDistributionProfilesAPIService) FetchSDWANTrafficDistributionProfilesInScope(ctx context.Context, name string, s api.Scope) (*SdwanTrafficDistributionProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSDWANTrafficDistributionProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchSecurityZonesInScope is FetchSecurityZones for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SecurityZonesAPIService) FetchSecurityZonesInScope(ctx context.Context, name string, s api.Scope) (*Zones, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSecurityZones(ctx, name, folder, snippet, device)
+}
+
+// FetchSystemMatchListInScope is FetchSystemMatchList for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SystemMatchListAPIService) FetchSystemMatchListInScope(ctx context.Context, name string, s api.Scope) (*SystemMatchList, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSystemMatchList(ctx, name, folder, snippet, device)
+}
+
+// FetchTunnelInterfacesInScope is FetchTunnelInterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *TunnelInterfacesAPIService) FetchTunnelInterfacesInScope(ctx context.Context, name string, s api.Scope) (*TunnelInterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchTunnelInterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchUseridMatchListInScope is FetchUseridMatchList for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *UseridMatchListAPIService) FetchUseridMatchListInScope(ctx context.Context, name string, s api.Scope) (*UseridMatchList, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchUseridMatchList(ctx, name, folder, snippet, device)
+}
+
+// FetchVLANInterfacesInScope is FetchVLANInterfaces for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *VLANInterfacesAPIService) FetchVLANInterfacesInScope(ctx context.Context, name string, s api.Scope) (*VlanInterfaces, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchVLANInterfaces(ctx, name, folder, snippet, device)
+}
+
+// FetchZoneProtectionProfilesInScope is FetchZoneProtectionProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ZoneProtectionProfilesAPIService) FetchZoneProtectionProfilesInScope(ctx context.Context, name string, s api.Scope) (*ZoneProtectionProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchZoneProtectionProfiles(ctx, name, folder, snippet, device)
+}
@@ -0,0 +1,6744 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package network_services
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var aggregateInterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AggregateInterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggregateInterfacesListResponse) Validate() error {
+	return aggregateInterfacesListResponseSchema.Validate(o)
+}
+
+var autoVPNClustersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AutoVPNClustersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVPNClustersListResponse) Validate() error {
+	return autoVPNClustersListResponseSchema.Validate(o)
+}
+
+var bGPAddressFamilyProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the BGPAddressFamilyProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BGPAddressFamilyProfilesListResponse) Validate() error {
+	return bGPAddressFamilyProfilesListResponseSchema.Validate(o)
+}
+
+var bGPAuthenticationProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the BGPAuthenticationProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BGPAuthenticationProfilesListResponse) Validate() error {
+	return bGPAuthenticationProfilesListResponseSchema.Validate(o)
+}
+
+var bGPFilteringProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the BGPFilteringProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BGPFilteringProfilesListResponse) Validate() error {
+	return bGPFilteringProfilesListResponseSchema.Validate(o)
+}
+
+var bGPRedistributionProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the BGPRedistributionProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BGPRedistributionProfilesListResponse) Validate() error {
+	return bGPRedistributionProfilesListResponseSchema.Validate(o)
+}
+
+var bGPRouteMapRedistributionsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the BGPRouteMapRedistributionsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BGPRouteMapRedistributionsListResponse) Validate() error {
+	return bGPRouteMapRedistributionsListResponseSchema.Validate(o)
+}
+
+var bGPRouteMapsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the BGPRouteMapsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BGPRouteMapsListResponse) Validate() error {
+	return bGPRouteMapsListResponseSchema.Validate(o)
+}
+
+var configMatchListListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ConfigMatchListListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ConfigMatchListListResponse) Validate() error {
+	return configMatchListListResponseSchema.Validate(o)
+}
+
+var dHCPInterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DHCPInterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DHCPInterfacesListResponse) Validate() error {
+	return dHCPInterfacesListResponseSchema.Validate(o)
+}
+
+var dNSProxiesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DNSProxiesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DNSProxiesListResponse) Validate() error {
+	return dNSProxiesListResponseSchema.Validate(o)
+}
+
+var ethernetInterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the EthernetInterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesListResponse) Validate() error {
+	return ethernetInterfacesListResponseSchema.Validate(o)
+}
+
+var getAutoVPNMonitor200ResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the GetAutoVPNMonitor_200_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GetAutoVPNMonitor200Response) Validate() error {
+	return getAutoVPNMonitor200ResponseSchema.Validate(o)
+}
+
+var globalprotectMatchListListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the GlobalprotectMatchListListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GlobalprotectMatchListListResponse) Validate() error {
+	return globalprotectMatchListListResponseSchema.Validate(o)
+}
+
+var hipmatchMatchListListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the HipmatchMatchListListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipmatchMatchListListResponse) Validate() error {
+	return hipmatchMatchListListResponseSchema.Validate(o)
+}
+
+var iKECryptoProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the IKECryptoProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IKECryptoProfilesListResponse) Validate() error {
+	return iKECryptoProfilesListResponseSchema.Validate(o)
+}
+
+var iKEGatewaysListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the IKEGatewaysListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IKEGatewaysListResponse) Validate() error {
+	return iKEGatewaysListResponseSchema.Validate(o)
+}
+
+var iPsecCryptoProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the IPsecCryptoProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IPsecCryptoProfilesListResponse) Validate() error {
+	return iPsecCryptoProfilesListResponseSchema.Validate(o)
+}
+
+var iPsecTunnelsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the IPsecTunnelsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IPsecTunnelsListResponse) Validate() error {
+	return iPsecTunnelsListResponseSchema.Validate(o)
+}
+
+var interfaceManagementProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the InterfaceManagementProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InterfaceManagementProfilesListResponse) Validate() error {
+	return interfaceManagementProfilesListResponseSchema.Validate(o)
+}
+
+var iptagMatchListListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the IptagMatchListListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IptagMatchListListResponse) Validate() error {
+	return iptagMatchListListResponseSchema.Validate(o)
+}
+
+var lLDPProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LLDPProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LLDPProfilesListResponse) Validate() error {
+	return lLDPProfilesListResponseSchema.Validate(o)
+}
+
+var layer2SubinterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the Layer2SubinterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer2SubinterfacesListResponse) Validate() error {
+	return layer2SubinterfacesListResponseSchema.Validate(o)
+}
+
+var layer3SubinterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the Layer3SubinterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3SubinterfacesListResponse) Validate() error {
+	return layer3SubinterfacesListResponseSchema.Validate(o)
+}
+
+var licenseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the LicenseInfo schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LicenseInfo) Validate() error {
+	return licenseInfoSchema.Validate(o)
+}
+
+var licenseResultSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the LicenseResult schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LicenseResult) Validate() error {
+	return licenseResultSchema.Validate(o)
+}
+
+var linkTagsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LinkTagsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LinkTagsListResponse) Validate() error {
+	return linkTagsListResponseSchema.Validate(o)
+}
+
+var logicalRoutersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LogicalRoutersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersListResponse) Validate() error {
+	return logicalRoutersListResponseSchema.Validate(o)
+}
+
+var loopbackInterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LoopbackInterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LoopbackInterfacesListResponse) Validate() error {
+	return loopbackInterfacesListResponseSchema.Validate(o)
+}
+
+var natRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the NatRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesListResponse) Validate() error {
+	return natRulesListResponseSchema.Validate(o)
+}
+
+var oSPFAuthenticationProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the OSPFAuthenticationProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o OSPFAuthenticationProfilesListResponse) Validate() error {
+	return oSPFAuthenticationProfilesListResponseSchema.Validate(o)
+}
+
+var pBFRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the PBFRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PBFRulesListResponse) Validate() error {
+	return pBFRulesListResponseSchema.Validate(o)
+}
+
+var qoSPolicyRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the QoSPolicyRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QoSPolicyRulesListResponse) Validate() error {
+	return qoSPolicyRulesListResponseSchema.Validate(o)
+}
+
+var qoSProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the QoSProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QoSProfilesListResponse) Validate() error {
+	return qoSProfilesListResponseSchema.Validate(o)
+}
+
+var routeAccessListsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RouteAccessListsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsListResponse) Validate() error {
+	return routeAccessListsListResponseSchema.Validate(o)
+}
+
+var routeCommunityListsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RouteCommunityListsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsListResponse) Validate() error {
+	return routeCommunityListsListResponseSchema.Validate(o)
+}
+
+var routePathAccessListsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RoutePathAccessListsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePathAccessListsListResponse) Validate() error {
+	return routePathAccessListsListResponseSchema.Validate(o)
+}
+
+var routePrefixListsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RoutePrefixListsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePrefixListsListResponse) Validate() error {
+	return routePrefixListsListResponseSchema.Validate(o)
+}
+
+var sDWANErrorCorrectionProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SDWANErrorCorrectionProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SDWANErrorCorrectionProfilesListResponse) Validate() error {
+	return sDWANErrorCorrectionProfilesListResponseSchema.Validate(o)
+}
+
+var sDWANPathQualityProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SDWANPathQualityProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SDWANPathQualityProfilesListResponse) Validate() error {
+	return sDWANPathQualityProfilesListResponseSchema.Validate(o)
+}
+
+var sDWANRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SDWANRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SDWANRulesListResponse) Validate() error {
+	return sDWANRulesListResponseSchema.Validate(o)
+}
+
+var sDWANSaaSQualityProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SDWANSaaSQualityProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SDWANSaaSQualityProfilesListResponse) Validate() error {
+	return sDWANSaaSQualityProfilesListResponseSchema.Validate(o)
+}
+
+var sDWANTrafficDistributionProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SDWANTrafficDistributionProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SDWANTrafficDistributionProfilesListResponse) Validate() error {
+	return sDWANTrafficDistributionProfilesListResponseSchema.Validate(o)
+}
+
+var systemMatchListListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SystemMatchListListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SystemMatchListListResponse) Validate() error {
+	return systemMatchListListResponseSchema.Validate(o)
+}
+
+var tunnelInterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the TunnelInterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TunnelInterfacesListResponse) Validate() error {
+	return tunnelInterfacesListResponseSchema.Validate(o)
+}
+
+var useridMatchListListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the UseridMatchListListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UseridMatchListListResponse) Validate() error {
+	return useridMatchListListResponseSchema.Validate(o)
+}
+
+var vLANInterfacesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the VLANInterfacesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VLANInterfacesListResponse) Validate() error {
+	return vLANInterfacesListResponseSchema.Validate(o)
+}
+
+var zoneProtectionProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ZoneProtectionProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesListResponse) Validate() error {
+	return zoneProtectionProfilesListResponseSchema.Validate(o)
+}
+
+var zonesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ZonesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZonesListResponse) Validate() error {
+	return zonesListResponseSchema.Validate(o)
+}
+
+var aggEthernetDhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the agg-ethernet-dhcp-client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggEthernetDhcpClient) Validate() error {
+	return aggEthernetDhcpClientSchema.Validate(o)
+}
+
+var aggEthernetArpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the agg_ethernet_arp_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggEthernetArpInner) Validate() error {
+	return aggEthernetArpInnerSchema.Validate(o)
+}
+
+var aggEthernetDhcpClientDhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_route_metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the agg_ethernet_dhcp_client_dhcp_client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggEthernetDhcpClientDhcpClient) Validate() error {
+	return aggEthernetDhcpClientDhcpClientSchema.Validate(o)
+}
+
+var aggEthernetDhcpClientDhcpClientSendHostnameSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"hostname": {Pattern: `^[a-zA-Z0-9\._-]+$`, MinLength: 1, MaxLength: 64},
+	},
+}
+
+// Validate checks o against the constraints of the agg_ethernet_dhcp_client_dhcp_client_send_hostname schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggEthernetDhcpClientDhcpClientSendHostname) Validate() error {
+	return aggEthernetDhcpClientDhcpClientSendHostnameSchema.Validate(o)
+}
+
+var aggregateInterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"comment": {MaxLength: 1023},
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the aggregate-interfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggregateInterfaces) Validate() error {
+	return aggregateInterfacesSchema.Validate(o)
+}
+
+var aggregateInterfacesLayer2Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"vlan_tag": {Pattern: `^([1-9]\d{0,2}|[1-3]\d{3}|40[0-8]\d|409[0-6])$`},
+	},
+}
+
+// Validate checks o against the constraints of the aggregate_interfaces_layer2 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggregateInterfacesLayer2) Validate() error {
+	return aggregateInterfacesLayer2Schema.Validate(o)
+}
+
+var aggregateInterfacesLayer3Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interface_management_profile": {MaxLength: 31},
+		"mtu":                          {Minimum: validate.Bound(576), Maximum: validate.Bound(9216)},
+	},
+}
+
+// Validate checks o against the constraints of the aggregate_interfaces_layer3 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggregateInterfacesLayer3) Validate() error {
+	return aggregateInterfacesLayer3Schema.Validate(o)
+}
+
+var aggregateInterfacesLayer3DdnsConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ddns_cert_profile":    {Required: true},
+		"ddns_hostname":        {Required: true, Pattern: `^[a-zA-Z0-9_\.\-]+$`, MaxLength: 255},
+		"ddns_update_interval": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+		"ddns_vendor":          {Required: true, MaxLength: 127},
+		"ddns_vendor_config":   {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the aggregate_interfaces_layer3_ddns_config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggregateInterfacesLayer3DdnsConfig) Validate() error {
+	return aggregateInterfacesLayer3DdnsConfigSchema.Validate(o)
+}
+
+var aggregateInterfacesLayer3IpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the aggregate_interfaces_layer3_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AggregateInterfacesLayer3IpInner) Validate() error {
+	return aggregateInterfacesLayer3IpInnerSchema.Validate(o)
+}
+
+var autoVpnClustersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"type": {Enum: []string{"hub-spoke", "mesh"}},
+	},
+}
+
+// Validate checks o against the constraints of the auto-vpn-clusters schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClusters) Validate() error {
+	return autoVpnClustersSchema.Validate(o)
+}
+
+var autoVpnMonitorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto-vpn-monitor schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnMonitor) Validate() error {
+	return autoVpnMonitorSchema.Validate(o)
+}
+
+var autoVpnPushConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto-vpn-push-config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnPushConfig) Validate() error {
+	return autoVpnPushConfigSchema.Validate(o)
+}
+
+var autoVpnPushResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto-vpn-push-response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnPushResponse) Validate() error {
+	return autoVpnPushResponseSchema.Validate(o)
+}
+
+var autoVpnSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"as_range":         {Required: true},
+		"vpn_address_pool": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the auto-vpn-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnSettings) Validate() error {
+	return autoVpnSettingsSchema.Validate(o)
+}
+
+var autoVpnClustersBranchesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interfaces":         {MaxItems: 4},
+		"private_interfaces": {MaxItems: 4},
+	},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_branches_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersBranchesInner) Validate() error {
+	return autoVpnClustersBranchesInnerSchema.Validate(o)
+}
+
+var autoVpnClustersBranchesInnerInterfacesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_branches_inner_interfaces_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersBranchesInnerInterfacesInner) Validate() error {
+	return autoVpnClustersBranchesInnerInterfacesInnerSchema.Validate(o)
+}
+
+var autoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_branches_inner_interfaces_inner_sdwan_link_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettings) Validate() error {
+	return autoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsSchema.Validate(o)
+}
+
+var autoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsUpstreamNatSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_branches_inner_interfaces_inner_sdwan_link_settings_upstream_nat schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsUpstreamNat) Validate() error {
+	return autoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsUpstreamNatSchema.Validate(o)
+}
+
+var autoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsUpstreamNatStaticIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_branches_inner_interfaces_inner_sdwan_link_settings_upstream_nat_static_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsUpstreamNatStaticIp) Validate() error {
+	return autoVpnClustersBranchesInnerInterfacesInnerSdwanLinkSettingsUpstreamNatStaticIpSchema.Validate(o)
+}
+
+var autoVpnClustersBranchesInnerPrivateInterfacesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_branches_inner_private_interfaces_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersBranchesInnerPrivateInterfacesInner) Validate() error {
+	return autoVpnClustersBranchesInnerPrivateInterfacesInnerSchema.Validate(o)
+}
+
+var autoVpnClustersGatewaysInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"priority": {Enum: []string{"1", "2", "3", "4", "5", "6", "7", "8"}},
+	},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_gateways_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersGatewaysInner) Validate() error {
+	return autoVpnClustersGatewaysInnerSchema.Validate(o)
+}
+
+var autoVpnClustersGatewaysInnerInterfacesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_gateways_inner_interfaces_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersGatewaysInnerInterfacesInner) Validate() error {
+	return autoVpnClustersGatewaysInnerInterfacesInnerSchema.Validate(o)
+}
+
+var autoVpnClustersGatewaysInnerInterfacesInnerSdwanLinkSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_gateways_inner_interfaces_inner_sdwan_link_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersGatewaysInnerInterfacesInnerSdwanLinkSettings) Validate() error {
+	return autoVpnClustersGatewaysInnerInterfacesInnerSdwanLinkSettingsSchema.Validate(o)
+}
+
+var autoVpnClustersGatewaysInnerInterfacesInnerSdwanLinkSettingsUpstreamNatSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_gateways_inner_interfaces_inner_sdwan_link_settings_upstream_nat schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersGatewaysInnerInterfacesInnerSdwanLinkSettingsUpstreamNat) Validate() error {
+	return autoVpnClustersGatewaysInnerInterfacesInnerSdwanLinkSettingsUpstreamNatSchema.Validate(o)
+}
+
+var autoVpnClustersGatewaysInnerPrivateInterfacesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_clusters_gateways_inner_private_interfaces_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnClustersGatewaysInnerPrivateInterfacesInner) Validate() error {
+	return autoVpnClustersGatewaysInnerPrivateInterfacesInnerSchema.Validate(o)
+}
+
+var autoVpnPushConfigAutoVpnDevicesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the auto_vpn_push_config_auto_vpn_devices_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnPushConfigAutoVpnDevicesInner) Validate() error {
+	return autoVpnPushConfigAutoVpnDevicesInnerSchema.Validate(o)
+}
+
+var autoVpnSettingsAsRangeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"end":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"start": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the auto_vpn_settings_as_range schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoVpnSettingsAsRange) Validate() error {
+	return autoVpnSettingsAsRangeSchema.Validate(o)
+}
+
+var bgpAddressFamilySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp-address-family schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamily) Validate() error {
+	return bgpAddressFamilySchema.Validate(o)
+}
+
+var bgpAddressFamilyProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp-address-family-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyProfiles) Validate() error {
+	return bgpAddressFamilyProfilesSchema.Validate(o)
+}
+
+var bgpAuthProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp-auth-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAuthProfiles) Validate() error {
+	return bgpAuthProfilesSchema.Validate(o)
+}
+
+var bgpFilterSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp-filter schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilter) Validate() error {
+	return bgpFilterSchema.Validate(o)
+}
+
+var bgpFilteringProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp-filtering-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilteringProfiles) Validate() error {
+	return bgpFilteringProfilesSchema.Validate(o)
+}
+
+var bgpRedistributionProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"ipv4":    {Required: true},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp-redistribution-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRedistributionProfiles) Validate() error {
+	return bgpRedistributionProfilesSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp-route-map-redistributions schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributions) Validate() error {
+	return bgpRouteMapRedistributionsSchema.Validate(o)
+}
+
+var bgpRouteMapsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp-route-maps schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMaps) Validate() error {
+	return bgpRouteMapsSchema.Validate(o)
+}
+
+var bgpAddressFamilyAddPathSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_add_path schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyAddPath) Validate() error {
+	return bgpAddressFamilyAddPathSchema.Validate(o)
+}
+
+var bgpAddressFamilyAllowasInSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"occurrence": {Minimum: validate.Bound(1), Maximum: validate.Bound(10)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_allowas_in schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyAllowasIn) Validate() error {
+	return bgpAddressFamilyAllowasInSchema.Validate(o)
+}
+
+var bgpAddressFamilyMaximumPrefixSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"num_prefixes": {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+		"threshold":    {Minimum: validate.Bound(1), Maximum: validate.Bound(100)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_maximum_prefix schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyMaximumPrefix) Validate() error {
+	return bgpAddressFamilyMaximumPrefixSchema.Validate(o)
+}
+
+var bgpAddressFamilyMaximumPrefixActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_maximum_prefix_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyMaximumPrefixAction) Validate() error {
+	return bgpAddressFamilyMaximumPrefixActionSchema.Validate(o)
+}
+
+var bgpAddressFamilyMaximumPrefixActionRestartSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interval": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_maximum_prefix_action_restart schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyMaximumPrefixActionRestart) Validate() error {
+	return bgpAddressFamilyMaximumPrefixActionRestartSchema.Validate(o)
+}
+
+var bgpAddressFamilyNextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyNextHop) Validate() error {
+	return bgpAddressFamilyNextHopSchema.Validate(o)
+}
+
+var bgpAddressFamilyOrfSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"orf_prefix_list": {Enum: []string{"none", "both", "receive", "send"}},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_orf schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyOrf) Validate() error {
+	return bgpAddressFamilyOrfSchema.Validate(o)
+}
+
+var bgpAddressFamilyProfilesIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_profiles_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyProfilesIpv4) Validate() error {
+	return bgpAddressFamilyProfilesIpv4Schema.Validate(o)
+}
+
+var bgpAddressFamilyRemovePrivateASSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_remove_private_AS schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilyRemovePrivateAS) Validate() error {
+	return bgpAddressFamilyRemovePrivateASSchema.Validate(o)
+}
+
+var bgpAddressFamilySendCommunitySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_address_family_send_community schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpAddressFamilySendCommunity) Validate() error {
+	return bgpAddressFamilySendCommunitySchema.Validate(o)
+}
+
+var bgpFilterConditionalAdvertisementSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_filter_conditional_advertisement schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilterConditionalAdvertisement) Validate() error {
+	return bgpFilterConditionalAdvertisementSchema.Validate(o)
+}
+
+var bgpFilterConditionalAdvertisementExistSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_filter_conditional_advertisement_exist schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilterConditionalAdvertisementExist) Validate() error {
+	return bgpFilterConditionalAdvertisementExistSchema.Validate(o)
+}
+
+var bgpFilterConditionalAdvertisementNonExistSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_filter_conditional_advertisement_non_exist schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilterConditionalAdvertisementNonExist) Validate() error {
+	return bgpFilterConditionalAdvertisementNonExistSchema.Validate(o)
+}
+
+var bgpFilterFilterListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_filter_filter_list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilterFilterList) Validate() error {
+	return bgpFilterFilterListSchema.Validate(o)
+}
+
+var bgpFilterInboundNetworkFiltersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_filter_inbound_network_filters schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilterInboundNetworkFilters) Validate() error {
+	return bgpFilterInboundNetworkFiltersSchema.Validate(o)
+}
+
+var bgpFilteringProfilesIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_filtering_profiles_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilteringProfilesIpv4) Validate() error {
+	return bgpFilteringProfilesIpv4Schema.Validate(o)
+}
+
+var bgpFilteringProfilesIpv4MulticastSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_filtering_profiles_ipv4_multicast schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpFilteringProfilesIpv4Multicast) Validate() error {
+	return bgpFilteringProfilesIpv4MulticastSchema.Validate(o)
+}
+
+var bgpRedistributionProfilesIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_redistribution_profiles_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRedistributionProfilesIpv4) Validate() error {
+	return bgpRedistributionProfilesIpv4Schema.Validate(o)
+}
+
+var bgpRedistributionProfilesIpv4UnicastSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_redistribution_profiles_ipv4_unicast schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRedistributionProfilesIpv4Unicast) Validate() error {
+	return bgpRedistributionProfilesIpv4UnicastSchema.Validate(o)
+}
+
+var bgpRedistributionProfilesIpv4UnicastConnectedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_redistribution_profiles_ipv4_unicast_connected schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRedistributionProfilesIpv4UnicastConnected) Validate() error {
+	return bgpRedistributionProfilesIpv4UnicastConnectedSchema.Validate(o)
+}
+
+var bgpRedistributionProfilesIpv4UnicastOspfSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_redistribution_profiles_ipv4_unicast_ospf schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRedistributionProfilesIpv4UnicastOspf) Validate() error {
+	return bgpRedistributionProfilesIpv4UnicastOspfSchema.Validate(o)
+}
+
+var bgpRedistributionProfilesIpv4UnicastStaticSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_redistribution_profiles_ipv4_unicast_static schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRedistributionProfilesIpv4UnicastStatic) Validate() error {
+	return bgpRedistributionProfilesIpv4UnicastStaticSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgp) Validate() error {
+	return bgpRouteMapRedistributionsBgpSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspf) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInner) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"local_preference": {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+		"metric":           {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"peer":             {Enum: []string{"local", "none"}},
+		"tag":              {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner_match_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4AddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner_match_ipv4_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4Address) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4AddressSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4NextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner_match_ipv4_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4NextHop) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4NextHopSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4RouteSourceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner_match_ipv4_route_source schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4RouteSource) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerMatchIpv4RouteSourceSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric_type": {Enum: []string{"type-1", "type-2"}},
+		"tag":         {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInnerSet) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpOspfRouteMapInnerSetMetricSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"set", "add", "subtract"}},
+		"value":  {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_ospf_route_map_inner_set_metric schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpOspfRouteMapInnerSetMetric) Validate() error {
+	return bgpRouteMapRedistributionsBgpOspfRouteMapInnerSetMetricSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRib) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRibRouteMapInner) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"local_preference": {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+		"metric":           {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"peer":             {Enum: []string{"local", "none"}},
+		"tag":              {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRibRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib_route_map_inner_match_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4AddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib_route_map_inner_match_ipv4_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4Address) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4AddressSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4NextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib_route_map_inner_match_ipv4_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4NextHop) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4NextHopSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4RouteSourceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib_route_map_inner_match_ipv4_route_source schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4RouteSource) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibRouteMapInnerMatchIpv4RouteSourceSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsBgpRibRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_bgp_rib_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsBgpRibRouteMapInnerSet) Validate() error {
+	return bgpRouteMapRedistributionsBgpRibRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStatic) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgp) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInner) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_match_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4AddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_match_ipv4_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4Address) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4AddressSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4NextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_match_ipv4_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4NextHop) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerMatchIpv4NextHopSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"aspath_prepend":   {Items: &validate.Rule{Minimum: validate.Bound(1), Maximum: validate.Bound(65535)}},
+		"local_preference": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"origin":           {Enum: []string{"none", "egp", "igp", "incomplete"}},
+		"tag":              {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+		"weight":           {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSet) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetAggregatorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"as": {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_set_aggregator schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetAggregator) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetAggregatorSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_set_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetIpv4) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetMetricSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"set", "add", "substract"}},
+		"value":  {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_bgp_route_map_inner_set_metric schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetMetric) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticBgpRouteMapInnerSetMetricSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspf) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspfRouteMapInner) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf_route_map_inner_match_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4AddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf_route_map_inner_match_ipv4_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4Address) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4AddressSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4NextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf_route_map_inner_match_ipv4_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4NextHop) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerMatchIpv4NextHopSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric_type": {Enum: []string{"type-1", "type-2"}},
+		"tag":         {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSet) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSetMetricSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"set", "add", "substract"}},
+		"value":  {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_ospf_route_map_inner_set_metric schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSetMetric) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticOspfRouteMapInnerSetMetricSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticRibSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_rib schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticRib) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticRibSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_rib_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticRibRouteMapInner) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_rib_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_rib_route_map_inner_match_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4AddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_rib_route_map_inner_match_ipv4_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4Address) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4AddressSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4NextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_rib_route_map_inner_match_ipv4_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4NextHop) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerMatchIpv4NextHopSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_connected_static_rib_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerSet) Validate() error {
+	return bgpRouteMapRedistributionsConnectedStaticRibRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspf) Validate() error {
+	return bgpRouteMapRedistributionsOspfSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgp) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInner) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"tag":    {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner_match_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchAddress) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchAddressSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchNextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner_match_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchNextHop) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerMatchNextHopSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"aspath_prepend":   {Items: &validate.Rule{Minimum: validate.Bound(1), Maximum: validate.Bound(65535)}},
+		"local_preference": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"origin":           {Enum: []string{"none", "egp", "igp", "incomplete"}},
+		"tag":              {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+		"weight":           {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInnerSet) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetAggregatorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"as": {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner_set_aggregator schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInnerSetAggregator) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetAggregatorSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner_set_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInnerSetIpv4) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetMetricSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"set", "add", "substract"}},
+		"value":  {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_bgp_route_map_inner_set_metric schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfBgpRouteMapInnerSetMetric) Validate() error {
+	return bgpRouteMapRedistributionsOspfBgpRouteMapInnerSetMetricSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfRibSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_rib schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfRib) Validate() error {
+	return bgpRouteMapRedistributionsOspfRibSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfRibRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_rib_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfRibRouteMapInner) Validate() error {
+	return bgpRouteMapRedistributionsOspfRibRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfRibRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"tag":    {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_rib_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfRibRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapRedistributionsOspfRibRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfRibRouteMapInnerMatchAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_rib_route_map_inner_match_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfRibRouteMapInnerMatchAddress) Validate() error {
+	return bgpRouteMapRedistributionsOspfRibRouteMapInnerMatchAddressSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfRibRouteMapInnerMatchNextHopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_rib_route_map_inner_match_next_hop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfRibRouteMapInnerMatchNextHop) Validate() error {
+	return bgpRouteMapRedistributionsOspfRibRouteMapInnerMatchNextHopSchema.Validate(o)
+}
+
+var bgpRouteMapRedistributionsOspfRibRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_map_redistributions_ospf_rib_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapRedistributionsOspfRibRouteMapInnerSet) Validate() error {
+	return bgpRouteMapRedistributionsOspfRibRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"permit", "deny"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInner) Validate() error {
+	return bgpRouteMapsRouteMapInnerSchema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"local_preference": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"metric":           {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"peer":             {Enum: []string{"local", "none"}},
+		"tag":              {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInnerMatch) Validate() error {
+	return bgpRouteMapsRouteMapInnerMatchSchema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerMatchIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner_match_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInnerMatchIpv4) Validate() error {
+	return bgpRouteMapsRouteMapInnerMatchIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerMatchIpv4AddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner_match_ipv4_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInnerMatchIpv4Address) Validate() error {
+	return bgpRouteMapsRouteMapInnerMatchIpv4AddressSchema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerSetSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"local_preference":  {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"origin":            {Enum: []string{"none", "egp", "igp", "incomplete"}},
+		"regular_community": {Items: &validate.Rule{Enum: []string{"none", "blackhole", "no-peer", "graceful-shutdown", "accept-own", "local-as", "route-filter-v4", "route-filter-v6", "no-advertise", "no-export", "internet"}}},
+		"tag":               {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+		"weight":            {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner_set schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInnerSet) Validate() error {
+	return bgpRouteMapsRouteMapInnerSetSchema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerSetAggregatorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"as": {Minimum: validate.Bound(1), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner_set_aggregator schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInnerSetAggregator) Validate() error {
+	return bgpRouteMapsRouteMapInnerSetAggregatorSchema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerSetIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner_set_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInnerSetIpv4) Validate() error {
+	return bgpRouteMapsRouteMapInnerSetIpv4Schema.Validate(o)
+}
+
+var bgpRouteMapsRouteMapInnerSetMetricSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"set", "add", "substract"}},
+		"value":  {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the bgp_route_maps_route_map_inner_set_metric schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BgpRouteMapsRouteMapInnerSetMetric) Validate() error {
+	return bgpRouteMapsRouteMapInnerSetMetricSchema.Validate(o)
+}
+
+var configMatchListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the config-match-list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ConfigMatchList) Validate() error {
+	return configMatchListSchema.Validate(o)
+}
+
+var ddnsConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ddns_cert_profile":    {Required: true},
+		"ddns_hostname":        {Required: true, Pattern: `^[a-zA-Z0-9_\.\-]+$`, MaxLength: 255},
+		"ddns_update_interval": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+		"ddns_vendor":          {Required: true, MaxLength: 127},
+		"ddns_vendor_config":   {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the ddns-config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DdnsConfig) Validate() error {
+	return ddnsConfigSchema.Validate(o)
+}
+
+var dhcpInterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the dhcp-interfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfaces) Validate() error {
+	return dhcpInterfacesSchema.Validate(o)
+}
+
+var dhcpInterfacesRelaySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ip": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_relay schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesRelay) Validate() error {
+	return dhcpInterfacesRelaySchema.Validate(o)
+}
+
+var dhcpInterfacesRelayIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enabled": {Required: true},
+		"server":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_relay_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesRelayIp) Validate() error {
+	return dhcpInterfacesRelayIpSchema.Validate(o)
+}
+
+var dhcpInterfacesServerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"mode": {Enum: []string{"auto", "enabled", "disabled"}},
+	},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServer) Validate() error {
+	return dhcpInterfacesServerSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOption) Validate() error {
+	return dhcpInterfacesServerOptionSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionDnsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option_dns schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOptionDns) Validate() error {
+	return dhcpInterfacesServerOptionDnsSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionInheritanceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option_inheritance schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOptionInheritance) Validate() error {
+	return dhcpInterfacesServerOptionInheritanceSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionLeaseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"timeout": {Minimum: validate.Bound(0), Maximum: validate.Bound(1e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option_lease schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOptionLease) Validate() error {
+	return dhcpInterfacesServerOptionLeaseSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionNisSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option_nis schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOptionNis) Validate() error {
+	return dhcpInterfacesServerOptionNisSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionNtpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option_ntp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOptionNtp) Validate() error {
+	return dhcpInterfacesServerOptionNtpSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionUserDefinedInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"code":      {Minimum: validate.Bound(1), Maximum: validate.Bound(254)},
+		"inherited": {Required: true},
+		"name":      {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option_user_defined_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOptionUserDefinedInner) Validate() error {
+	return dhcpInterfacesServerOptionUserDefinedInnerSchema.Validate(o)
+}
+
+var dhcpInterfacesServerOptionWinsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_option_wins schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerOptionWins) Validate() error {
+	return dhcpInterfacesServerOptionWinsSchema.Validate(o)
+}
+
+var dhcpInterfacesServerReservedInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dhcp_interfaces_server_reserved_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DhcpInterfacesServerReservedInner) Validate() error {
+	return dhcpInterfacesServerReservedInnerSchema.Validate(o)
+}
+
+var dnsProxiesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default": {Required: true},
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 31},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the dns-proxies schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxies) Validate() error {
+	return dnsProxiesSchema.Validate(o)
+}
+
+var dnsProxiesCacheSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enabled": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dns_proxies_cache schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesCache) Validate() error {
+	return dnsProxiesCacheSchema.Validate(o)
+}
+
+var dnsProxiesCacheMaxTtlSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enabled":      {Required: true},
+		"time_to_live": {Minimum: validate.Bound(60), Maximum: validate.Bound(86400)},
+	},
+}
+
+// Validate checks o against the constraints of the dns_proxies_cache_max_ttl schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesCacheMaxTtl) Validate() error {
+	return dnsProxiesCacheMaxTtlSchema.Validate(o)
+}
+
+var dnsProxiesDefaultSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"primary": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dns_proxies_default schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesDefault) Validate() error {
+	return dnsProxiesDefaultSchema.Validate(o)
+}
+
+var dnsProxiesDefaultInheritanceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dns_proxies_default_inheritance schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesDefaultInheritance) Validate() error {
+	return dnsProxiesDefaultInheritanceSchema.Validate(o)
+}
+
+var dnsProxiesDomainServersInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"domain_name": {Items: &validate.Rule{MaxLength: 128}},
+		"name":        {Required: true},
+		"primary":     {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dns_proxies_domain_servers_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesDomainServersInner) Validate() error {
+	return dnsProxiesDomainServersInnerSchema.Validate(o)
+}
+
+var dnsProxiesStaticEntriesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"address": {Required: true, Items: &validate.Rule{MaxLength: 63}},
+		"domain":  {Required: true, MaxLength: 255},
+		"name":    {Required: true, MaxLength: 31},
+	},
+}
+
+// Validate checks o against the constraints of the dns_proxies_static_entries_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesStaticEntriesInner) Validate() error {
+	return dnsProxiesStaticEntriesInnerSchema.Validate(o)
+}
+
+var dnsProxiesTcpQueriesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enabled":              {Required: true},
+		"max_pending_requests": {Minimum: validate.Bound(64), Maximum: validate.Bound(256)},
+	},
+}
+
+// Validate checks o against the constraints of the dns_proxies_tcp_queries schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesTcpQueries) Validate() error {
+	return dnsProxiesTcpQueriesSchema.Validate(o)
+}
+
+var dnsProxiesUdpQueriesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dns_proxies_udp_queries schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesUdpQueries) Validate() error {
+	return dnsProxiesUdpQueriesSchema.Validate(o)
+}
+
+var dnsProxiesUdpQueriesRetriesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"attempts": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+		"interval": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+	},
+}
+
+// Validate checks o against the constraints of the dns_proxies_udp_queries_retries schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsProxiesUdpQueriesRetries) Validate() error {
+	return dnsProxiesUdpQueriesRetriesSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var ethernetInterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"comment":     {MaxLength: 1023},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"link_duplex": {Enum: []string{"auto", "half", "full"}},
+		"link_speed":  {Enum: []string{"auto", "10", "100", "1000", "10000", "40000", "100000"}},
+		"link_state":  {Enum: []string{"auto", "up", "down"}},
+		"name":        {Required: true},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet-interfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfaces) Validate() error {
+	return ethernetInterfacesSchema.Validate(o)
+}
+
+var ethernetInterfacesDhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ethernet-interfaces-dhcp-client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesDhcpClient) Validate() error {
+	return ethernetInterfacesDhcpClientSchema.Validate(o)
+}
+
+var ethernetInterfacesArpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_arp_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesArpInner) Validate() error {
+	return ethernetInterfacesArpInnerSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer2Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"vlan_tag": {Pattern: `^([1-9]\d{0,2}|[1-3]\d{3}|40[0-8]\d|409[0-6])$`},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer2 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer2) Validate() error {
+	return ethernetInterfacesLayer2Schema.Validate(o)
+}
+
+var ethernetInterfacesLayer2LldpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enable": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer2_lldp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer2Lldp) Validate() error {
+	return ethernetInterfacesLayer2LldpSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer3Schema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interface_management_profile": {MaxLength: 31},
+		"mtu":                          {Minimum: validate.Bound(576), Maximum: validate.Bound(9216)},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3) Validate() error {
+	return ethernetInterfacesLayer3Schema.Validate(o)
+}
+
+var ethernetInterfacesLayer3DdnsConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ddns_cert_profile":    {Required: true},
+		"ddns_hostname":        {Required: true, Pattern: `^[a-zA-Z0-9_\.\-]+$`, MaxLength: 255},
+		"ddns_update_interval": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+		"ddns_vendor":          {Required: true, MaxLength: 127},
+		"ddns_vendor_config":   {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3_ddns_config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3DdnsConfig) Validate() error {
+	return ethernetInterfacesLayer3DdnsConfigSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer3DhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_route_metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3_dhcp_client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3DhcpClient) Validate() error {
+	return ethernetInterfacesLayer3DhcpClientSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer3DhcpClientSendHostnameSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"hostname": {Pattern: `^[a-zA-Z0-9\._-]+$`, MinLength: 1, MaxLength: 64},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3_dhcp_client_send_hostname schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3DhcpClientSendHostname) Validate() error {
+	return ethernetInterfacesLayer3DhcpClientSendHostnameSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer3IpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3IpInner) Validate() error {
+	return ethernetInterfacesLayer3IpInnerSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer3PppoeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"access_concentrator":  {MinLength: 1, MaxLength: 255},
+		"authentication":       {Enum: []string{"CHAP", "PAP", "auto"}},
+		"default_route_metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"password":             {Required: true, MaxLength: 255},
+		"service":              {MinLength: 1, MaxLength: 255},
+		"username":             {Required: true, MinLength: 1, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3_pppoe schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3Pppoe) Validate() error {
+	return ethernetInterfacesLayer3PppoeSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer3PppoePassiveSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enable": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3_pppoe_passive schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3PppoePassive) Validate() error {
+	return ethernetInterfacesLayer3PppoePassiveSchema.Validate(o)
+}
+
+var ethernetInterfacesLayer3PppoeStaticAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ip": {Required: true, MaxLength: 63},
+	},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_layer3_pppoe_static_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesLayer3PppoeStaticAddress) Validate() error {
+	return ethernetInterfacesLayer3PppoeStaticAddressSchema.Validate(o)
+}
+
+var ethernetInterfacesTapSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ethernet_interfaces_tap schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o EthernetInterfacesTap) Validate() error {
+	return ethernetInterfacesTapSchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var getRemoteNetworksLicenseInfo500ResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the getRemoteNetworksLicenseInfo_500_response schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GetRemoteNetworksLicenseInfo500Response) Validate() error {
+	return getRemoteNetworksLicenseInfo500ResponseSchema.Validate(o)
+}
+
+var globalprotectMatchListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the globalprotect-match-list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GlobalprotectMatchList) Validate() error {
+	return globalprotectMatchListSchema.Validate(o)
+}
+
+var hipmatchMatchListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the hipmatch-match-list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipmatchMatchList) Validate() error {
+	return hipmatchMatchListSchema.Validate(o)
+}
+
+var ikeCryptoProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"authentication_multiple": {Maximum: validate.Bound(50)},
+		"device":                  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"dh_group":                {Required: true, Items: &validate.Rule{Enum: []string{"group1", "group2", "group5", "group14", "group19", "group20"}}},
+		"encryption":              {Required: true, Items: &validate.Rule{Enum: []string{"des", "3des", "aes-128-cbc", "aes-192-cbc", "aes-256-cbc", "aes-128-gcm", "aes-256-gcm"}}},
+		"folder":                  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"hash":                    {Required: true, Items: &validate.Rule{Enum: []string{"md5", "sha1", "sha256", "sha384", "sha512", "non-auth"}}},
+		"name":                    {Required: true, MaxLength: 31},
+		"snippet":                 {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ike-crypto-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeCryptoProfiles) Validate() error {
+	return ikeCryptoProfilesSchema.Validate(o)
+}
+
+var ikeGatewaysSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"authentication": {Required: true},
+		"device":         {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":         {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":           {Required: true, MaxLength: 63},
+		"peer_address":   {Required: true},
+		"protocol":       {Required: true},
+		"snippet":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ike-gateways schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGateways) Validate() error {
+	return ikeGatewaysSchema.Validate(o)
+}
+
+var ikeCryptoProfilesLifetimeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"days":    {Minimum: validate.Bound(1), Maximum: validate.Bound(365)},
+		"hours":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"minutes": {Minimum: validate.Bound(3), Maximum: validate.Bound(65535)},
+		"seconds": {Minimum: validate.Bound(180), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ike_crypto_profiles_lifetime schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeCryptoProfilesLifetime) Validate() error {
+	return ikeCryptoProfilesLifetimeSchema.Validate(o)
+}
+
+var ikeGatewaysAuthenticationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_authentication schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysAuthentication) Validate() error {
+	return ikeGatewaysAuthenticationSchema.Validate(o)
+}
+
+var ikeGatewaysAuthenticationCertificateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_authentication_certificate schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysAuthenticationCertificate) Validate() error {
+	return ikeGatewaysAuthenticationCertificateSchema.Validate(o)
+}
+
+var ikeGatewaysAuthenticationCertificateLocalCertificateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_authentication_certificate_local_certificate schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysAuthenticationCertificateLocalCertificate) Validate() error {
+	return ikeGatewaysAuthenticationCertificateLocalCertificateSchema.Validate(o)
+}
+
+var ikeGatewaysAuthenticationPreSharedKeySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_authentication_pre_shared_key schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysAuthenticationPreSharedKey) Validate() error {
+	return ikeGatewaysAuthenticationPreSharedKeySchema.Validate(o)
+}
+
+var ikeGatewaysLocalAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_local_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysLocalAddress) Validate() error {
+	return ikeGatewaysLocalAddressSchema.Validate(o)
+}
+
+var ikeGatewaysLocalIdSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"id": {Pattern: `^(.+\@[a-zA-Z0-9.-]+)$|^([$a-zA-Z0-9_:.-]+)$|^(([[:xdigit:]][[:xdigit:]])+)$|^([a-zA-Z0-9.]+=(\\,|[^,])+[, ]+)*([a-zA-Z0-9.]+=(\\,|[^,])+)$`, MinLength: 1, MaxLength: 1024},
+	},
+}
+
+// Validate checks o against the constraints of the ike_gateways_local_id schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysLocalId) Validate() error {
+	return ikeGatewaysLocalIdSchema.Validate(o)
+}
+
+var ikeGatewaysPeerAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"fqdn": {MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the ike_gateways_peer_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysPeerAddress) Validate() error {
+	return ikeGatewaysPeerAddressSchema.Validate(o)
+}
+
+var ikeGatewaysPeerIdSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"id":   {Pattern: `^(.+\@[\*a-zA-Z0-9.-]+)$|^([\*$a-zA-Z0-9_:.-]+)$|^(([[:xdigit:]][[:xdigit:]])+)$|^([a-zA-Z0-9.]+=(\\,|[^,])+[, ]+)*([a-zA-Z0-9.]+=(\\,|[^,])+)$`, MinLength: 1, MaxLength: 1024},
+		"type": {Enum: []string{"ipaddr", "keyid", "fqdn", "ufqdn"}},
+	},
+}
+
+// Validate checks o against the constraints of the ike_gateways_peer_id schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysPeerId) Validate() error {
+	return ikeGatewaysPeerIdSchema.Validate(o)
+}
+
+var ikeGatewaysProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"version": {Enum: []string{"ikev2-preferred", "ikev1", "ikev2"}},
+	},
+}
+
+// Validate checks o against the constraints of the ike_gateways_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysProtocol) Validate() error {
+	return ikeGatewaysProtocolSchema.Validate(o)
+}
+
+var ikeGatewaysProtocolCommonSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_protocol_common schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysProtocolCommon) Validate() error {
+	return ikeGatewaysProtocolCommonSchema.Validate(o)
+}
+
+var ikeGatewaysProtocolCommonFragmentationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_protocol_common_fragmentation schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysProtocolCommonFragmentation) Validate() error {
+	return ikeGatewaysProtocolCommonFragmentationSchema.Validate(o)
+}
+
+var ikeGatewaysProtocolCommonNatTraversalSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_protocol_common_nat_traversal schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysProtocolCommonNatTraversal) Validate() error {
+	return ikeGatewaysProtocolCommonNatTraversalSchema.Validate(o)
+}
+
+var ikeGatewaysProtocolIkev1Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_protocol_ikev1 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysProtocolIkev1) Validate() error {
+	return ikeGatewaysProtocolIkev1Schema.Validate(o)
+}
+
+var ikeGatewaysProtocolIkev1DpdSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ike_gateways_protocol_ikev1_dpd schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IkeGatewaysProtocolIkev1Dpd) Validate() error {
+	return ikeGatewaysProtocolIkev1DpdSchema.Validate(o)
+}
+
+var interfaceManagementProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the interface-management-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InterfaceManagementProfiles) Validate() error {
+	return interfaceManagementProfilesSchema.Validate(o)
+}
+
+var interfaceManagementProfilesPermittedIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the interface_management_profiles_permitted_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InterfaceManagementProfilesPermittedIpInner) Validate() error {
+	return interfaceManagementProfilesPermittedIpInnerSchema.Validate(o)
+}
+
+var ipsecCryptoProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"dh_group": {Enum: []string{"no-pfs", "group1", "group2", "group5", "group14", "group19", "group20"}},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"lifetime": {Required: true},
+		"name":     {Required: true, MaxLength: 31},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec-crypto-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecCryptoProfiles) Validate() error {
+	return ipsecCryptoProfilesSchema.Validate(o)
+}
+
+var ipsecTunnelsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"auto_key": {Required: true},
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":     {Required: true, MaxLength: 63},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec-tunnels schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnels) Validate() error {
+	return ipsecTunnelsSchema.Validate(o)
+}
+
+var ipsecCryptoProfilesAhSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"authentication": {Required: true, Items: &validate.Rule{Enum: []string{"md5", "sha1", "sha256", "sha384", "sha512"}}},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_crypto_profiles_ah schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecCryptoProfilesAh) Validate() error {
+	return ipsecCryptoProfilesAhSchema.Validate(o)
+}
+
+var ipsecCryptoProfilesEspSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"authentication": {Required: true},
+		"encryption":     {Required: true, Items: &validate.Rule{Enum: []string{"des", "3des", "aes-128-cbc", "aes-192-cbc", "aes-256-cbc", "aes-128-gcm", "aes-256-gcm", "null"}}},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_crypto_profiles_esp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecCryptoProfilesEsp) Validate() error {
+	return ipsecCryptoProfilesEspSchema.Validate(o)
+}
+
+var ipsecCryptoProfilesLifesizeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"gb": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"kb": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"mb": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"tb": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_crypto_profiles_lifesize schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecCryptoProfilesLifesize) Validate() error {
+	return ipsecCryptoProfilesLifesizeSchema.Validate(o)
+}
+
+var ipsecCryptoProfilesLifetimeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"days":    {Minimum: validate.Bound(1), Maximum: validate.Bound(365)},
+		"hours":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"minutes": {Minimum: validate.Bound(3), Maximum: validate.Bound(65535)},
+		"seconds": {Minimum: validate.Bound(180), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_crypto_profiles_lifetime schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecCryptoProfilesLifetime) Validate() error {
+	return ipsecCryptoProfilesLifetimeSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ike_gateway":          {Required: true},
+		"ipsec_crypto_profile": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKey) Validate() error {
+	return ipsecTunnelsAutoKeySchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyIkeGatewayInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_ike_gateway_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyIkeGatewayInner) Validate() error {
+	return ipsecTunnelsAutoKeyIkeGatewayInnerSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyProxyIdInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_proxy_id_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyProxyIdInner) Validate() error {
+	return ipsecTunnelsAutoKeyProxyIdInnerSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyProxyIdInnerProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"number": {Minimum: validate.Bound(1), Maximum: validate.Bound(254)},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_proxy_id_inner_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyProxyIdInnerProtocol) Validate() error {
+	return ipsecTunnelsAutoKeyProxyIdInnerProtocolSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyProxyIdInnerProtocolTcpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"local_port":  {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+		"remote_port": {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_proxy_id_inner_protocol_tcp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyProxyIdInnerProtocolTcp) Validate() error {
+	return ipsecTunnelsAutoKeyProxyIdInnerProtocolTcpSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyProxyIdInnerProtocolUdpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"local_port":  {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+		"remote_port": {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_proxy_id_inner_protocol_udp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyProxyIdInnerProtocolUdp) Validate() error {
+	return ipsecTunnelsAutoKeyProxyIdInnerProtocolUdpSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyProxyIdV6InnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_proxy_id_v6_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyProxyIdV6Inner) Validate() error {
+	return ipsecTunnelsAutoKeyProxyIdV6InnerSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyProxyIdV6InnerProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"number": {Minimum: validate.Bound(1), Maximum: validate.Bound(254)},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_proxy_id_v6_inner_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyProxyIdV6InnerProtocol) Validate() error {
+	return ipsecTunnelsAutoKeyProxyIdV6InnerProtocolSchema.Validate(o)
+}
+
+var ipsecTunnelsAutoKeyProxyIdV6InnerProtocolTcpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"local_port":  {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+		"remote_port": {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_auto_key_proxy_id_v6_inner_protocol_tcp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsAutoKeyProxyIdV6InnerProtocolTcp) Validate() error {
+	return ipsecTunnelsAutoKeyProxyIdV6InnerProtocolTcpSchema.Validate(o)
+}
+
+var ipsecTunnelsTunnelMonitorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"destination_ip": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ipsec_tunnels_tunnel_monitor schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IpsecTunnelsTunnelMonitor) Validate() error {
+	return ipsecTunnelsTunnelMonitorSchema.Validate(o)
+}
+
+var iptagMatchListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the iptag-match-list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o IptagMatchList) Validate() error {
+	return iptagMatchListSchema.Validate(o)
+}
+
+var lacpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"max_ports":         {Minimum: validate.Bound(1), Maximum: validate.Bound(8)},
+		"mode":              {Enum: []string{"passive", "active"}},
+		"system_priority":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"transmission_rate": {Enum: []string{"fast", "slow"}},
+	},
+}
+
+// Validate checks o against the constraints of the lacp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Lacp) Validate() error {
+	return lacpSchema.Validate(o)
+}
+
+var layer2SubinterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":     {Required: true},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"vlan_tag": {Required: true, Pattern: `^([1-9]\d{0,2}|[1-3]\d{3}|40[0-8]\d|409[0-6])$`},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the layer2-subinterfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer2Subinterfaces) Validate() error {
+	return layer2SubinterfacesSchema.Validate(o)
+}
+
+var layer3SubInterfacesDhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the layer3-sub-interfaces-dhcp-client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3SubInterfacesDhcpClient) Validate() error {
+	return layer3SubInterfacesDhcpClientSchema.Validate(o)
+}
+
+var layer3SubinterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"mtu":     {Minimum: validate.Bound(576), Maximum: validate.Bound(9216)},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"tag":     {Minimum: validate.Bound(1), Maximum: validate.Bound(4096)},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the layer3-subinterfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3Subinterfaces) Validate() error {
+	return layer3SubinterfacesSchema.Validate(o)
+}
+
+var layer3SubInterfacesDhcpClientDhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_route_metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the layer3_sub_interfaces_dhcp_client_dhcp_client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3SubInterfacesDhcpClientDhcpClient) Validate() error {
+	return layer3SubInterfacesDhcpClientDhcpClientSchema.Validate(o)
+}
+
+var layer3SubInterfacesDhcpClientDhcpClientSendHostnameSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"hostname": {Pattern: `^[a-zA-Z0-9\._-]+$`, MinLength: 1, MaxLength: 64},
+	},
+}
+
+// Validate checks o against the constraints of the layer3_sub_interfaces_dhcp_client_dhcp_client_send_hostname schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3SubInterfacesDhcpClientDhcpClientSendHostname) Validate() error {
+	return layer3SubInterfacesDhcpClientDhcpClientSendHostnameSchema.Validate(o)
+}
+
+var layer3SubinterfacesArpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the layer3_subinterfaces_arp_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3SubinterfacesArpInner) Validate() error {
+	return layer3SubinterfacesArpInnerSchema.Validate(o)
+}
+
+var layer3SubinterfacesDdnsConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ddns_cert_profile":    {Required: true},
+		"ddns_hostname":        {Required: true, Pattern: `^[a-zA-Z0-9_\.\-]+$`, MaxLength: 255},
+		"ddns_update_interval": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+		"ddns_vendor":          {Required: true, MaxLength: 127},
+		"ddns_vendor_config":   {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the layer3_subinterfaces_ddns_config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3SubinterfacesDdnsConfig) Validate() error {
+	return layer3SubinterfacesDdnsConfigSchema.Validate(o)
+}
+
+var layer3SubinterfacesIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the layer3_subinterfaces_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Layer3SubinterfacesIpInner) Validate() error {
+	return layer3SubinterfacesIpInnerSchema.Validate(o)
+}
+
+var linkTagsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"color":    {Enum: []string{"Red", "Green", "Blue", "Yellow", "Copper", "Orange", "Purple", "Gray", "Light Green", "Cyan", "Light Gray", "Blue Gray", "Lime", "Black", "Gold", "Brown", "Olive", "Maroon", "Red-Orange", "Yellow-Orange", "Forest Green", "Turquoise Blue", "Azure Blue", "Cerulean Blue", "Midnight Blue", "Medium Blue", "Cobalt Blue", "Violet Blue", "Blue Violet", "Medium Violet", "Medium Rose", "Lavender", "Orchid", "Thistle", "Peach", "Salmon", "Magenta", "Red Violet", "Mahogany", "Burnt Sienna", "Chestnut"}},
+		"comments": {MaxLength: 1024},
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":     {Required: true, MaxLength: 63},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the link-tags schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LinkTags) Validate() error {
+	return linkTagsSchema.Validate(o)
+}
+
+var lldpProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the lldp-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LldpProfiles) Validate() error {
+	return lldpProfilesSchema.Validate(o)
+}
+
+var lldpProfilesOptionTlvsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the lldp_profiles_option_tlvs schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LldpProfilesOptionTlvs) Validate() error {
+	return lldpProfilesOptionTlvsSchema.Validate(o)
+}
+
+var lldpProfilesOptionTlvsManagementAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the lldp_profiles_option_tlvs_management_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LldpProfilesOptionTlvsManagementAddress) Validate() error {
+	return lldpProfilesOptionTlvsManagementAddressSchema.Validate(o)
+}
+
+var lldpProfilesOptionTlvsManagementAddressIplistInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the lldp_profiles_option_tlvs_management_address_iplist_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LldpProfilesOptionTlvsManagementAddressIplistInner) Validate() error {
+	return lldpProfilesOptionTlvsManagementAddressIplistInnerSchema.Validate(o)
+}
+
+var logicalRoutersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":          {Required: true},
+		"routing_stack": {Enum: []string{"legacy", "advanced"}},
+		"snippet":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical-routers schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRouters) Validate() error {
+	return logicalRoutersSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInner) Validate() error {
+	return logicalRoutersVrfInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerAdminDistsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_admin_dists schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerAdminDists) Validate() error {
+	return logicalRoutersVrfInnerAdminDistsSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgp) Validate() error {
+	return logicalRoutersVrfInnerBgpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAdvertiseNetworkSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_advertise_network schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAdvertiseNetwork) Validate() error {
+	return logicalRoutersVrfInnerBgpAdvertiseNetworkSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAdvertiseNetworkIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_advertise_network_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAdvertiseNetworkIpv4) Validate() error {
+	return logicalRoutersVrfInnerBgpAdvertiseNetworkIpv4Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAdvertiseNetworkIpv4NetworkInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_advertise_network_ipv4_network_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAdvertiseNetworkIpv4NetworkInner) Validate() error {
+	return logicalRoutersVrfInnerBgpAdvertiseNetworkIpv4NetworkInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAdvertiseNetworkIpv6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_advertise_network_ipv6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAdvertiseNetworkIpv6) Validate() error {
+	return logicalRoutersVrfInnerBgpAdvertiseNetworkIpv6Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAdvertiseNetworkIpv6NetworkInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_advertise_network_ipv6_network_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAdvertiseNetworkIpv6NetworkInner) Validate() error {
+	return logicalRoutersVrfInnerBgpAdvertiseNetworkIpv6NetworkInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAggregateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_aggregate schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAggregate) Validate() error {
+	return logicalRoutersVrfInnerBgpAggregateSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAggregateRoutesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_aggregate_routes_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAggregateRoutesInner) Validate() error {
+	return logicalRoutersVrfInnerBgpAggregateRoutesInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAggregateRoutesInnerTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_aggregate_routes_inner_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAggregateRoutesInnerType) Validate() error {
+	return logicalRoutersVrfInnerBgpAggregateRoutesInnerTypeSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpAggregateRoutesInnerTypeIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_aggregate_routes_inner_type_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpAggregateRoutesInnerTypeIpv4) Validate() error {
+	return logicalRoutersVrfInnerBgpAggregateRoutesInnerTypeIpv4Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpGlobalBfdSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_global_bfd schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpGlobalBfd) Validate() error {
+	return logicalRoutersVrfInnerBgpGlobalBfdSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpGracefulRestartSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_graceful_restart schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpGracefulRestart) Validate() error {
+	return logicalRoutersVrfInnerBgpGracefulRestartSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpMedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_med schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpMed) Validate() error {
+	return logicalRoutersVrfInnerBgpMedSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerAddressFamilySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_address_family schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerAddressFamily) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerAddressFamilySchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerConnectionOptionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_connection_options schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerConnectionOptions) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerConnectionOptionsSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerBfdSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_bfd schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerBfd) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerBfdSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerBfdMultihopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_bfd_multihop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerBfdMultihop) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerBfdMultihopSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_connection_options schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptions) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsIncomingBgpConnectionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_connection_options_incoming_bgp_connection schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsIncomingBgpConnection) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsIncomingBgpConnectionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsOutgoingBgpConnectionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_connection_options_outgoing_bgp_connection schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsOutgoingBgpConnection) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerConnectionOptionsOutgoingBgpConnectionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerInheritSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_inherit schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerInherit) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerInheritSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerInheritNoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_inherit_no schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerInheritNo) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerInheritNoSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerLocalAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_local_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerLocalAddress) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerLocalAddressSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerPeerAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_peer_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerPeerAddress) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerPeerAddressSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerSubsequentAddressFamilyIdentifierSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_peer_inner_subsequent_address_family_identifier schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerSubsequentAddressFamilyIdentifier) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerPeerInnerSubsequentAddressFamilyIdentifierSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerType) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerTypeSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerTypeEbgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_type_ebgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerTypeEbgp) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerTypeEbgpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPeerGroupInnerTypeEbgpConfedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_peer_group_inner_type_ebgp_confed schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPeerGroupInnerTypeEbgpConfed) Validate() error {
+	return logicalRoutersVrfInnerBgpPeerGroupInnerTypeEbgpConfedSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicy) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicySchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregation) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner_advertise_filters_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"afi":         {Enum: []string{"ip", "ipv6"}},
+		"route_table": {Enum: []string{"unicast", "multicast", "both"}},
+		"safi":        {Enum: []string{"ip", "ipv6"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner_advertise_filters_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatch) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchAddressPrefixInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner_advertise_filters_inner_match_address_prefix_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchAddressPrefixInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchAddressPrefixInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchAsPathSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner_advertise_filters_inner_match_as_path schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchAsPath) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAdvertiseFiltersInnerMatchAsPathSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"origin": {Enum: []string{"igp", "egp", "incomplete"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner_aggregate_route_attributes schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributes) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesAsPathSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner_aggregate_route_attributes_as_path schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesAsPath) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesAsPathSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesCommunitySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_aggregation_address_inner_aggregate_route_attributes_community schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesCommunity) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyAggregationAddressInnerAggregateRouteAttributesCommunitySchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyConditionalAdvertisementSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_conditional_advertisement schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyConditionalAdvertisement) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyConditionalAdvertisementSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyConditionalAdvertisementPolicyInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_conditional_advertisement_policy_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyConditionalAdvertisementPolicyInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyConditionalAdvertisementPolicyInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyExportSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_export schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyExport) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyExportSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyExportRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_export_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyExportRulesInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyExportRulesInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyExportRulesInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_export_rules_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyExportRulesInnerAction) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyExportRulesInnerActionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyExportRulesInnerActionAllowSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_export_rules_inner_action_allow schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyExportRulesInnerActionAllow) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyExportRulesInnerActionAllowSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyExportRulesInnerActionAllowUpdateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"origin": {Enum: []string{"igp", "egp", "multicast"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_export_rules_inner_action_allow_update schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyExportRulesInnerActionAllowUpdate) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyExportRulesInnerActionAllowUpdateSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyExportRulesInnerMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"afi":         {Enum: []string{"ip", "ipv6"}},
+		"route_table": {Enum: []string{"unicast", "multicast", "both"}},
+		"safi":        {Enum: []string{"ip", "ipv6"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_export_rules_inner_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyExportRulesInnerMatch) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyExportRulesInnerMatchSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyExportRulesInnerMatchAddressPrefixInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_export_rules_inner_match_address_prefix_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyExportRulesInnerMatchAddressPrefixInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyExportRulesInnerMatchAddressPrefixInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyImportSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_import schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyImport) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyImportSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyImportRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_import_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyImportRulesInner) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyImportRulesInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyImportRulesInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_import_rules_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyImportRulesInnerAction) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyImportRulesInnerActionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpPolicyImportRulesInnerActionAllowSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_policy_import_rules_inner_action_allow schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpPolicyImportRulesInnerActionAllow) Validate() error {
+	return logicalRoutersVrfInnerBgpPolicyImportRulesInnerActionAllowSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpRedistRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"address_family_identifier": {Enum: []string{"ipv4", "ipv6"}},
+		"name":                      {Required: true},
+		"route_table":               {Enum: []string{"unicast", "multicast", "both"}},
+		"set_origin":                {Enum: []string{"igp", "egp", "incomplete"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_redist_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpRedistRulesInner) Validate() error {
+	return logicalRoutersVrfInnerBgpRedistRulesInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpRedistributionProfileSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_redistribution_profile schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpRedistributionProfile) Validate() error {
+	return logicalRoutersVrfInnerBgpRedistributionProfileSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerBgpRedistributionProfileIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_bgp_redistribution_profile_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerBgpRedistributionProfileIpv4) Validate() error {
+	return logicalRoutersVrfInnerBgpRedistributionProfileIpv4Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerEcmpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ecmp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerEcmp) Validate() error {
+	return logicalRoutersVrfInnerEcmpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerEcmpAlgorithmSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ecmp_algorithm schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerEcmpAlgorithm) Validate() error {
+	return logicalRoutersVrfInnerEcmpAlgorithmSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerEcmpAlgorithmIpHashSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ecmp_algorithm_ip_hash schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerEcmpAlgorithmIpHash) Validate() error {
+	return logicalRoutersVrfInnerEcmpAlgorithmIpHashSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerEcmpAlgorithmWeightedRoundRobinSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ecmp_algorithm_weighted_round_robin schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerEcmpAlgorithmWeightedRoundRobin) Validate() error {
+	return logicalRoutersVrfInnerEcmpAlgorithmWeightedRoundRobinSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerEcmpAlgorithmWeightedRoundRobinInterfaceInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ecmp_algorithm_weighted_round_robin_interface_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerEcmpAlgorithmWeightedRoundRobinInterfaceInner) Validate() error {
+	return logicalRoutersVrfInnerEcmpAlgorithmWeightedRoundRobinInterfaceInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"mode": {Enum: []string{"PIM-SM", "IGMP-Proxy"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticast) Validate() error {
+	return logicalRoutersVrfInnerMulticastSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastIgmpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_igmp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastIgmp) Validate() error {
+	return logicalRoutersVrfInnerMulticastIgmpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastIgmpDynamicSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_igmp_dynamic schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastIgmpDynamic) Validate() error {
+	return logicalRoutersVrfInnerMulticastIgmpDynamicSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastIgmpDynamicInterfaceInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":       {Required: true},
+		"robustness": {Enum: []string{"1", "2", "3", "4", "5", "6", "7"}},
+		"version":    {Enum: []string{"2", "3"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_igmp_dynamic_interface_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastIgmpDynamicInterfaceInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastIgmpDynamicInterfaceInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastIgmpStaticInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_igmp_static_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastIgmpStaticInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastIgmpStaticInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastInterfaceGroupInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_interface_group_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastInterfaceGroupInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastInterfaceGroupInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_interface_group_inner_group_permission schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermission) Validate() error {
+	return logicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionAnySourceMulticastInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_interface_group_inner_group_permission_any_source_multicast_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionAnySourceMulticastInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionAnySourceMulticastInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionSourceSpecificMulticastInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_interface_group_inner_group_permission_source_specific_multicast_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionSourceSpecificMulticastInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastInterfaceGroupInnerGroupPermissionSourceSpecificMulticastInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastInterfaceGroupInnerIgmpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"mode":       {Enum: []string{"router", "host"}},
+		"robustness": {Enum: []string{"1", "2", "3", "4", "5", "6", "7"}},
+		"version":    {Enum: []string{"1", "2", "3"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_interface_group_inner_igmp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastInterfaceGroupInnerIgmp) Validate() error {
+	return logicalRoutersVrfInnerMulticastInterfaceGroupInnerIgmpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastInterfaceGroupInnerPimSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_interface_group_inner_pim schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastInterfaceGroupInnerPim) Validate() error {
+	return logicalRoutersVrfInnerMulticastInterfaceGroupInnerPimSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastInterfaceGroupInnerPimAllowedNeighborsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_interface_group_inner_pim_allowed_neighbors_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastInterfaceGroupInnerPimAllowedNeighborsInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastInterfaceGroupInnerPimAllowedNeighborsInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastMsdpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_msdp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastMsdp) Validate() error {
+	return logicalRoutersVrfInnerMulticastMsdpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastMsdpPeerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_msdp_peer_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastMsdpPeerInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastMsdpPeerInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"rpf_lookup_mode": {Enum: []string{"mrib-then-urib", "mrib-only", "urib-only"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPim) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimInterfaceInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_interface_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimInterfaceInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimInterfaceInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimRpExternalRpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_rp_external_rp_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimRpExternalRpInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimRpExternalRpInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimRpLocalRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_rp_local_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimRpLocalRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimRpLocalRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimRpLocalRpCandidateRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_rp_local_rp_candidate_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimRpLocalRpCandidateRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimRpLocalRpCandidateRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimRpLocalRpStaticRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_rp_local_rp_static_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimRpLocalRpStaticRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimRpLocalRpStaticRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimSptThresholdInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_spt_threshold_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimSptThresholdInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimSptThresholdInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastPimSsmAddressSpaceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_pim_ssm_address_space schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastPimSsmAddressSpace) Validate() error {
+	return logicalRoutersVrfInnerMulticastPimSsmAddressSpaceSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastRpExternalRpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_rp_external_rp_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastRpExternalRpInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastRpExternalRpInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastRpLocalRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_rp_local_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastRpLocalRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastRpLocalRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastRpLocalRpCandidateRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_rp_local_rp_candidate_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastRpLocalRpCandidateRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastRpLocalRpCandidateRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastRpLocalRpStaticRpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_rp_local_rp_static_rp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastRpLocalRpStaticRp) Validate() error {
+	return logicalRoutersVrfInnerMulticastRpLocalRpStaticRpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastStaticRouteInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_static_route_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastStaticRouteInner) Validate() error {
+	return logicalRoutersVrfInnerMulticastStaticRouteInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerMulticastStaticRouteInnerNexthopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_multicast_static_route_inner_nexthop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerMulticastStaticRouteInnerNexthop) Validate() error {
+	return logicalRoutersVrfInnerMulticastStaticRouteInnerNexthopSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspf) Validate() error {
+	return logicalRoutersVrfInnerOspfSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_interface_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerInterfaceInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_interface_inner_link_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkType) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeP2mpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_interface_inner_link_type_p2mp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeP2mp) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeP2mpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeP2mpNeighborInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_interface_inner_link_type_p2mp_neighbor_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeP2mpNeighborInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerLinkTypeP2mpNeighborInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerVrTimingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_interface_inner_vr_timing schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerInterfaceInnerVrTiming) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerInterfaceInnerVrTimingSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerRangeInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_range_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerRangeInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerRangeInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerType) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNormalSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_normal schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNormal) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNormalSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNormalAbrSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_normal_abr schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNormalAbr) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNormalAbrSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNssaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_nssa schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNssa) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNssaSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNssaAbrSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_nssa_abr schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNssaAbr) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNssaAbrSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNssaAbrNssaExtRangeInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_nssa_abr_nssa_ext_range_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNssaAbrNssaExtRangeInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNssaAbrNssaExtRangeInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultInformationOriginateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"metric_type": {Enum: []string{"type-1", "type-2"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_nssa_default_information_originate schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultInformationOriginate) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultInformationOriginateSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultRouteSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_nssa_default_route schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultRoute) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultRouteSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultRouteAdvertiseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"type": {Enum: []string{"ext-1", "ext-2"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_nssa_default_route_advertise schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultRouteAdvertise) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNssaDefaultRouteAdvertiseSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeNssaNssaExtRangeInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_nssa_nssa_ext_range_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeNssaNssaExtRangeInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeNssaNssaExtRangeInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeStubSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_stub schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeStub) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeStubSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeStubDefaultRouteSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_stub_default_route schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeStubDefaultRoute) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeStubDefaultRouteSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerTypeStubDefaultRouteAdvertiseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_type_stub_default_route_advertise schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerTypeStubDefaultRouteAdvertise) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerTypeStubDefaultRouteAdvertiseSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerVirtualLinkInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_virtual_link_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerVirtualLinkInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerVirtualLinkInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAreaInnerVirtualLinkInnerVrTimingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_area_inner_virtual_link_inner_vr_timing schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAreaInnerVirtualLinkInnerVrTiming) Validate() error {
+	return logicalRoutersVrfInnerOspfAreaInnerVirtualLinkInnerVrTimingSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAuthProfileInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_auth_profile_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAuthProfileInner) Validate() error {
+	return logicalRoutersVrfInnerOspfAuthProfileInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfAuthProfileInnerMd5InnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_auth_profile_inner_md5_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfAuthProfileInnerMd5Inner) Validate() error {
+	return logicalRoutersVrfInnerOspfAuthProfileInnerMd5InnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfExportRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":          {Required: true},
+		"new_path_type": {Enum: []string{"ext-1", "ext-2"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_export_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfExportRulesInner) Validate() error {
+	return logicalRoutersVrfInnerOspfExportRulesInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfFloodPreventionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_flood_prevention schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfFloodPrevention) Validate() error {
+	return logicalRoutersVrfInnerOspfFloodPreventionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfFloodPreventionHelloSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_flood_prevention_hello schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfFloodPreventionHello) Validate() error {
+	return logicalRoutersVrfInnerOspfFloodPreventionHelloSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfGracefulRestartSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_graceful_restart schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfGracefulRestart) Validate() error {
+	return logicalRoutersVrfInnerOspfGracefulRestartSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfVrTimersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospf_vr_timers schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfVrTimers) Validate() error {
+	return logicalRoutersVrfInnerOspfVrTimersSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3) Validate() error {
+	return logicalRoutersVrfInnerOspfv3Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AreaInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_area_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AreaInner) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AreaInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AreaInnerInterfaceInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_area_inner_interface_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AreaInnerInterfaceInner) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AreaInnerInterfaceInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AreaInnerRangeInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_area_inner_range_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AreaInnerRangeInner) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AreaInnerRangeInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AreaInnerTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_area_inner_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AreaInnerType) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AreaInnerTypeSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AreaInnerTypeNssaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_area_inner_type_nssa schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AreaInnerTypeNssa) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AreaInnerTypeNssaSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AreaInnerTypeNssaAbrSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_area_inner_type_nssa_abr schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AreaInnerTypeNssaAbr) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AreaInnerTypeNssaAbrSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AreaInnerTypeNssaAbrNssaExtRangeInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_area_inner_type_nssa_abr_nssa_ext_range_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AreaInnerTypeNssaAbrNssaExtRangeInner) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AreaInnerTypeNssaAbrNssaExtRangeInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AuthProfileInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_auth_profile_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AuthProfileInner) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AuthProfileInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AuthProfileInnerAhSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_auth_profile_inner_ah schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AuthProfileInnerAh) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AuthProfileInnerAhSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AuthProfileInnerAhMd5Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_auth_profile_inner_ah_md5 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AuthProfileInnerAhMd5) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AuthProfileInnerAhMd5Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AuthProfileInnerEspSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_auth_profile_inner_esp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AuthProfileInnerEsp) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AuthProfileInnerEspSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AuthProfileInnerEspAuthenticationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_auth_profile_inner_esp_authentication schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AuthProfileInnerEspAuthentication) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AuthProfileInnerEspAuthenticationSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerOspfv3AuthProfileInnerEspEncryptionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"algorithm": {Enum: []string{"3des", "aes-128-cbc", "aes-192-cbc", "aes-256-cbc", "null"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_ospfv3_auth_profile_inner_esp_encryption schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerOspfv3AuthProfileInnerEspEncryption) Validate() error {
+	return logicalRoutersVrfInnerOspfv3AuthProfileInnerEspEncryptionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRibFilterSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rib_filter schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRibFilter) Validate() error {
+	return logicalRoutersVrfInnerRibFilterSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRibFilterIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rib_filter_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRibFilterIpv4) Validate() error {
+	return logicalRoutersVrfInnerRibFilterIpv4Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRibFilterIpv4BgpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rib_filter_ipv4_bgp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRibFilterIpv4Bgp) Validate() error {
+	return logicalRoutersVrfInnerRibFilterIpv4BgpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRibFilterIpv6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rib_filter_ipv6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRibFilterIpv6) Validate() error {
+	return logicalRoutersVrfInnerRibFilterIpv6Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRipSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRip) Validate() error {
+	return logicalRoutersVrfInnerRipSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRipGlobalInboundDistributeListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rip_global_inbound_distribute_list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRipGlobalInboundDistributeList) Validate() error {
+	return logicalRoutersVrfInnerRipGlobalInboundDistributeListSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRipInterfaceInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"mode":          {Enum: []string{"active", "passive", "send-only"}},
+		"name":          {Required: true},
+		"split_horizon": {Enum: []string{"split-horizon", "no-split-horizon", "no-split-horizon-with-poison-reverse"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rip_interface_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRipInterfaceInner) Validate() error {
+	return logicalRoutersVrfInnerRipInterfaceInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRipInterfaceInnerInterfaceInboundDistributeListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_rip_interface_inner_interface_inbound_distribute_list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRipInterfaceInnerInterfaceInboundDistributeList) Validate() error {
+	return logicalRoutersVrfInnerRipInterfaceInnerInterfaceInboundDistributeListSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTable) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIp) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ip_static_route_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpStaticRouteInner) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerNexthopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ip_static_route_inner_nexthop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpStaticRouteInnerNexthop) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerNexthopSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerPathMonitorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"failure_condition": {Enum: []string{"any", "all"}},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ip_static_route_inner_path_monitor schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpStaticRouteInnerPathMonitor) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerPathMonitorSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerPathMonitorMonitorDestinationsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ip_static_route_inner_path_monitor_monitor_destinations_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpStaticRouteInnerPathMonitorMonitorDestinationsInner) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerPathMonitorMonitorDestinationsInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerRouteTableSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ip_static_route_inner_route_table schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpStaticRouteInnerRouteTable) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpStaticRouteInnerRouteTableSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpv6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ipv6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpv6) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpv6Schema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ipv6_static_route_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpv6StaticRouteInner) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerNexthopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ipv6_static_route_inner_nexthop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerNexthop) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerNexthopSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerOptionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_routing_table_ipv6_static_route_inner_option schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerOption) Validate() error {
+	return logicalRoutersVrfInnerRoutingTableIpv6StaticRouteInnerOptionSchema.Validate(o)
+}
+
+var logicalRoutersVrfInnerVrAdminDistsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the logical_routers_vrf_inner_vr_admin_dists schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogicalRoutersVrfInnerVrAdminDists) Validate() error {
+	return logicalRoutersVrfInnerVrAdminDistsSchema.Validate(o)
+}
+
+var loopbackInterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_value": {Pattern: `^loopback\.([1-9][0-9]{0,3})$`},
+		"device":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"mtu":           {Minimum: validate.Bound(576), Maximum: validate.Bound(9216)},
+		"name":          {Required: true, Pattern: `^\$[a-zA-Z\d\-_\. ]+$`},
+		"snippet":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the loopback-interfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LoopbackInterfaces) Validate() error {
+	return loopbackInterfacesSchema.Validate(o)
+}
+
+var loopbackInterfacesIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the loopback_interfaces_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LoopbackInterfacesIpInner) Validate() error {
+	return loopbackInterfacesIpInnerSchema.Validate(o)
+}
+
+var loopbackInterfacesIpv6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the loopback_interfaces_ipv6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LoopbackInterfacesIpv6) Validate() error {
+	return loopbackInterfacesIpv6Schema.Validate(o)
+}
+
+var loopbackInterfacesIpv6AddressInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the loopback_interfaces_ipv6_address_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LoopbackInterfacesIpv6AddressInner) Validate() error {
+	return loopbackInterfacesIpv6AddressInnerSchema.Validate(o)
+}
+
+var natRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"active_active_device_binding": {Enum: []string{"primary", "both", "0", "1"}},
+		"destination":                  {Required: true},
+		"device":                       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":                       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"from":                         {Required: true},
+		"name":                         {Required: true},
+		"nat_type":                     {Enum: []string{"ipv4", "nat64", "nptv6"}},
+		"service":                      {Required: true},
+		"snippet":                      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"source":                       {Required: true},
+		"to":                           {Required: true},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the nat-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRules) Validate() error {
+	return natRulesSchema.Validate(o)
+}
+
+var natRulesDestinationTranslationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"translated_port": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the nat_rules_destination_translation schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesDestinationTranslation) Validate() error {
+	return natRulesDestinationTranslationSchema.Validate(o)
+}
+
+var natRulesDestinationTranslationDnsRewriteSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"direction": {Enum: []string{"reverse", "forward"}},
+	},
+}
+
+// Validate checks o against the constraints of the nat_rules_destination_translation_dns_rewrite schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesDestinationTranslationDnsRewrite) Validate() error {
+	return natRulesDestinationTranslationDnsRewriteSchema.Validate(o)
+}
+
+var natRulesDynamicDestinationTranslationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"distribution":    {Enum: []string{"round-robin", "source-ip-hash", "ip-modulo", "ip-hash", "least-sessions"}},
+		"translated_port": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the nat_rules_dynamic_destination_translation schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesDynamicDestinationTranslation) Validate() error {
+	return natRulesDynamicDestinationTranslationSchema.Validate(o)
+}
+
+var natRulesSourceTranslationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the nat_rules_source_translation schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesSourceTranslation) Validate() error {
+	return natRulesSourceTranslationSchema.Validate(o)
+}
+
+var natRulesSourceTranslationDynamicIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the nat_rules_source_translation_dynamic_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesSourceTranslationDynamicIp) Validate() error {
+	return natRulesSourceTranslationDynamicIpSchema.Validate(o)
+}
+
+var natRulesSourceTranslationDynamicIpAndPortSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the nat_rules_source_translation_dynamic_ip_and_port schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesSourceTranslationDynamicIpAndPort) Validate() error {
+	return natRulesSourceTranslationDynamicIpAndPortSchema.Validate(o)
+}
+
+var natRulesSourceTranslationDynamicIpAndPortInterfaceAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the nat_rules_source_translation_dynamic_ip_and_port_interface_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesSourceTranslationDynamicIpAndPortInterfaceAddress) Validate() error {
+	return natRulesSourceTranslationDynamicIpAndPortInterfaceAddressSchema.Validate(o)
+}
+
+var natRulesSourceTranslationDynamicIpFallbackSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the nat_rules_source_translation_dynamic_ip_fallback schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesSourceTranslationDynamicIpFallback) Validate() error {
+	return natRulesSourceTranslationDynamicIpFallbackSchema.Validate(o)
+}
+
+var natRulesSourceTranslationDynamicIpFallbackInterfaceAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the nat_rules_source_translation_dynamic_ip_fallback_interface_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesSourceTranslationDynamicIpFallbackInterfaceAddress) Validate() error {
+	return natRulesSourceTranslationDynamicIpFallbackInterfaceAddressSchema.Validate(o)
+}
+
+var natRulesSourceTranslationStaticIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the nat_rules_source_translation_static_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o NatRulesSourceTranslationStaticIp) Validate() error {
+	return natRulesSourceTranslationStaticIpSchema.Validate(o)
+}
+
+var ospfAuthProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ospf-auth-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o OspfAuthProfiles) Validate() error {
+	return ospfAuthProfilesSchema.Validate(o)
+}
+
+var ospfAuthProfilesMd5InnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"key":  {MaxLength: 256},
+		"name": {Minimum: validate.Bound(1), Maximum: validate.Bound(255)},
+	},
+}
+
+// Validate checks o against the constraints of the ospf_auth_profiles_md5_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o OspfAuthProfilesMd5Inner) Validate() error {
+	return ospfAuthProfilesMd5InnerSchema.Validate(o)
+}
+
+var pbfRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the pbf-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRules) Validate() error {
+	return pbfRulesSchema.Validate(o)
+}
+
+var pbfRulesActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the pbf_rules_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRulesAction) Validate() error {
+	return pbfRulesActionSchema.Validate(o)
+}
+
+var pbfRulesActionForwardSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the pbf_rules_action_forward schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRulesActionForward) Validate() error {
+	return pbfRulesActionForwardSchema.Validate(o)
+}
+
+var pbfRulesActionForwardMonitorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the pbf_rules_action_forward_monitor schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRulesActionForwardMonitor) Validate() error {
+	return pbfRulesActionForwardMonitorSchema.Validate(o)
+}
+
+var pbfRulesActionForwardNexthopSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the pbf_rules_action_forward_nexthop schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRulesActionForwardNexthop) Validate() error {
+	return pbfRulesActionForwardNexthopSchema.Validate(o)
+}
+
+var pbfRulesEnforceSymmetricReturnSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the pbf_rules_enforce_symmetric_return schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRulesEnforceSymmetricReturn) Validate() error {
+	return pbfRulesEnforceSymmetricReturnSchema.Validate(o)
+}
+
+var pbfRulesEnforceSymmetricReturnNexthopAddressListInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the pbf_rules_enforce_symmetric_return_nexthop_address_list_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRulesEnforceSymmetricReturnNexthopAddressListInner) Validate() error {
+	return pbfRulesEnforceSymmetricReturnNexthopAddressListInnerSchema.Validate(o)
+}
+
+var pbfRulesFromSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the pbf_rules_from schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PbfRulesFrom) Validate() error {
+	return pbfRulesFromSchema.Validate(o)
+}
+
+var poeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"poe_rsvd_pwr": {Minimum: validate.Bound(0), Maximum: validate.Bound(90)},
+	},
+}
+
+// Validate checks o against the constraints of the poe schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Poe) Validate() error {
+	return poeSchema.Validate(o)
+}
+
+var qosPolicyRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":  {Required: true},
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the qos-policy-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRules) Validate() error {
+	return qosPolicyRulesSchema.Validate(o)
+}
+
+var qosProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 31},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the qos-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfiles) Validate() error {
+	return qosProfilesSchema.Validate(o)
+}
+
+var qosPolicyRulesActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_policy_rules_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRulesAction) Validate() error {
+	return qosPolicyRulesActionSchema.Validate(o)
+}
+
+var qosPolicyRulesDscpTosSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_policy_rules_dscp_tos schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRulesDscpTos) Validate() error {
+	return qosPolicyRulesDscpTosSchema.Validate(o)
+}
+
+var qosPolicyRulesDscpTosCodepointsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_policy_rules_dscp_tos_codepoints_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRulesDscpTosCodepointsInner) Validate() error {
+	return qosPolicyRulesDscpTosCodepointsInnerSchema.Validate(o)
+}
+
+var qosPolicyRulesDscpTosCodepointsInnerTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_policy_rules_dscp_tos_codepoints_inner_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRulesDscpTosCodepointsInnerType) Validate() error {
+	return qosPolicyRulesDscpTosCodepointsInnerTypeSchema.Validate(o)
+}
+
+var qosPolicyRulesDscpTosCodepointsInnerTypeAfSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_policy_rules_dscp_tos_codepoints_inner_type_af schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRulesDscpTosCodepointsInnerTypeAf) Validate() error {
+	return qosPolicyRulesDscpTosCodepointsInnerTypeAfSchema.Validate(o)
+}
+
+var qosPolicyRulesDscpTosCodepointsInnerTypeCustomSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_policy_rules_dscp_tos_codepoints_inner_type_custom schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRulesDscpTosCodepointsInnerTypeCustom) Validate() error {
+	return qosPolicyRulesDscpTosCodepointsInnerTypeCustomSchema.Validate(o)
+}
+
+var qosPolicyRulesDscpTosCodepointsInnerTypeCustomCodepointSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_policy_rules_dscp_tos_codepoints_inner_type_custom_codepoint schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosPolicyRulesDscpTosCodepointsInnerTypeCustomCodepoint) Validate() error {
+	return qosPolicyRulesDscpTosCodepointsInnerTypeCustomCodepointSchema.Validate(o)
+}
+
+var qosProfilesAggregateBandwidthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"egress_guaranteed": {Minimum: validate.Bound(0), Maximum: validate.Bound(16000)},
+		"egress_max":        {Minimum: validate.Bound(0), Maximum: validate.Bound(60000)},
+	},
+}
+
+// Validate checks o against the constraints of the qos_profiles_aggregate_bandwidth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesAggregateBandwidth) Validate() error {
+	return qosProfilesAggregateBandwidthSchema.Validate(o)
+}
+
+var qosProfilesClassBandwidthTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_profiles_class_bandwidth_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesClassBandwidthType) Validate() error {
+	return qosProfilesClassBandwidthTypeSchema.Validate(o)
+}
+
+var qosProfilesClassBandwidthTypeMbpsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_profiles_class_bandwidth_type_mbps schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesClassBandwidthTypeMbps) Validate() error {
+	return qosProfilesClassBandwidthTypeMbpsSchema.Validate(o)
+}
+
+var qosProfilesClassBandwidthTypeMbpsClassInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":     {Enum: []string{"class1", "class2", "class3", "class4", "class5", "class6", "class7", "class8"}},
+		"priority": {Enum: []string{"real-time", "high", "medium", "low"}},
+	},
+}
+
+// Validate checks o against the constraints of the qos_profiles_class_bandwidth_type_mbps_class_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesClassBandwidthTypeMbpsClassInner) Validate() error {
+	return qosProfilesClassBandwidthTypeMbpsClassInnerSchema.Validate(o)
+}
+
+var qosProfilesClassBandwidthTypeMbpsClassInnerClassBandwidthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"egress_guaranteed": {Minimum: validate.Bound(0), Maximum: validate.Bound(60000)},
+		"egress_max":        {Minimum: validate.Bound(0), Maximum: validate.Bound(60000)},
+	},
+}
+
+// Validate checks o against the constraints of the qos_profiles_class_bandwidth_type_mbps_class_inner_class_bandwidth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesClassBandwidthTypeMbpsClassInnerClassBandwidth) Validate() error {
+	return qosProfilesClassBandwidthTypeMbpsClassInnerClassBandwidthSchema.Validate(o)
+}
+
+var qosProfilesClassBandwidthTypePercentageSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the qos_profiles_class_bandwidth_type_percentage schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesClassBandwidthTypePercentage) Validate() error {
+	return qosProfilesClassBandwidthTypePercentageSchema.Validate(o)
+}
+
+var qosProfilesClassBandwidthTypePercentageClassInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":     {Enum: []string{"class1", "class2", "class3", "class4", "class5", "class6", "class7", "class8"}},
+		"priority": {Enum: []string{"real-time", "high", "medium", "low"}},
+	},
+}
+
+// Validate checks o against the constraints of the qos_profiles_class_bandwidth_type_percentage_class_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesClassBandwidthTypePercentageClassInner) Validate() error {
+	return qosProfilesClassBandwidthTypePercentageClassInnerSchema.Validate(o)
+}
+
+var qosProfilesClassBandwidthTypePercentageClassInnerClassBandwidthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"egress_guaranteed": {Minimum: validate.Bound(0), Maximum: validate.Bound(100)},
+		"egress_max":        {Minimum: validate.Bound(0), Maximum: validate.Bound(100)},
+	},
+}
+
+// Validate checks o against the constraints of the qos_profiles_class_bandwidth_type_percentage_class_inner_class_bandwidth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QosProfilesClassBandwidthTypePercentageClassInnerClassBandwidth) Validate() error {
+	return qosProfilesClassBandwidthTypePercentageClassInnerClassBandwidthSchema.Validate(o)
+}
+
+var routeAccessListsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the route-access-lists schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessLists) Validate() error {
+	return routeAccessListsSchema.Validate(o)
+}
+
+var routeCommunityListsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the route-community-lists schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityLists) Validate() error {
+	return routeCommunityListsSchema.Validate(o)
+}
+
+var routePathAccessListsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the route-path-access-lists schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePathAccessLists) Validate() error {
+	return routePathAccessListsSchema.Validate(o)
+}
+
+var routePrefixListsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the route-prefix-lists schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePrefixLists) Validate() error {
+	return routePrefixListsSchema.Validate(o)
+}
+
+var routeAccessListsTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_access_lists_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsType) Validate() error {
+	return routeAccessListsTypeSchema.Validate(o)
+}
+
+var routeAccessListsTypeIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_access_lists_type_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsTypeIpv4) Validate() error {
+	return routeAccessListsTypeIpv4Schema.Validate(o)
+}
+
+var routeAccessListsTypeIpv4Ipv4EntryInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"deny", "permit"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the route_access_lists_type_ipv4_ipv4_entry_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsTypeIpv4Ipv4EntryInner) Validate() error {
+	return routeAccessListsTypeIpv4Ipv4EntryInnerSchema.Validate(o)
+}
+
+var routeAccessListsTypeIpv4Ipv4EntryInnerDestinationAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_access_lists_type_ipv4_ipv4_entry_inner_destination_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsTypeIpv4Ipv4EntryInnerDestinationAddress) Validate() error {
+	return routeAccessListsTypeIpv4Ipv4EntryInnerDestinationAddressSchema.Validate(o)
+}
+
+var routeAccessListsTypeIpv4Ipv4EntryInnerDestinationAddressEntrySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_access_lists_type_ipv4_ipv4_entry_inner_destination_address_entry schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsTypeIpv4Ipv4EntryInnerDestinationAddressEntry) Validate() error {
+	return routeAccessListsTypeIpv4Ipv4EntryInnerDestinationAddressEntrySchema.Validate(o)
+}
+
+var routeAccessListsTypeIpv4Ipv4EntryInnerSourceAddressSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_access_lists_type_ipv4_ipv4_entry_inner_source_address schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsTypeIpv4Ipv4EntryInnerSourceAddress) Validate() error {
+	return routeAccessListsTypeIpv4Ipv4EntryInnerSourceAddressSchema.Validate(o)
+}
+
+var routeAccessListsTypeIpv4Ipv4EntryInnerSourceAddressEntrySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_access_lists_type_ipv4_ipv4_entry_inner_source_address_entry schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteAccessListsTypeIpv4Ipv4EntryInnerSourceAddressEntry) Validate() error {
+	return routeAccessListsTypeIpv4Ipv4EntryInnerSourceAddressEntrySchema.Validate(o)
+}
+
+var routeCommunityListsTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_community_lists_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsType) Validate() error {
+	return routeCommunityListsTypeSchema.Validate(o)
+}
+
+var routeCommunityListsTypeExtendedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_community_lists_type_extended schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsTypeExtended) Validate() error {
+	return routeCommunityListsTypeExtendedSchema.Validate(o)
+}
+
+var routeCommunityListsTypeExtendedExtendedEntryInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":   {Enum: []string{"deny", "permit"}},
+		"lc_regex": {MaxItems: 8},
+		"name":     {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the route_community_lists_type_extended_extended_entry_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsTypeExtendedExtendedEntryInner) Validate() error {
+	return routeCommunityListsTypeExtendedExtendedEntryInnerSchema.Validate(o)
+}
+
+var routeCommunityListsTypeLargeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_community_lists_type_large schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsTypeLarge) Validate() error {
+	return routeCommunityListsTypeLargeSchema.Validate(o)
+}
+
+var routeCommunityListsTypeLargeLargeEntryInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":   {Enum: []string{"deny", "permit"}},
+		"lc_regex": {MaxItems: 8},
+		"name":     {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the route_community_lists_type_large_large_entry_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsTypeLargeLargeEntryInner) Validate() error {
+	return routeCommunityListsTypeLargeLargeEntryInnerSchema.Validate(o)
+}
+
+var routeCommunityListsTypeRegularSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_community_lists_type_regular schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsTypeRegular) Validate() error {
+	return routeCommunityListsTypeRegularSchema.Validate(o)
+}
+
+var routeCommunityListsTypeRegularRegularEntryInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"deny", "permit"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the route_community_lists_type_regular_regular_entry_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RouteCommunityListsTypeRegularRegularEntryInner) Validate() error {
+	return routeCommunityListsTypeRegularRegularEntryInnerSchema.Validate(o)
+}
+
+var routePathAccessListsAspathEntryInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"deny", "permit"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the route_path_access_lists_aspath_entry_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePathAccessListsAspathEntryInner) Validate() error {
+	return routePathAccessListsAspathEntryInnerSchema.Validate(o)
+}
+
+var routePrefixListsTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ipv4": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the route_prefix_lists_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePrefixListsType) Validate() error {
+	return routePrefixListsTypeSchema.Validate(o)
+}
+
+var routePrefixListsTypeIpv4Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the route_prefix_lists_type_ipv4 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePrefixListsTypeIpv4) Validate() error {
+	return routePrefixListsTypeIpv4Schema.Validate(o)
+}
+
+var routePrefixListsTypeIpv4Ipv4EntryInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"deny", "permit"}},
+		"name":   {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the route_prefix_lists_type_ipv4_ipv4_entry_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePrefixListsTypeIpv4Ipv4EntryInner) Validate() error {
+	return routePrefixListsTypeIpv4Ipv4EntryInnerSchema.Validate(o)
+}
+
+var routePrefixListsTypeIpv4Ipv4EntryInnerPrefixSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"network": {Enum: []string{"any"}},
+	},
+}
+
+// Validate checks o against the constraints of the route_prefix_lists_type_ipv4_ipv4_entry_inner_prefix schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePrefixListsTypeIpv4Ipv4EntryInnerPrefix) Validate() error {
+	return routePrefixListsTypeIpv4Ipv4EntryInnerPrefixSchema.Validate(o)
+}
+
+var routePrefixListsTypeIpv4Ipv4EntryInnerPrefixEntrySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"greater_than_or_equal": {Minimum: validate.Bound(0), Maximum: validate.Bound(32)},
+		"less_than_or_equal":    {Minimum: validate.Bound(0), Maximum: validate.Bound(32)},
+	},
+}
+
+// Validate checks o against the constraints of the route_prefix_lists_type_ipv4_ipv4_entry_inner_prefix_entry schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RoutePrefixListsTypeIpv4Ipv4EntryInnerPrefixEntry) Validate() error {
+	return routePrefixListsTypeIpv4Ipv4EntryInnerPrefixEntrySchema.Validate(o)
+}
+
+var ruleBasedMoveSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"destination": {Required: true, Enum: []string{"top", "bottom", "before", "after"}},
+		"rulebase":    {Required: true, Enum: []string{"pre", "post"}},
+	},
+}
+
+// Validate checks o against the constraints of the rule-based-move schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RuleBasedMove) Validate() error {
+	return ruleBasedMoveSchema.Validate(o)
+}
+
+var sdwanErrorCorrectionProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activation_threshold": {Required: true},
+		"device":               {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":               {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"mode":                 {Required: true},
+		"name":                 {Required: true},
+		"snippet":              {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan-error-correction-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanErrorCorrectionProfiles) Validate() error {
+	return sdwanErrorCorrectionProfilesSchema.Validate(o)
+}
+
+var sdwanPathQualityProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"metric":  {Required: true},
+		"name":    {Required: true, MaxLength: 31},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan-path-quality-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanPathQualityProfiles) Validate() error {
+	return sdwanPathQualityProfilesSchema.Validate(o)
+}
+
+var sdwanRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":               {Required: true},
+		"application":          {Required: true},
+		"destination":          {Required: true},
+		"device":               {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":               {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"from":                 {Required: true},
+		"name":                 {Required: true},
+		"path_quality_profile": {Required: true},
+		"position":             {Required: true, Enum: []string{"pre", "post"}},
+		"service":              {Required: true},
+		"snippet":              {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"source":               {Required: true},
+		"source_user":          {Required: true},
+		"to":                   {Required: true},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanRules) Validate() error {
+	return sdwanRulesSchema.Validate(o)
+}
+
+var sdwanSaasQualityProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"monitor_mode": {Required: true},
+		"name":         {Required: true},
+		"snippet":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan-saas-quality-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanSaasQualityProfiles) Validate() error {
+	return sdwanSaasQualityProfilesSchema.Validate(o)
+}
+
+var sdwanTrafficDistributionProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":               {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":               {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":                 {Required: true, MaxLength: 31},
+		"snippet":              {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"traffic_distribution": {Enum: []string{"Best Available Path", "Top Down Priority", "Weighted Session Distribution"}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan-traffic-distribution-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanTrafficDistributionProfiles) Validate() error {
+	return sdwanTrafficDistributionProfilesSchema.Validate(o)
+}
+
+var sdwanErrorCorrectionProfilesModeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the sdwan_error_correction_profiles_mode schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanErrorCorrectionProfilesMode) Validate() error {
+	return sdwanErrorCorrectionProfilesModeSchema.Validate(o)
+}
+
+var sdwanErrorCorrectionProfilesModeForwardErrorCorrectionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ratio":             {Required: true},
+		"recovery_duration": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_error_correction_profiles_mode_forward_error_correction schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanErrorCorrectionProfilesModeForwardErrorCorrection) Validate() error {
+	return sdwanErrorCorrectionProfilesModeForwardErrorCorrectionSchema.Validate(o)
+}
+
+var sdwanErrorCorrectionProfilesModePacketDuplicationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"recovery_duration_pd": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_error_correction_profiles_mode_packet_duplication schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanErrorCorrectionProfilesModePacketDuplication) Validate() error {
+	return sdwanErrorCorrectionProfilesModePacketDuplicationSchema.Validate(o)
+}
+
+var sdwanPathQualityProfilesMetricSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"jitter":  {Required: true},
+		"latency": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_path_quality_profiles_metric schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanPathQualityProfilesMetric) Validate() error {
+	return sdwanPathQualityProfilesMetricSchema.Validate(o)
+}
+
+var sdwanPathQualityProfilesMetricJitterSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"sensitivity": {Required: true, Enum: []string{"low", "medium", "high"}},
+		"threshold":   {Required: true, Minimum: validate.Bound(10), Maximum: validate.Bound(2000)},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_path_quality_profiles_metric_jitter schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanPathQualityProfilesMetricJitter) Validate() error {
+	return sdwanPathQualityProfilesMetricJitterSchema.Validate(o)
+}
+
+var sdwanPathQualityProfilesMetricLatencySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"sensitivity": {Required: true, Enum: []string{"low", "medium", "high"}},
+		"threshold":   {Required: true, Minimum: validate.Bound(10), Maximum: validate.Bound(3000)},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_path_quality_profiles_metric_latency schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanPathQualityProfilesMetricLatency) Validate() error {
+	return sdwanPathQualityProfilesMetricLatencySchema.Validate(o)
+}
+
+var sdwanPathQualityProfilesMetricPktLossSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"sensitivity": {Required: true, Enum: []string{"low", "medium", "high"}},
+		"threshold":   {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(100)},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_path_quality_profiles_metric_pkt_loss schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanPathQualityProfilesMetricPktLoss) Validate() error {
+	return sdwanPathQualityProfilesMetricPktLossSchema.Validate(o)
+}
+
+var sdwanRulesActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"traffic_distribution_profile": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_rules_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanRulesAction) Validate() error {
+	return sdwanRulesActionSchema.Validate(o)
+}
+
+var sdwanSaasQualityProfilesMonitorModeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the sdwan_saas_quality_profiles_monitor_mode schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanSaasQualityProfilesMonitorMode) Validate() error {
+	return sdwanSaasQualityProfilesMonitorModeSchema.Validate(o)
+}
+
+var sdwanSaasQualityProfilesMonitorModeHttpHttpsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"monitored_url":  {Required: true},
+		"probe_interval": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(60)},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_saas_quality_profiles_monitor_mode_http_https schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanSaasQualityProfilesMonitorModeHttpHttps) Validate() error {
+	return sdwanSaasQualityProfilesMonitorModeHttpHttpsSchema.Validate(o)
+}
+
+var sdwanSaasQualityProfilesMonitorModeStaticIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the sdwan_saas_quality_profiles_monitor_mode_static_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanSaasQualityProfilesMonitorModeStaticIp) Validate() error {
+	return sdwanSaasQualityProfilesMonitorModeStaticIpSchema.Validate(o)
+}
+
+var sdwanSaasQualityProfilesMonitorModeStaticIpFqdnSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"fqdn_name":      {Required: true},
+		"probe_interval": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(60)},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_saas_quality_profiles_monitor_mode_static_ip_fqdn schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanSaasQualityProfilesMonitorModeStaticIpFqdn) Validate() error {
+	return sdwanSaasQualityProfilesMonitorModeStaticIpFqdnSchema.Validate(o)
+}
+
+var sdwanSaasQualityProfilesMonitorModeStaticIpIpAddressInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":           {Required: true},
+		"probe_interval": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(60)},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_saas_quality_profiles_monitor_mode_static_ip_ip_address_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanSaasQualityProfilesMonitorModeStaticIpIpAddressInner) Validate() error {
+	return sdwanSaasQualityProfilesMonitorModeStaticIpIpAddressInnerSchema.Validate(o)
+}
+
+var sdwanTrafficDistributionProfilesLinkTagsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":   {Required: true, MaxLength: 255},
+		"weight": {Minimum: validate.Bound(0), Maximum: validate.Bound(100)},
+	},
+}
+
+// Validate checks o against the constraints of the sdwan_traffic_distribution_profiles_link_tags_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SdwanTrafficDistributionProfilesLinkTagsInner) Validate() error {
+	return sdwanTrafficDistributionProfilesLinkTagsInnerSchema.Validate(o)
+}
+
+var systemMatchListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the system-match-list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SystemMatchList) Validate() error {
+	return systemMatchListSchema.Validate(o)
+}
+
+var tunnelInterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_value": {Pattern: `^tunnel\.([1-9][0-9]{0,3})$`},
+		"device":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"mtu":           {Minimum: validate.Bound(576), Maximum: validate.Bound(9216)},
+		"name":          {Required: true},
+		"snippet":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the tunnel-interfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TunnelInterfaces) Validate() error {
+	return tunnelInterfacesSchema.Validate(o)
+}
+
+var tunnelInterfacesIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the tunnel_interfaces_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TunnelInterfacesIpInner) Validate() error {
+	return tunnelInterfacesIpInnerSchema.Validate(o)
+}
+
+var tunnelInterfacesIpv6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the tunnel_interfaces_ipv6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TunnelInterfacesIpv6) Validate() error {
+	return tunnelInterfacesIpv6Schema.Validate(o)
+}
+
+var tunnelInterfacesIpv6AddressInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the tunnel_interfaces_ipv6_address_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TunnelInterfacesIpv6AddressInner) Validate() error {
+	return tunnelInterfacesIpv6AddressInnerSchema.Validate(o)
+}
+
+var useridMatchListSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the userid-match-list schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UseridMatchList) Validate() error {
+	return useridMatchListSchema.Validate(o)
+}
+
+var vlanInterfacesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_value": {Pattern: `^vlan\.([1-9]\d{0,2}|[1-3]\d{3}|40[0-8]\d|409[0-6])$`},
+		"device":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"mtu":           {Minimum: validate.Bound(576), Maximum: validate.Bound(9216)},
+		"name":          {Required: true},
+		"snippet":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"vlan_tag":      {Pattern: `^([1-9]\d{0,2}|[1-3]\d{3}|40[0-8]\d|409[0-6])$`},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the vlan-interfaces schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VlanInterfaces) Validate() error {
+	return vlanInterfacesSchema.Validate(o)
+}
+
+var vlanInterfacesArpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vlan_interfaces_arp_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VlanInterfacesArpInner) Validate() error {
+	return vlanInterfacesArpInnerSchema.Validate(o)
+}
+
+var vlanInterfacesDdnsConfigSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ddns_cert_profile":    {Required: true},
+		"ddns_hostname":        {Required: true, Pattern: `^[a-zA-Z0-9_\.\-]+$`, MaxLength: 255},
+		"ddns_update_interval": {Minimum: validate.Bound(1), Maximum: validate.Bound(30)},
+		"ddns_vendor":          {Required: true, MaxLength: 127},
+		"ddns_vendor_config":   {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the vlan_interfaces_ddns_config schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VlanInterfacesDdnsConfig) Validate() error {
+	return vlanInterfacesDdnsConfigSchema.Validate(o)
+}
+
+var vlanInterfacesDhcpClientSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_route_metric": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the vlan_interfaces_dhcp_client schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VlanInterfacesDhcpClient) Validate() error {
+	return vlanInterfacesDhcpClientSchema.Validate(o)
+}
+
+var vlanInterfacesDhcpClientSendHostnameSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"hostname": {Pattern: `^[a-zA-Z0-9\._-]+$`, MinLength: 1, MaxLength: 64},
+	},
+}
+
+// Validate checks o against the constraints of the vlan_interfaces_dhcp_client_send_hostname schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VlanInterfacesDhcpClientSendHostname) Validate() error {
+	return vlanInterfacesDhcpClientSendHostnameSchema.Validate(o)
+}
+
+var vlanInterfacesIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the vlan_interfaces_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VlanInterfacesIpInner) Validate() error {
+	return vlanInterfacesIpInnerSchema.Validate(o)
+}
+
+var zoneProtectionProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"asymmetric_path":    {Enum: []string{"global", "drop", "bypass"}},
+		"description":        {MaxLength: 255},
+		"device":             {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":             {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"mptcp_option_strip": {Enum: []string{"no", "yes", "global"}},
+		"name":               {Required: true, MaxLength: 31},
+		"reject_non_syn_tcp": {Enum: []string{"global", "yes", "no"}},
+		"snippet":            {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the zone-protection-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfiles) Validate() error {
+	return zoneProtectionProfilesSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFlood) Validate() error {
+	return zoneProtectionProfilesFloodSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodIcmpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_icmp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodIcmp) Validate() error {
+	return zoneProtectionProfilesFloodIcmpSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodIcmpRedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate_rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm_rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal_rate":  {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_icmp_red schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodIcmpRed) Validate() error {
+	return zoneProtectionProfilesFloodIcmpRedSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodIcmpv6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_icmpv6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodIcmpv6) Validate() error {
+	return zoneProtectionProfilesFloodIcmpv6Schema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodIcmpv6RedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate_rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm_rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal_rate":  {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_icmpv6_red schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodIcmpv6Red) Validate() error {
+	return zoneProtectionProfilesFloodIcmpv6RedSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodOtherIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_other_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodOtherIp) Validate() error {
+	return zoneProtectionProfilesFloodOtherIpSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodOtherIpRedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate_rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm_rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal_rate":  {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_other_ip_red schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodOtherIpRed) Validate() error {
+	return zoneProtectionProfilesFloodOtherIpRedSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodSctpInitSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_sctp_init schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodSctpInit) Validate() error {
+	return zoneProtectionProfilesFloodSctpInitSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodSctpInitRedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate_rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm_rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal_rate":  {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_sctp_init_red schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodSctpInitRed) Validate() error {
+	return zoneProtectionProfilesFloodSctpInitRedSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodTcpSynSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_tcp_syn schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodTcpSyn) Validate() error {
+	return zoneProtectionProfilesFloodTcpSynSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodTcpSynRedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate_rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm_rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal_rate":  {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_tcp_syn_red schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodTcpSynRed) Validate() error {
+	return zoneProtectionProfilesFloodTcpSynRedSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodTcpSynSynCookiesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate_rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm_rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal_rate":  {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_tcp_syn_syn_cookies schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodTcpSynSynCookies) Validate() error {
+	return zoneProtectionProfilesFloodTcpSynSynCookiesSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodUdpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_udp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodUdp) Validate() error {
+	return zoneProtectionProfilesFloodUdpSchema.Validate(o)
+}
+
+var zoneProtectionProfilesFloodUdpRedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate_rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm_rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal_rate":  {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_flood_udp_red schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesFloodUdpRed) Validate() error {
+	return zoneProtectionProfilesFloodUdpRedSchema.Validate(o)
+}
+
+var zoneProtectionProfilesIpv6Schema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_ipv6 schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesIpv6) Validate() error {
+	return zoneProtectionProfilesIpv6Schema.Validate(o)
+}
+
+var zoneProtectionProfilesIpv6FilterExtHdrSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_ipv6_filter_ext_hdr schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesIpv6FilterExtHdr) Validate() error {
+	return zoneProtectionProfilesIpv6FilterExtHdrSchema.Validate(o)
+}
+
+var zoneProtectionProfilesIpv6IgnoreInvPktSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_ipv6_ignore_inv_pkt schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesIpv6IgnoreInvPkt) Validate() error {
+	return zoneProtectionProfilesIpv6IgnoreInvPktSchema.Validate(o)
+}
+
+var zoneProtectionProfilesL2SecGroupTagProtectionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_l2_sec_group_tag_protection schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesL2SecGroupTagProtection) Validate() error {
+	return zoneProtectionProfilesL2SecGroupTagProtectionSchema.Validate(o)
+}
+
+var zoneProtectionProfilesL2SecGroupTagProtectionTagsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+		"tag":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_l2_sec_group_tag_protection_tags_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesL2SecGroupTagProtectionTagsInner) Validate() error {
+	return zoneProtectionProfilesL2SecGroupTagProtectionTagsInnerSchema.Validate(o)
+}
+
+var zoneProtectionProfilesNonIpProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"list_type": {Enum: []string{"exclude", "include"}},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_non_ip_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesNonIpProtocol) Validate() error {
+	return zoneProtectionProfilesNonIpProtocolSchema.Validate(o)
+}
+
+var zoneProtectionProfilesNonIpProtocolProtocolInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ether_type": {Required: true},
+		"name":       {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_non_ip_protocol_protocol_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesNonIpProtocolProtocolInner) Validate() error {
+	return zoneProtectionProfilesNonIpProtocolProtocolInnerSchema.Validate(o)
+}
+
+var zoneProtectionProfilesScanInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interval":  {Minimum: validate.Bound(2), Maximum: validate.Bound(65535)},
+		"name":      {Required: true, Enum: []string{"8001", "8002", "8003", "8006"}},
+		"threshold": {Minimum: validate.Bound(2), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_scan_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesScanInner) Validate() error {
+	return zoneProtectionProfilesScanInnerSchema.Validate(o)
+}
+
+var zoneProtectionProfilesScanInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_scan_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesScanInnerAction) Validate() error {
+	return zoneProtectionProfilesScanInnerActionSchema.Validate(o)
+}
+
+var zoneProtectionProfilesScanInnerActionBlockIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"track_by": {Required: true, Enum: []string{"source-and-destination", "source"}},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_scan_inner_action_block_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesScanInnerActionBlockIp) Validate() error {
+	return zoneProtectionProfilesScanInnerActionBlockIpSchema.Validate(o)
+}
+
+var zoneProtectionProfilesScanWhiteListInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the zone_protection_profiles_scan_white_list_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZoneProtectionProfilesScanWhiteListInner) Validate() error {
+	return zoneProtectionProfilesScanWhiteListInnerSchema.Validate(o)
+}
+
+var zonesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the zones schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Zones) Validate() error {
+	return zonesSchema.Validate(o)
+}
+
+var zonesDeviceAclSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zones_device_acl schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZonesDeviceAcl) Validate() error {
+	return zonesDeviceAclSchema.Validate(o)
+}
+
+var zonesNetworkSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the zones_network schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ZonesNetwork) Validate() error {
+	return zonesNetworkSchema.Validate(o)
+}
@@ -0,0 +1,640 @@
+// Code generated by pagergen. DO NOT EDIT.
+
+package objects
+
+import (
+	"context"
+	"iter"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// All returns an iterator over every AddressGroups object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAddressGroups(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAddressGroupsRequest) All(opts ...api.PageOptions) iter.Seq2[AddressGroups, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AddressGroups, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAddressGroupsAll returns an iterator over every AddressGroups object.
+//
+// It is a shorthand for ListAddressGroups(ctx).All(opts...), see ApiListAddressGroupsRequest.All.
+func (a *AddressGroupsAPIService) ListAddressGroupsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AddressGroups, error] {
+	return a.ListAddressGroups(ctx).All(opts...)
+}
+
+// All returns an iterator over every Addresses object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAddresses(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAddressesRequest) All(opts ...api.PageOptions) iter.Seq2[Addresses, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Addresses, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAddressesAll returns an iterator over every Addresses object.
+//
+// It is a shorthand for ListAddresses(ctx).All(opts...), see ApiListAddressesRequest.All.
+func (a *AddressesAPIService) ListAddressesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Addresses, error] {
+	return a.ListAddresses(ctx).All(opts...)
+}
+
+// All returns an iterator over every ApplicationFilters object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListApplicationFilters(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListApplicationFiltersRequest) All(opts ...api.PageOptions) iter.Seq2[ApplicationFilters, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ApplicationFilters, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListApplicationFiltersAll returns an iterator over every ApplicationFilters object.
+//
+// It is a shorthand for ListApplicationFilters(ctx).All(opts...), see ApiListApplicationFiltersRequest.All.
+func (a *ApplicationFiltersAPIService) ListApplicationFiltersAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ApplicationFilters, error] {
+	return a.ListApplicationFilters(ctx).All(opts...)
+}
+
+// All returns an iterator over every ApplicationGroups object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListApplicationGroups(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListApplicationGroupsRequest) All(opts ...api.PageOptions) iter.Seq2[ApplicationGroups, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ApplicationGroups, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListApplicationGroupsAll returns an iterator over every ApplicationGroups object.
+//
+// It is a shorthand for ListApplicationGroups(ctx).All(opts...), see ApiListApplicationGroupsRequest.All.
+func (a *ApplicationGroupsAPIService) ListApplicationGroupsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ApplicationGroups, error] {
+	return a.ListApplicationGroups(ctx).All(opts...)
+}
+
+// All returns an iterator over every Applications object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListApplications(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListApplicationsRequest) All(opts ...api.PageOptions) iter.Seq2[Applications, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Applications, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListApplicationsAll returns an iterator over every Applications object.
+//
+// It is a shorthand for ListApplications(ctx).All(opts...), see ApiListApplicationsRequest.All.
+func (a *ApplicationsAPIService) ListApplicationsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Applications, error] {
+	return a.ListApplications(ctx).All(opts...)
+}
+
+// All returns an iterator over every AutoTagActions object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAutoTagActions(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAutoTagActionsRequest) All(opts ...api.PageOptions) iter.Seq2[AutoTagActions, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AutoTagActions, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAutoTagActionsAll returns an iterator over every AutoTagActions object.
+//
+// It is a shorthand for ListAutoTagActions(ctx).All(opts...), see ApiListAutoTagActionsRequest.All.
+func (a *AutoTagActionsAPIService) ListAutoTagActionsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AutoTagActions, error] {
+	return a.ListAutoTagActions(ctx).All(opts...)
+}
+
+// All returns an iterator over every DynamicUserGroups object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDynamicUserGroups(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDynamicUserGroupsRequest) All(opts ...api.PageOptions) iter.Seq2[DynamicUserGroups, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DynamicUserGroups, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDynamicUserGroupsAll returns an iterator over every DynamicUserGroups object.
+//
+// It is a shorthand for ListDynamicUserGroups(ctx).All(opts...), see ApiListDynamicUserGroupsRequest.All.
+func (a *DynamicUserGroupsAPIService) ListDynamicUserGroupsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DynamicUserGroups, error] {
+	return a.ListDynamicUserGroups(ctx).All(opts...)
+}
+
+// All returns an iterator over every ExternalDynamicLists object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListExternalDynamicLists(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListExternalDynamicListsRequest) All(opts ...api.PageOptions) iter.Seq2[ExternalDynamicLists, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ExternalDynamicLists, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListExternalDynamicListsAll returns an iterator over every ExternalDynamicLists object.
+//
+// It is a shorthand for ListExternalDynamicLists(ctx).All(opts...), see ApiListExternalDynamicListsRequest.All.
+func (a *ExternalDynamicListsAPIService) ListExternalDynamicListsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ExternalDynamicLists, error] {
+	return a.ListExternalDynamicLists(ctx).All(opts...)
+}
+
+// All returns an iterator over every HipObjects object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListHIPObjects(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListHIPObjectsRequest) All(opts ...api.PageOptions) iter.Seq2[HipObjects, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]HipObjects, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListHIPObjectsAll returns an iterator over every HipObjects object.
+//
+// It is a shorthand for ListHIPObjects(ctx).All(opts...), see ApiListHIPObjectsRequest.All.
+func (a *HIPObjectsAPIService) ListHIPObjectsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[HipObjects, error] {
+	return a.ListHIPObjects(ctx).All(opts...)
+}
+
+// All returns an iterator over every HipProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListHIPProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListHIPProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[HipProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]HipProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListHIPProfilesAll returns an iterator over every HipProfiles object.
+//
+// It is a shorthand for ListHIPProfiles(ctx).All(opts...), see ApiListHIPProfilesRequest.All.
+func (a *HIPProfilesAPIService) ListHIPProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[HipProfiles, error] {
+	return a.ListHIPProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every HttpServerProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListHTTPServerProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListHTTPServerProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[HttpServerProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]HttpServerProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListHTTPServerProfilesAll returns an iterator over every HttpServerProfiles object.
+//
+// It is a shorthand for ListHTTPServerProfiles(ctx).All(opts...), see ApiListHTTPServerProfilesRequest.All.
+func (a *HTTPServerProfilesAPIService) ListHTTPServerProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[HttpServerProfiles, error] {
+	return a.ListHTTPServerProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every LogForwardingProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListLogForwardingProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListLogForwardingProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[LogForwardingProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]LogForwardingProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListLogForwardingProfilesAll returns an iterator over every LogForwardingProfiles object.
+//
+// It is a shorthand for ListLogForwardingProfiles(ctx).All(opts...), see ApiListLogForwardingProfilesRequest.All.
+func (a *LogForwardingProfilesAPIService) ListLogForwardingProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[LogForwardingProfiles, error] {
+	return a.ListLogForwardingProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every Regions object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListRegions(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListRegionsRequest) All(opts ...api.PageOptions) iter.Seq2[Regions, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Regions, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListRegionsAll returns an iterator over every Regions object.
+//
+// It is a shorthand for ListRegions(ctx).All(opts...), see ApiListRegionsRequest.All.
+func (a *RegionsAPIService) ListRegionsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Regions, error] {
+	return a.ListRegions(ctx).All(opts...)
+}
+
+// All returns an iterator over every Schedules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSchedules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSchedulesRequest) All(opts ...api.PageOptions) iter.Seq2[Schedules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Schedules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSchedulesAll returns an iterator over every Schedules object.
+//
+// It is a shorthand for ListSchedules(ctx).All(opts...), see ApiListSchedulesRequest.All.
+func (a *SchedulesAPIService) ListSchedulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Schedules, error] {
+	return a.ListSchedules(ctx).All(opts...)
+}
+
+// All returns an iterator over every ServiceGroups object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListServiceGroups(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListServiceGroupsRequest) All(opts ...api.PageOptions) iter.Seq2[ServiceGroups, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ServiceGroups, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListServiceGroupsAll returns an iterator over every ServiceGroups object.
+//
+// It is a shorthand for ListServiceGroups(ctx).All(opts...), see ApiListServiceGroupsRequest.All.
+func (a *ServiceGroupsAPIService) ListServiceGroupsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ServiceGroups, error] {
+	return a.ListServiceGroups(ctx).All(opts...)
+}
+
+// All returns an iterator over every Services object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListServices(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListServicesRequest) All(opts ...api.PageOptions) iter.Seq2[Services, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Services, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListServicesAll returns an iterator over every Services object.
+//
+// It is a shorthand for ListServices(ctx).All(opts...), see ApiListServicesRequest.All.
+func (a *ServicesAPIService) ListServicesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Services, error] {
+	return a.ListServices(ctx).All(opts...)
+}
+
+// All returns an iterator over every SyslogServerProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListSyslogServerProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListSyslogServerProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[SyslogServerProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SyslogServerProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListSyslogServerProfilesAll returns an iterator over every SyslogServerProfiles object.
+//
+// It is a shorthand for ListSyslogServerProfiles(ctx).All(opts...), see ApiListSyslogServerProfilesRequest.All.
+func (a *SyslogServerProfilesAPIService) ListSyslogServerProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SyslogServerProfiles, error] {
+	return a.ListSyslogServerProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every Tags object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListTags(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListTagsRequest) All(opts ...api.PageOptions) iter.Seq2[Tags, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]Tags, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListTagsAll returns an iterator over every Tags object.
+//
+// It is a shorthand for ListTags(ctx).All(opts...), see ApiListTagsRequest.All.
+func (a *TagsAPIService) ListTagsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[Tags, error] {
+	return a.ListTags(ctx).All(opts...)
+}
@@ -0,0 +1,682 @@
+// Code generated by scopegen. DO NOT EDIT.
+
+package objects
+
+import (
+	"context"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AddressGroups) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AddressGroups) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Addresses) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Addresses) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ApplicationFilters) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ApplicationFilters) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ApplicationGroups) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ApplicationGroups) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Applications) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Applications) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *AutoTagActions) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *AutoTagActions) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *DynamicUserGroups) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *DynamicUserGroups) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ExternalDynamicLists) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ExternalDynamicLists) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *HipObjects) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *HipObjects) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *HipProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *HipProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *HttpServerProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *HttpServerProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *LogForwardingProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *LogForwardingProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Regions) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Regions) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Schedules) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Schedules) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *ServiceGroups) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *ServiceGroups) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Services) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Services) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *SyslogServerProfiles) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *SyslogServerProfiles) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// SetScope sets the folder, snippet or device of o to the one of s,
+// clearing the others.  It fails, leaving o unchanged, if s is the zero
+// Scope.
+func (o *Tags) SetScope(s api.Scope) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	o.Folder, o.Snippet, o.Device = s.Pointers()
+	return nil
+}
+
+// GetScope returns the scope of o.  It fails unless exactly one of
+// its folder, snippet and device is set.
+func (o *Tags) GetScope() (api.Scope, error) {
+	return api.NewScope(o.Folder, o.Snippet, o.Device)
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAddressGroupsRequest) Scope(s api.Scope) ApiListAddressGroupsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListAddressesRequest) Scope(s api.Scope) ApiListAddressesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListApplicationFiltersRequest) Scope(s api.Scope) ApiListApplicationFiltersRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListApplicationGroupsRequest) Scope(s api.Scope) ApiListApplicationGroupsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListApplicationsRequest) Scope(s api.Scope) ApiListApplicationsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListDynamicUserGroupsRequest) Scope(s api.Scope) ApiListDynamicUserGroupsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListExternalDynamicListsRequest) Scope(s api.Scope) ApiListExternalDynamicListsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListHIPObjectsRequest) Scope(s api.Scope) ApiListHIPObjectsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListHIPProfilesRequest) Scope(s api.Scope) ApiListHIPProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListHTTPServerProfilesRequest) Scope(s api.Scope) ApiListHTTPServerProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListLogForwardingProfilesRequest) Scope(s api.Scope) ApiListLogForwardingProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListRegionsRequest) Scope(s api.Scope) ApiListRegionsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSchedulesRequest) Scope(s api.Scope) ApiListSchedulesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListServiceGroupsRequest) Scope(s api.Scope) ApiListServiceGroupsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListServicesRequest) Scope(s api.Scope) ApiListServicesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListSyslogServerProfilesRequest) Scope(s api.Scope) ApiListSyslogServerProfilesRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// Scope sets the folder, snippet or device of the request to the one of
+// s, clearing the others.  If s is the zero Scope, Execute fails.
+func (r ApiListTagsRequest) Scope(s api.Scope) ApiListTagsRequest {
+	if err := s.Validate(); err != nil {
+		r.ctx = api.WithError(r.ctx, err)
+		return r
+	}
+	r.folder, r.snippet, r.device = s.Pointers()
+	return r
+}
+
+// FetchAddressGroupsInScope is FetchAddressGroups for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AddressGroupsAPIService) FetchAddressGroupsInScope(ctx context.Context, name string, s api.Scope) (*AddressGroups, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAddressGroups(ctx, name, folder, snippet, device)
+}
+
+// FetchAddressesInScope is FetchAddresses for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AddressesAPIService) FetchAddressesInScope(ctx context.Context, name string, s api.Scope) (*Addresses, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAddresses(ctx, name, folder, snippet, device)
+}
+
+// FetchApplicationFiltersInScope is FetchApplicationFilters for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ApplicationFiltersAPIService) FetchApplicationFiltersInScope(ctx context.Context, name string, s api.Scope) (*ApplicationFilters, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchApplicationFilters(ctx, name, folder, snippet, device)
+}
+
+// FetchApplicationGroupsInScope is FetchApplicationGroups for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ApplicationGroupsAPIService) FetchApplicationGroupsInScope(ctx context.Context, name string, s api.Scope) (*ApplicationGroups, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchApplicationGroups(ctx, name, folder, snippet, device)
+}
+
+// FetchApplicationsInScope is FetchApplications for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ApplicationsAPIService) FetchApplicationsInScope(ctx context.Context, name string, s api.Scope) (*Applications, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchApplications(ctx, name, folder, snippet, device)
+}
+
+// FetchAutoTagActionsInScope is FetchAutoTagActions for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *AutoTagActionsAPIService) FetchAutoTagActionsInScope(ctx context.Context, name string, s api.Scope) (*AutoTagActions, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchAutoTagActions(ctx, name, folder, snippet, device)
+}
+
+// FetchDynamicUserGroupsInScope is FetchDynamicUserGroups for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *DynamicUserGroupsAPIService) FetchDynamicUserGroupsInScope(ctx context.Context, name string, s api.Scope) (*DynamicUserGroups, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchDynamicUserGroups(ctx, name, folder, snippet, device)
+}
+
+// FetchExternalDynamicListsInScope is FetchExternalDynamicLists for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ExternalDynamicListsAPIService) FetchExternalDynamicListsInScope(ctx context.Context, name string, s api.Scope) (*ExternalDynamicLists, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchExternalDynamicLists(ctx, name, folder, snippet, device)
+}
+
+// FetchHIPObjectsInScope is FetchHIPObjects for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *HIPObjectsAPIService) FetchHIPObjectsInScope(ctx context.Context, name string, s api.Scope) (*HipObjects, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchHIPObjects(ctx, name, folder, snippet, device)
+}
+
+// FetchHIPProfilesInScope is FetchHIPProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *HIPProfilesAPIService) FetchHIPProfilesInScope(ctx context.Context, name string, s api.Scope) (*HipProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchHIPProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchHTTPServerProfilesInScope is FetchHTTPServerProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *HTTPServerProfilesAPIService) FetchHTTPServerProfilesInScope(ctx context.Context, name string, s api.Scope) (*HttpServerProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchHTTPServerProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchLogForwardingProfilesInScope is FetchLogForwardingProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *LogForwardingProfilesAPIService) FetchLogForwardingProfilesInScope(ctx context.Context, name string, s api.Scope) (*LogForwardingProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchLogForwardingProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchRegionsInScope is FetchRegions for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *RegionsAPIService) FetchRegionsInScope(ctx context.Context, name string, s api.Scope) (*Regions, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchRegions(ctx, name, folder, snippet, device)
+}
+
+// FetchSchedulesInScope is FetchSchedules for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SchedulesAPIService) FetchSchedulesInScope(ctx context.Context, name string, s api.Scope) (*Schedules, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSchedules(ctx, name, folder, snippet, device)
+}
+
+// FetchServiceGroupsInScope is FetchServiceGroups for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ServiceGroupsAPIService) FetchServiceGroupsInScope(ctx context.Context, name string, s api.Scope) (*ServiceGroups, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchServiceGroups(ctx, name, folder, snippet, device)
+}
+
+// FetchServicesInScope is FetchServices for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *ServicesAPIService) FetchServicesInScope(ctx context.Context, name string, s api.Scope) (*Services, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchServices(ctx, name, folder, snippet, device)
+}
+
+// FetchSyslogServerProfilesInScope is FetchSyslogServerProfiles for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *SyslogServerProfilesAPIService) FetchSyslogServerProfilesInScope(ctx context.Context, name string, s api.Scope) (*SyslogServerProfiles, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchSyslogServerProfiles(ctx, name, folder, snippet, device)
+}
+
+// FetchTagsInScope is FetchTags for the object named name in scope s.
+// It fails if s is the zero Scope.
+func (a *TagsAPIService) FetchTagsInScope(ctx context.Context, name string, s api.Scope) (*Tags, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	folder, snippet, device := s.Pointers()
+	return a.FetchTags(ctx, name, folder, snippet, device)
+}
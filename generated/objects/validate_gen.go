@@ -0,0 +1,2125 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package objects
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var addressGroupsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AddressGroupsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AddressGroupsListResponse) Validate() error {
+	return addressGroupsListResponseSchema.Validate(o)
+}
+
+var addressesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AddressesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AddressesListResponse) Validate() error {
+	return addressesListResponseSchema.Validate(o)
+}
+
+var applicationFiltersListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ApplicationFiltersListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationFiltersListResponse) Validate() error {
+	return applicationFiltersListResponseSchema.Validate(o)
+}
+
+var applicationGroupsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ApplicationGroupsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationGroupsListResponse) Validate() error {
+	return applicationGroupsListResponseSchema.Validate(o)
+}
+
+var applicationsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ApplicationsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsListResponse) Validate() error {
+	return applicationsListResponseSchema.Validate(o)
+}
+
+var autoTagActionsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the Auto-TagActionsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoTagActionsListResponse) Validate() error {
+	return autoTagActionsListResponseSchema.Validate(o)
+}
+
+var dynamicUserGroupsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DynamicUserGroupsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DynamicUserGroupsListResponse) Validate() error {
+	return dynamicUserGroupsListResponseSchema.Validate(o)
+}
+
+var externalDynamicListsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ExternalDynamicListsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsListResponse) Validate() error {
+	return externalDynamicListsListResponseSchema.Validate(o)
+}
+
+var hIPObjectsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the HIPObjectsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HIPObjectsListResponse) Validate() error {
+	return hIPObjectsListResponseSchema.Validate(o)
+}
+
+var hIPProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the HIPProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HIPProfilesListResponse) Validate() error {
+	return hIPProfilesListResponseSchema.Validate(o)
+}
+
+var hTTPServerProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the HTTPServerProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HTTPServerProfilesListResponse) Validate() error {
+	return hTTPServerProfilesListResponseSchema.Validate(o)
+}
+
+var logForwardingProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the LogForwardingProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogForwardingProfilesListResponse) Validate() error {
+	return logForwardingProfilesListResponseSchema.Validate(o)
+}
+
+var regionsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RegionsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RegionsListResponse) Validate() error {
+	return regionsListResponseSchema.Validate(o)
+}
+
+var schedulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SchedulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SchedulesListResponse) Validate() error {
+	return schedulesListResponseSchema.Validate(o)
+}
+
+var serviceGroupsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ServiceGroupsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceGroupsListResponse) Validate() error {
+	return serviceGroupsListResponseSchema.Validate(o)
+}
+
+var servicesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ServicesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServicesListResponse) Validate() error {
+	return servicesListResponseSchema.Validate(o)
+}
+
+var syslogServerProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the SyslogServerProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SyslogServerProfilesListResponse) Validate() error {
+	return syslogServerProfilesListResponseSchema.Validate(o)
+}
+
+var tagsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the TagsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o TagsListResponse) Validate() error {
+	return tagsListResponseSchema.Validate(o)
+}
+
+var addressGroupsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 1023},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, Pattern: `^[ a-zA-Z\d._-]+$`, MaxLength: 63},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"static":      {Items: &validate.Rule{MaxLength: 63}},
+		"tag":         {MaxItems: 64, Items: &validate.Rule{MaxLength: 127}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"static", "dynamic"}, Required: true},
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the address-groups schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AddressGroups) Validate() error {
+	return addressGroupsSchema.Validate(o)
+}
+
+var addressGroupsDynamicSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"filter": {Required: true, MaxLength: 2047},
+	},
+}
+
+// Validate checks o against the constraints of the address_groups_dynamic schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AddressGroupsDynamic) Validate() error {
+	return addressGroupsDynamicSchema.Validate(o)
+}
+
+var addressesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 1023},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"fqdn":        {Pattern: `^[a-zA-Z0-9_]([a-zA-Z0-9._-])+[a-zA-Z0-9]$`, MinLength: 1, MaxLength: 255},
+		"name":        {Required: true, MaxLength: 63},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"tag":         {MaxItems: 64, Items: &validate.Rule{MaxLength: 127}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"ip_netmask", "ip_range", "ip_wildcard", "fqdn"}, Required: true},
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the addresses schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Addresses) Validate() error {
+	return addressesSchema.Validate(o)
+}
+
+var applicationFiltersSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"category":            {Items: &validate.Rule{MaxLength: 128}},
+		"device":              {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"exclude":             {Items: &validate.Rule{MaxLength: 63}},
+		"folder":              {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":                {Required: true, MaxLength: 31},
+		"risk":                {Items: &validate.Rule{Minimum: validate.Bound(1), Maximum: validate.Bound(5)}},
+		"saas_certifications": {Items: &validate.Rule{MaxLength: 32}},
+		"saas_risk":           {Items: &validate.Rule{MaxLength: 32}},
+		"snippet":             {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"subcategory":         {Items: &validate.Rule{MaxLength: 128}},
+		"technology":          {Items: &validate.Rule{MaxLength: 128}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the application-filters schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationFilters) Validate() error {
+	return applicationFiltersSchema.Validate(o)
+}
+
+var applicationGroupsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"members": {Required: true, Items: &validate.Rule{MaxLength: 63}},
+		"name":    {Required: true, MaxLength: 31},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the application-groups schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationGroups) Validate() error {
+	return applicationGroupsSchema.Validate(o)
+}
+
+var applicationFiltersTaggingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"tag": {Items: &validate.Rule{MaxLength: 127}},
+	},
+}
+
+// Validate checks o against the constraints of the application_filters_tagging schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationFiltersTagging) Validate() error {
+	return applicationFiltersTaggingSchema.Validate(o)
+}
+
+var applicationsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"alg_disable_capability":  {MaxLength: 127},
+		"category":                {Required: true},
+		"description":             {MaxLength: 8192},
+		"device":                  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":                  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":                    {Required: true, MaxLength: 31},
+		"parent_app":              {MaxLength: 127},
+		"risk":                    {Required: true},
+		"snippet":                 {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"subcategory":             {MaxLength: 63},
+		"tcp_half_closed_timeout": {Minimum: validate.Bound(1), Maximum: validate.Bound(604800)},
+		"tcp_time_wait_timeout":   {Minimum: validate.Bound(1), Maximum: validate.Bound(600)},
+		"tcp_timeout":             {Minimum: validate.Bound(0), Maximum: validate.Bound(604800)},
+		"technology":              {MaxLength: 63},
+		"timeout":                 {Minimum: validate.Bound(0), Maximum: validate.Bound(604800)},
+		"udp_timeout":             {Minimum: validate.Bound(0), Maximum: validate.Bound(604800)},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the applications schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Applications) Validate() error {
+	return applicationsSchema.Validate(o)
+}
+
+var applicationsDefaultSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"port": {Items: &validate.Rule{MaxLength: 63}},
+	},
+}
+
+// Validate checks o against the constraints of the applications_default schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsDefault) Validate() error {
+	return applicationsDefaultSchema.Validate(o)
+}
+
+var applicationsDefaultIdentByIcmp6TypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"type": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the applications_default_ident_by_icmp6_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsDefaultIdentByIcmp6Type) Validate() error {
+	return applicationsDefaultIdentByIcmp6TypeSchema.Validate(o)
+}
+
+var applicationsSignatureInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"comment": {MaxLength: 256},
+		"name":    {Required: true, MaxLength: 31},
+		"scope":   {Enum: []string{"protocol-data-unit", "session"}},
+	},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInner) Validate() error {
+	return applicationsSignatureInnerSchema.Validate(o)
+}
+
+var applicationsSignatureInnerAndConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true, MaxLength: 31},
+	},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner_and_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInnerAndConditionInner) Validate() error {
+	return applicationsSignatureInnerAndConditionInnerSchema.Validate(o)
+}
+
+var applicationsSignatureInnerAndConditionInnerOrConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":     {Required: true, MaxLength: 31},
+		"operator": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner_and_condition_inner_or_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInnerAndConditionInnerOrConditionInner) Validate() error {
+	return applicationsSignatureInnerAndConditionInnerOrConditionInnerSchema.Validate(o)
+}
+
+var applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner_and_condition_inner_or_condition_inner_operator schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInnerAndConditionInnerOrConditionInnerOperator) Validate() error {
+	return applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorSchema.Validate(o)
+}
+
+var applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorEqualToSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"context":  {Required: true},
+		"mask":     {Pattern: `^[0][xX][0-9A-Fa-f]{8}$`, MaxLength: 10},
+		"position": {MaxLength: 127},
+		"value":    {Required: true, MaxLength: 10},
+	},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner_and_condition_inner_or_condition_inner_operator_equal_to schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorEqualTo) Validate() error {
+	return applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorEqualToSchema.Validate(o)
+}
+
+var applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorGreaterThanSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"context": {Required: true, MaxLength: 127},
+		"value":   {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner_and_condition_inner_or_condition_inner_operator_greater_than schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorGreaterThan) Validate() error {
+	return applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorGreaterThanSchema.Validate(o)
+}
+
+var applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorGreaterThanQualifierInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":  {Required: true, MaxLength: 31},
+		"value": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner_and_condition_inner_or_condition_inner_operator_greater_than_qualifier_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorGreaterThanQualifierInner) Validate() error {
+	return applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorGreaterThanQualifierInnerSchema.Validate(o)
+}
+
+var applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorPatternMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"context": {Required: true, MaxLength: 127},
+		"pattern": {Required: true, MaxLength: 127},
+	},
+}
+
+// Validate checks o against the constraints of the applications_signature_inner_and_condition_inner_or_condition_inner_operator_pattern_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorPatternMatch) Validate() error {
+	return applicationsSignatureInnerAndConditionInnerOrConditionInnerOperatorPatternMatchSchema.Validate(o)
+}
+
+var autoTagActionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 1023},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"filter":      {Required: true, MaxLength: 2047},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, MaxLength: 63},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the auto-tag-actions schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoTagActions) Validate() error {
+	return autoTagActionsSchema.Validate(o)
+}
+
+var autoTagActionsActionsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+		"type": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the auto_tag_actions_actions_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoTagActionsActionsInner) Validate() error {
+	return autoTagActionsActionsInnerSchema.Validate(o)
+}
+
+var autoTagActionsActionsInnerTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"tagging": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the auto_tag_actions_actions_inner_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoTagActionsActionsInnerType) Validate() error {
+	return autoTagActionsActionsInnerTypeSchema.Validate(o)
+}
+
+var autoTagActionsActionsInnerTypeTaggingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Required: true, Enum: []string{"add-tag", "remove-tag"}},
+		"tags":   {MaxItems: 64, Items: &validate.Rule{MaxLength: 127}},
+		"target": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the auto_tag_actions_actions_inner_type_tagging schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AutoTagActionsActionsInnerTypeTagging) Validate() error {
+	return autoTagActionsActionsInnerTypeTaggingSchema.Validate(o)
+}
+
+var dynamicUserGroupsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 1023},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"filter":      {Required: true, MaxLength: 2047},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, Pattern: `^[ a-zA-Z\d.\-_]+$`, MaxLength: 63},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"tag":         {MaxItems: 64, Items: &validate.Rule{MaxLength: 127}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the dynamic-user-groups schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DynamicUserGroups) Validate() error {
+	return dynamicUserGroupsSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var externalDynamicListsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, Pattern: `^[ a-zA-Z\d.\-_]+$`, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the external-dynamic-lists schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicLists) Validate() error {
+	return externalDynamicListsSchema.Validate(o)
+}
+
+var externalDynamicListsTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsType) Validate() error {
+	return externalDynamicListsTypeSchema.Validate(o)
+}
+
+var externalDynamicListsTypeDomainSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description":    {MaxLength: 255},
+		"exception_list": {Items: &validate.Rule{MaxLength: 255}},
+		"recurring":      {Required: true},
+		"url":            {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_domain schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeDomain) Validate() error {
+	return externalDynamicListsTypeDomainSchema.Validate(o)
+}
+
+var externalDynamicListsTypeDomainAuthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"password": {Required: true, MaxLength: 255},
+		"username": {Required: true, MinLength: 1, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_domain_auth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeDomainAuth) Validate() error {
+	return externalDynamicListsTypeDomainAuthSchema.Validate(o)
+}
+
+var externalDynamicListsTypeDomainRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_domain_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeDomainRecurring) Validate() error {
+	return externalDynamicListsTypeDomainRecurringSchema.Validate(o)
+}
+
+var externalDynamicListsTypeDomainRecurringDailySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at": {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_domain_recurring_daily schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeDomainRecurringDaily) Validate() error {
+	return externalDynamicListsTypeDomainRecurringDailySchema.Validate(o)
+}
+
+var externalDynamicListsTypeDomainRecurringMonthlySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":           {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_month": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(31)},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_domain_recurring_monthly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeDomainRecurringMonthly) Validate() error {
+	return externalDynamicListsTypeDomainRecurringMonthlySchema.Validate(o)
+}
+
+var externalDynamicListsTypeDomainRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":          {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_week": {Required: true, Enum: []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_domain_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeDomainRecurringWeekly) Validate() error {
+	return externalDynamicListsTypeDomainRecurringWeeklySchema.Validate(o)
+}
+
+var externalDynamicListsTypeImeiSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description":    {MaxLength: 255},
+		"exception_list": {Items: &validate.Rule{MaxLength: 32}},
+		"recurring":      {Required: true},
+		"url":            {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imei schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImei) Validate() error {
+	return externalDynamicListsTypeImeiSchema.Validate(o)
+}
+
+var externalDynamicListsTypeImeiAuthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"password": {Required: true, MaxLength: 255},
+		"username": {Required: true, MinLength: 1, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imei_auth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImeiAuth) Validate() error {
+	return externalDynamicListsTypeImeiAuthSchema.Validate(o)
+}
+
+var externalDynamicListsTypeImeiRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imei_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImeiRecurring) Validate() error {
+	return externalDynamicListsTypeImeiRecurringSchema.Validate(o)
+}
+
+var externalDynamicListsTypeImeiRecurringDailySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at": {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imei_recurring_daily schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImeiRecurringDaily) Validate() error {
+	return externalDynamicListsTypeImeiRecurringDailySchema.Validate(o)
+}
+
+var externalDynamicListsTypeImeiRecurringMonthlySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":           {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_month": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(31)},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imei_recurring_monthly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImeiRecurringMonthly) Validate() error {
+	return externalDynamicListsTypeImeiRecurringMonthlySchema.Validate(o)
+}
+
+var externalDynamicListsTypeImeiRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":          {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_week": {Required: true, Enum: []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imei_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImeiRecurringWeekly) Validate() error {
+	return externalDynamicListsTypeImeiRecurringWeeklySchema.Validate(o)
+}
+
+var externalDynamicListsTypeImsiSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description":    {MaxLength: 255},
+		"exception_list": {Items: &validate.Rule{MaxLength: 34}},
+		"recurring":      {Required: true},
+		"url":            {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imsi schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImsi) Validate() error {
+	return externalDynamicListsTypeImsiSchema.Validate(o)
+}
+
+var externalDynamicListsTypeImsiAuthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"password": {Required: true, MaxLength: 255},
+		"username": {Required: true, MinLength: 1, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imsi_auth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImsiAuth) Validate() error {
+	return externalDynamicListsTypeImsiAuthSchema.Validate(o)
+}
+
+var externalDynamicListsTypeImsiRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imsi_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImsiRecurring) Validate() error {
+	return externalDynamicListsTypeImsiRecurringSchema.Validate(o)
+}
+
+var externalDynamicListsTypeImsiRecurringDailySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at": {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imsi_recurring_daily schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImsiRecurringDaily) Validate() error {
+	return externalDynamicListsTypeImsiRecurringDailySchema.Validate(o)
+}
+
+var externalDynamicListsTypeImsiRecurringMonthlySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":           {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_month": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(31)},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imsi_recurring_monthly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImsiRecurringMonthly) Validate() error {
+	return externalDynamicListsTypeImsiRecurringMonthlySchema.Validate(o)
+}
+
+var externalDynamicListsTypeImsiRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":          {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_week": {Required: true, Enum: []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_imsi_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeImsiRecurringWeekly) Validate() error {
+	return externalDynamicListsTypeImsiRecurringWeeklySchema.Validate(o)
+}
+
+var externalDynamicListsTypeIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description":    {MaxLength: 255},
+		"exception_list": {Items: &validate.Rule{MaxLength: 255}},
+		"recurring":      {Required: true},
+		"url":            {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeIp) Validate() error {
+	return externalDynamicListsTypeIpSchema.Validate(o)
+}
+
+var externalDynamicListsTypeIpAuthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"password": {Required: true, MaxLength: 255},
+		"username": {Required: true, MinLength: 1, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_ip_auth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeIpAuth) Validate() error {
+	return externalDynamicListsTypeIpAuthSchema.Validate(o)
+}
+
+var externalDynamicListsTypeIpRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_ip_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeIpRecurring) Validate() error {
+	return externalDynamicListsTypeIpRecurringSchema.Validate(o)
+}
+
+var externalDynamicListsTypeIpRecurringDailySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at": {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_ip_recurring_daily schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeIpRecurringDaily) Validate() error {
+	return externalDynamicListsTypeIpRecurringDailySchema.Validate(o)
+}
+
+var externalDynamicListsTypeIpRecurringMonthlySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":           {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_month": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(31)},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_ip_recurring_monthly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeIpRecurringMonthly) Validate() error {
+	return externalDynamicListsTypeIpRecurringMonthlySchema.Validate(o)
+}
+
+var externalDynamicListsTypeIpRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":          {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_week": {Required: true, Enum: []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_ip_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeIpRecurringWeekly) Validate() error {
+	return externalDynamicListsTypeIpRecurringWeeklySchema.Validate(o)
+}
+
+var externalDynamicListsTypePredefinedIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description":    {MaxLength: 255},
+		"exception_list": {Items: &validate.Rule{MaxLength: 255}},
+		"url":            {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_predefined_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypePredefinedIp) Validate() error {
+	return externalDynamicListsTypePredefinedIpSchema.Validate(o)
+}
+
+var externalDynamicListsTypePredefinedUrlSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description":    {MaxLength: 255},
+		"exception_list": {Items: &validate.Rule{MaxLength: 255}},
+		"url":            {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_predefined_url schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypePredefinedUrl) Validate() error {
+	return externalDynamicListsTypePredefinedUrlSchema.Validate(o)
+}
+
+var externalDynamicListsTypeUrlSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description":    {MaxLength: 255},
+		"exception_list": {Items: &validate.Rule{MaxLength: 255}},
+		"recurring":      {Required: true},
+		"url":            {Required: true, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_url schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeUrl) Validate() error {
+	return externalDynamicListsTypeUrlSchema.Validate(o)
+}
+
+var externalDynamicListsTypeUrlAuthSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"password": {Required: true, MaxLength: 255},
+		"username": {Required: true, MinLength: 1, MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_url_auth schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeUrlAuth) Validate() error {
+	return externalDynamicListsTypeUrlAuthSchema.Validate(o)
+}
+
+var externalDynamicListsTypeUrlRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_url_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeUrlRecurring) Validate() error {
+	return externalDynamicListsTypeUrlRecurringSchema.Validate(o)
+}
+
+var externalDynamicListsTypeUrlRecurringDailySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at": {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_url_recurring_daily schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeUrlRecurringDaily) Validate() error {
+	return externalDynamicListsTypeUrlRecurringDailySchema.Validate(o)
+}
+
+var externalDynamicListsTypeUrlRecurringMonthlySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":           {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_month": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(31)},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_url_recurring_monthly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeUrlRecurringMonthly) Validate() error {
+	return externalDynamicListsTypeUrlRecurringMonthlySchema.Validate(o)
+}
+
+var externalDynamicListsTypeUrlRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"at":          {Required: true, Pattern: `([01][0-9]|[2][0-3])`, MinLength: 2, MaxLength: 2},
+		"day_of_week": {Required: true, Enum: []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}},
+	},
+}
+
+// Validate checks o against the constraints of the external_dynamic_lists_type_url_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ExternalDynamicListsTypeUrlRecurringWeekly) Validate() error {
+	return externalDynamicListsTypeUrlRecurringWeeklySchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var hipObjectsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 255},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, Pattern: `^[ a-zA-Z\d.\-_]+$`, MaxLength: 31},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the hip-objects schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjects) Validate() error {
+	return hipObjectsSchema.Validate(o)
+}
+
+var hipProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 255},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"match":       {Required: true, MaxLength: 2048},
+		"name":        {Required: true, Pattern: `^[ a-zA-Z\d.\-_]+$`, MaxLength: 31},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the hip-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipProfiles) Validate() error {
+	return hipProfilesSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalware) Validate() error {
+	return hipObjectsAntiMalwareSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"real_time_protection": {Enum: []string{"no", "yes", "not-available"}},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareCriteria) Validate() error {
+	return hipObjectsAntiMalwareCriteriaSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareCriteriaLastScanTimeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_criteria_last_scan_time schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareCriteriaLastScanTime) Validate() error {
+	return hipObjectsAntiMalwareCriteriaLastScanTimeSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareCriteriaLastScanTimeNotWithinSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"days":  {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"hours": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_criteria_last_scan_time_not_within schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareCriteriaLastScanTimeNotWithin) Validate() error {
+	return hipObjectsAntiMalwareCriteriaLastScanTimeNotWithinSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareCriteriaProductVersionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"contains":      {MaxLength: 255},
+		"greater_equal": {MaxLength: 255},
+		"greater_than":  {MaxLength: 255},
+		"is":            {MaxLength: 255},
+		"is_not":        {MaxLength: 255},
+		"less_equal":    {MaxLength: 255},
+		"less_than":     {MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_criteria_product_version schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareCriteriaProductVersion) Validate() error {
+	return hipObjectsAntiMalwareCriteriaProductVersionSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareCriteriaProductVersionNotWithinSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"versions": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_criteria_product_version_not_within schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareCriteriaProductVersionNotWithin) Validate() error {
+	return hipObjectsAntiMalwareCriteriaProductVersionNotWithinSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareCriteriaVirdefVersionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_criteria_virdef_version schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareCriteriaVirdefVersion) Validate() error {
+	return hipObjectsAntiMalwareCriteriaVirdefVersionSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareCriteriaVirdefVersionNotWithinSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"days":     {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"versions": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_criteria_virdef_version_not_within schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareCriteriaVirdefVersionNotWithin) Validate() error {
+	return hipObjectsAntiMalwareCriteriaVirdefVersionNotWithinSchema.Validate(o)
+}
+
+var hipObjectsAntiMalwareVendorInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":    {Required: true, MaxLength: 103},
+		"product": {Items: &validate.Rule{Pattern: `.*`, MaxLength: 1023}},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_anti_malware_vendor_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsAntiMalwareVendorInner) Validate() error {
+	return hipObjectsAntiMalwareVendorInnerSchema.Validate(o)
+}
+
+var hipObjectsCertificateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_certificate schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCertificate) Validate() error {
+	return hipObjectsCertificateSchema.Validate(o)
+}
+
+var hipObjectsCertificateCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_certificate_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCertificateCriteria) Validate() error {
+	return hipObjectsCertificateCriteriaSchema.Validate(o)
+}
+
+var hipObjectsCertificateCriteriaCertificateAttributesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":  {Required: true},
+		"value": {Pattern: `.*`, MaxLength: 1024},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_certificate_criteria_certificate_attributes_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCertificateCriteriaCertificateAttributesInner) Validate() error {
+	return hipObjectsCertificateCriteriaCertificateAttributesInnerSchema.Validate(o)
+}
+
+var hipObjectsCustomChecksSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"criteria": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_custom_checks schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCustomChecks) Validate() error {
+	return hipObjectsCustomChecksSchema.Validate(o)
+}
+
+var hipObjectsCustomChecksCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_custom_checks_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCustomChecksCriteria) Validate() error {
+	return hipObjectsCustomChecksCriteriaSchema.Validate(o)
+}
+
+var hipObjectsCustomChecksCriteriaPlistInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_custom_checks_criteria_plist_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCustomChecksCriteriaPlistInner) Validate() error {
+	return hipObjectsCustomChecksCriteriaPlistInnerSchema.Validate(o)
+}
+
+var hipObjectsCustomChecksCriteriaPlistInnerKeyInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":  {Required: true, MaxLength: 1023},
+		"value": {Pattern: `.*`, MaxLength: 1024},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_custom_checks_criteria_plist_inner_key_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCustomChecksCriteriaPlistInnerKeyInner) Validate() error {
+	return hipObjectsCustomChecksCriteriaPlistInnerKeyInnerSchema.Validate(o)
+}
+
+var hipObjectsCustomChecksCriteriaProcessListInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_custom_checks_criteria_process_list_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCustomChecksCriteriaProcessListInner) Validate() error {
+	return hipObjectsCustomChecksCriteriaProcessListInnerSchema.Validate(o)
+}
+
+var hipObjectsCustomChecksCriteriaRegistryKeyInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"default_value_data": {Pattern: `.*`, MaxLength: 1024},
+		"name":               {Required: true, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_custom_checks_criteria_registry_key_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCustomChecksCriteriaRegistryKeyInner) Validate() error {
+	return hipObjectsCustomChecksCriteriaRegistryKeyInnerSchema.Validate(o)
+}
+
+var hipObjectsCustomChecksCriteriaRegistryKeyInnerRegistryValueInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":       {Required: true, MaxLength: 1023},
+		"value_data": {Pattern: `.*`, MaxLength: 1024},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_custom_checks_criteria_registry_key_inner_registry_value_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsCustomChecksCriteriaRegistryKeyInnerRegistryValueInner) Validate() error {
+	return hipObjectsCustomChecksCriteriaRegistryKeyInnerRegistryValueInnerSchema.Validate(o)
+}
+
+var hipObjectsDataLossPreventionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_data_loss_prevention schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDataLossPrevention) Validate() error {
+	return hipObjectsDataLossPreventionSchema.Validate(o)
+}
+
+var hipObjectsDataLossPreventionCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"is_enabled": {Enum: []string{"no", "yes", "not-available"}},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_data_loss_prevention_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDataLossPreventionCriteria) Validate() error {
+	return hipObjectsDataLossPreventionCriteriaSchema.Validate(o)
+}
+
+var hipObjectsDataLossPreventionVendorInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":    {Required: true, MaxLength: 103},
+		"product": {Items: &validate.Rule{Pattern: `.*`, MaxLength: 1023}},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_data_loss_prevention_vendor_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDataLossPreventionVendorInner) Validate() error {
+	return hipObjectsDataLossPreventionVendorInnerSchema.Validate(o)
+}
+
+var hipObjectsDiskBackupSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_disk_backup schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDiskBackup) Validate() error {
+	return hipObjectsDiskBackupSchema.Validate(o)
+}
+
+var hipObjectsDiskBackupCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_disk_backup_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDiskBackupCriteria) Validate() error {
+	return hipObjectsDiskBackupCriteriaSchema.Validate(o)
+}
+
+var hipObjectsDiskEncryptionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_disk_encryption schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDiskEncryption) Validate() error {
+	return hipObjectsDiskEncryptionSchema.Validate(o)
+}
+
+var hipObjectsDiskEncryptionCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_disk_encryption_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDiskEncryptionCriteria) Validate() error {
+	return hipObjectsDiskEncryptionCriteriaSchema.Validate(o)
+}
+
+var hipObjectsDiskEncryptionCriteriaEncryptedLocationsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_disk_encryption_criteria_encrypted_locations_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDiskEncryptionCriteriaEncryptedLocationsInner) Validate() error {
+	return hipObjectsDiskEncryptionCriteriaEncryptedLocationsInnerSchema.Validate(o)
+}
+
+var hipObjectsDiskEncryptionCriteriaEncryptedLocationsInnerEncryptionStateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"is":     {Enum: []string{"encrypted", "unencrypted", "partial", "unknown"}},
+		"is_not": {Enum: []string{"encrypted", "unencrypted", "partial", "unknown"}},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_disk_encryption_criteria_encrypted_locations_inner_encryption_state schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsDiskEncryptionCriteriaEncryptedLocationsInnerEncryptionState) Validate() error {
+	return hipObjectsDiskEncryptionCriteriaEncryptedLocationsInnerEncryptionStateSchema.Validate(o)
+}
+
+var hipObjectsFirewallSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_firewall schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsFirewall) Validate() error {
+	return hipObjectsFirewallSchema.Validate(o)
+}
+
+var hipObjectsHostInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"criteria": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_host_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsHostInfo) Validate() error {
+	return hipObjectsHostInfoSchema.Validate(o)
+}
+
+var hipObjectsHostInfoCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_host_info_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsHostInfoCriteria) Validate() error {
+	return hipObjectsHostInfoCriteriaSchema.Validate(o)
+}
+
+var hipObjectsHostInfoCriteriaClientVersionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"contains": {MaxLength: 255},
+		"is":       {MaxLength: 255},
+		"is_not":   {MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_host_info_criteria_client_version schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsHostInfoCriteriaClientVersion) Validate() error {
+	return hipObjectsHostInfoCriteriaClientVersionSchema.Validate(o)
+}
+
+var hipObjectsHostInfoCriteriaOsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_host_info_criteria_os schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsHostInfoCriteriaOs) Validate() error {
+	return hipObjectsHostInfoCriteriaOsSchema.Validate(o)
+}
+
+var hipObjectsHostInfoCriteriaOsContainsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"Apple":     {MaxLength: 255},
+		"Google":    {MaxLength: 255},
+		"Linux":     {MaxLength: 255},
+		"Microsoft": {MaxLength: 255},
+		"Other":     {MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_host_info_criteria_os_contains schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsHostInfoCriteriaOsContains) Validate() error {
+	return hipObjectsHostInfoCriteriaOsContainsSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDevice) Validate() error {
+	return hipObjectsMobileDeviceSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDeviceCriteria) Validate() error {
+	return hipObjectsMobileDeviceCriteriaSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceCriteriaApplicationsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device_criteria_applications schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDeviceCriteriaApplications) Validate() error {
+	return hipObjectsMobileDeviceCriteriaApplicationsSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceCriteriaApplicationsHasMalwareSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device_criteria_applications_has_malware schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDeviceCriteriaApplicationsHasMalware) Validate() error {
+	return hipObjectsMobileDeviceCriteriaApplicationsHasMalwareSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceCriteriaApplicationsHasMalwareYesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device_criteria_applications_has_malware_yes schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDeviceCriteriaApplicationsHasMalwareYes) Validate() error {
+	return hipObjectsMobileDeviceCriteriaApplicationsHasMalwareYesSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceCriteriaApplicationsHasMalwareYesExcludesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"hash":    {Pattern: `.*`, MaxLength: 1024},
+		"name":    {Required: true, MaxLength: 31},
+		"package": {Pattern: `.*`, MaxLength: 1024},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device_criteria_applications_has_malware_yes_excludes_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDeviceCriteriaApplicationsHasMalwareYesExcludesInner) Validate() error {
+	return hipObjectsMobileDeviceCriteriaApplicationsHasMalwareYesExcludesInnerSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceCriteriaLastCheckinTimeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device_criteria_last_checkin_time schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDeviceCriteriaLastCheckinTime) Validate() error {
+	return hipObjectsMobileDeviceCriteriaLastCheckinTimeSchema.Validate(o)
+}
+
+var hipObjectsMobileDeviceCriteriaLastCheckinTimeNotWithinSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"days": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(365)},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_mobile_device_criteria_last_checkin_time_not_within schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsMobileDeviceCriteriaLastCheckinTimeNotWithin) Validate() error {
+	return hipObjectsMobileDeviceCriteriaLastCheckinTimeNotWithinSchema.Validate(o)
+}
+
+var hipObjectsNetworkInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_network_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsNetworkInfo) Validate() error {
+	return hipObjectsNetworkInfoSchema.Validate(o)
+}
+
+var hipObjectsNetworkInfoCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_network_info_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsNetworkInfoCriteria) Validate() error {
+	return hipObjectsNetworkInfoCriteriaSchema.Validate(o)
+}
+
+var hipObjectsNetworkInfoCriteriaNetworkSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_network_info_criteria_network schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsNetworkInfoCriteriaNetwork) Validate() error {
+	return hipObjectsNetworkInfoCriteriaNetworkSchema.Validate(o)
+}
+
+var hipObjectsNetworkInfoCriteriaNetworkIsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_network_info_criteria_network_is schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsNetworkInfoCriteriaNetworkIs) Validate() error {
+	return hipObjectsNetworkInfoCriteriaNetworkIsSchema.Validate(o)
+}
+
+var hipObjectsNetworkInfoCriteriaNetworkIsMobileSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"carrier": {Pattern: `.*`, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_network_info_criteria_network_is_mobile schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsNetworkInfoCriteriaNetworkIsMobile) Validate() error {
+	return hipObjectsNetworkInfoCriteriaNetworkIsMobileSchema.Validate(o)
+}
+
+var hipObjectsNetworkInfoCriteriaNetworkIsNotSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_network_info_criteria_network_is_not schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsNetworkInfoCriteriaNetworkIsNot) Validate() error {
+	return hipObjectsNetworkInfoCriteriaNetworkIsNotSchema.Validate(o)
+}
+
+var hipObjectsNetworkInfoCriteriaNetworkIsWifiSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ssid": {Pattern: `.*`, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_network_info_criteria_network_is_wifi schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsNetworkInfoCriteriaNetworkIsWifi) Validate() error {
+	return hipObjectsNetworkInfoCriteriaNetworkIsWifiSchema.Validate(o)
+}
+
+var hipObjectsPatchManagementSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the hip_objects_patch_management schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsPatchManagement) Validate() error {
+	return hipObjectsPatchManagementSchema.Validate(o)
+}
+
+var hipObjectsPatchManagementCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"is_enabled": {Enum: []string{"no", "yes", "not-available"}},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_patch_management_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsPatchManagementCriteria) Validate() error {
+	return hipObjectsPatchManagementCriteriaSchema.Validate(o)
+}
+
+var hipObjectsPatchManagementCriteriaMissingPatchesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"check":   {Required: true, Enum: []string{"has-any", "has-none", "has-all"}},
+		"patches": {Items: &validate.Rule{Pattern: `.*`, MaxLength: 1023}},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_patch_management_criteria_missing_patches schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsPatchManagementCriteriaMissingPatches) Validate() error {
+	return hipObjectsPatchManagementCriteriaMissingPatchesSchema.Validate(o)
+}
+
+var hipObjectsPatchManagementCriteriaMissingPatchesSeveritySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"greater_equal": {Minimum: validate.Bound(0), Maximum: validate.Bound(100000)},
+		"greater_than":  {Minimum: validate.Bound(0), Maximum: validate.Bound(100000)},
+		"is":            {Minimum: validate.Bound(0), Maximum: validate.Bound(100000)},
+		"is_not":        {Minimum: validate.Bound(0), Maximum: validate.Bound(100000)},
+		"less_equal":    {Minimum: validate.Bound(0), Maximum: validate.Bound(100000)},
+		"less_than":     {Minimum: validate.Bound(0), Maximum: validate.Bound(100000)},
+	},
+}
+
+// Validate checks o against the constraints of the hip_objects_patch_management_criteria_missing_patches_severity schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HipObjectsPatchManagementCriteriaMissingPatchesSeverity) Validate() error {
+	return hipObjectsPatchManagementCriteriaMissingPatchesSeveritySchema.Validate(o)
+}
+
+var httpServerProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the http-server-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HttpServerProfiles) Validate() error {
+	return httpServerProfilesSchema.Validate(o)
+}
+
+var httpServerProfilesFormatSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the http_server_profiles_format schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HttpServerProfilesFormat) Validate() error {
+	return httpServerProfilesFormatSchema.Validate(o)
+}
+
+var httpServerProfilesServerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"http_method": {Enum: []string{"GET", "POST", "PUT", "DELETE"}},
+		"protocol":    {Enum: []string{"HTTP", "HTTPS"}},
+		"tls_version": {Enum: []string{"1.0", "1.1", "1.2", "1.3"}},
+	},
+}
+
+// Validate checks o against the constraints of the http_server_profiles_server_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HttpServerProfilesServerInner) Validate() error {
+	return httpServerProfilesServerInnerSchema.Validate(o)
+}
+
+var logForwardingProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {Maximum: validate.Bound(255)},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"match_list":  {Required: true, MinItems: 1},
+		"name":        {Required: true, MaxLength: 63},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the log-forwarding-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogForwardingProfiles) Validate() error {
+	return logForwardingProfilesSchema.Validate(o)
+}
+
+var logForwardingProfilesMatchListInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action_desc": {MaxLength: 255},
+		"filter":      {Required: true, MaxLength: 65535},
+		"log_type":    {Required: true, Enum: []string{"traffic", "threat", "wildfire", "url", "data", "tunnel", "auth", "decryption", "dns-security", "gtp", "sctp"}},
+		"name":        {Required: true, MaxLength: 63},
+	},
+}
+
+// Validate checks o against the constraints of the log_forwarding_profiles_match_list_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o LogForwardingProfilesMatchListInner) Validate() error {
+	return logForwardingProfilesMatchListInnerSchema.Validate(o)
+}
+
+var payloadFormatSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the payload-format schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PayloadFormat) Validate() error {
+	return payloadFormatSchema.Validate(o)
+}
+
+var payloadFormatHeadersInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the payload_format_headers_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PayloadFormatHeadersInner) Validate() error {
+	return payloadFormatHeadersInnerSchema.Validate(o)
+}
+
+var payloadFormatParamsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the payload_format_params_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o PayloadFormatParamsInner) Validate() error {
+	return payloadFormatParamsInnerSchema.Validate(o)
+}
+
+var quarantinedDevicesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"host_id": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the quarantined-devices schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o QuarantinedDevices) Validate() error {
+	return quarantinedDevicesSchema.Validate(o)
+}
+
+var regionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, Pattern: `^[ a-zA-Z\d._-]+$`, MaxLength: 31},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the regions schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Regions) Validate() error {
+	return regionsSchema.Validate(o)
+}
+
+var regionsGeoLocationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"latitude":  {Required: true, Minimum: validate.Bound(-90), Maximum: validate.Bound(90)},
+		"longitude": {Required: true, Minimum: validate.Bound(-180), Maximum: validate.Bound(180)},
+	},
+}
+
+// Validate checks o against the constraints of the regions_geo_location schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RegionsGeoLocation) Validate() error {
+	return regionsGeoLocationSchema.Validate(o)
+}
+
+var schedulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":          {Required: true, Pattern: `^[ a-zA-Z\d._-]+$`, MaxLength: 31},
+		"schedule_type": {Required: true},
+		"snippet":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the schedules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Schedules) Validate() error {
+	return schedulesSchema.Validate(o)
+}
+
+var schedulesScheduleTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"non_recurring": {Items: &validate.Rule{Pattern: `[0-9][0-9][0-9][0-9]\/([0][1-9]|[1][0-2])\/([0-2][0-9]|[3][0-1])@([01][0-9]|[2][0-3]):([0-5][0-9])-[0-9][0-9][0-9][0-9]\/([0][1-9]|[1][0-2])\/([0-2][0-9]|[3][0-1])@([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 33, MaxLength: 33}},
+	},
+}
+
+// Validate checks o against the constraints of the schedules_schedule_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SchedulesScheduleType) Validate() error {
+	return schedulesScheduleTypeSchema.Validate(o)
+}
+
+var schedulesScheduleTypeRecurringSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"daily": {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+	},
+}
+
+// Validate checks o against the constraints of the schedules_schedule_type_recurring schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SchedulesScheduleTypeRecurring) Validate() error {
+	return schedulesScheduleTypeRecurringSchema.Validate(o)
+}
+
+var schedulesScheduleTypeRecurringWeeklySchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"friday":    {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+		"monday":    {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+		"saturday":  {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+		"sunday":    {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+		"thursday":  {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+		"tuesday":   {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+		"wednesday": {Items: &validate.Rule{Pattern: `([01][0-9]|[2][0-3]):([0-5][0-9])-([01][0-9]|[2][0-3]):([0-5][0-9])`, MinLength: 11, MaxLength: 11}},
+	},
+}
+
+// Validate checks o against the constraints of the schedules_schedule_type_recurring_weekly schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SchedulesScheduleTypeRecurringWeekly) Validate() error {
+	return schedulesScheduleTypeRecurringWeeklySchema.Validate(o)
+}
+
+var serviceGroupsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"members": {Required: true, Items: &validate.Rule{MaxLength: 63}},
+		"name":    {Required: true, Pattern: `^[ a-zA-Z\d.\-_]+$`, MaxLength: 63},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"tag":     {MaxItems: 64, Items: &validate.Rule{MaxLength: 127}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the service-groups schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServiceGroups) Validate() error {
+	return serviceGroupsSchema.Validate(o)
+}
+
+var servicesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 1023},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, Pattern: `^[ a-zA-Z\d.\-_]+$`, MaxLength: 63},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"tag":         {MaxItems: 64, Items: &validate.Rule{MaxLength: 127}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the services schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Services) Validate() error {
+	return servicesSchema.Validate(o)
+}
+
+var servicesProtocolSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"tcp", "udp"}, Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the services_protocol schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServicesProtocol) Validate() error {
+	return servicesProtocolSchema.Validate(o)
+}
+
+var servicesProtocolTcpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"port":        {Required: true, MinLength: 1, MaxLength: 1023},
+		"source_port": {MinLength: 1, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the services_protocol_tcp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServicesProtocolTcp) Validate() error {
+	return servicesProtocolTcpSchema.Validate(o)
+}
+
+var servicesProtocolTcpOverrideSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"halfclose_timeout": {Minimum: validate.Bound(1), Maximum: validate.Bound(604800)},
+		"timeout":           {Minimum: validate.Bound(1), Maximum: validate.Bound(604800)},
+		"timewait_timeout":  {Minimum: validate.Bound(1), Maximum: validate.Bound(600)},
+	},
+}
+
+// Validate checks o against the constraints of the services_protocol_tcp_override schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServicesProtocolTcpOverride) Validate() error {
+	return servicesProtocolTcpOverrideSchema.Validate(o)
+}
+
+var servicesProtocolUdpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"port":        {Required: true, MinLength: 1, MaxLength: 1023},
+		"source_port": {MinLength: 1, MaxLength: 1023},
+	},
+}
+
+// Validate checks o against the constraints of the services_protocol_udp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServicesProtocolUdp) Validate() error {
+	return servicesProtocolUdpSchema.Validate(o)
+}
+
+var servicesProtocolUdpOverrideSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"timeout": {Minimum: validate.Bound(1), Maximum: validate.Bound(604800)},
+	},
+}
+
+// Validate checks o against the constraints of the services_protocol_udp_override schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ServicesProtocolUdpOverride) Validate() error {
+	return servicesProtocolUdpOverrideSchema.Validate(o)
+}
+
+var syslogServerProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, MaxLength: 31},
+		"server":  {Required: true, MinItems: 1},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the syslog-server-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SyslogServerProfiles) Validate() error {
+	return syslogServerProfilesSchema.Validate(o)
+}
+
+var syslogServerProfilesFormatSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the syslog_server_profiles_format schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SyslogServerProfilesFormat) Validate() error {
+	return syslogServerProfilesFormatSchema.Validate(o)
+}
+
+var syslogServerProfilesFormatEscapingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"escape_character":   {MaxLength: 1},
+		"escaped_characters": {MaxLength: 255},
+	},
+}
+
+// Validate checks o against the constraints of the syslog_server_profiles_format_escaping schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SyslogServerProfilesFormatEscaping) Validate() error {
+	return syslogServerProfilesFormatEscapingSchema.Validate(o)
+}
+
+var syslogServerProfilesServerInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"facility":  {Enum: []string{"LOG_USER", "LOG_LOCAL0", "LOG_LOCAL1", "LOG_LOCAL2", "LOG_LOCAL3", "LOG_LOCAL4", "LOG_LOCAL5", "LOG_LOCAL6", "LOG_LOCAL7"}},
+		"format":    {Enum: []string{"BSD", "IETF"}},
+		"port":      {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"transport": {Enum: []string{"UDP", "TCP"}},
+	},
+}
+
+// Validate checks o against the constraints of the syslog_server_profiles_server_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SyslogServerProfilesServerInner) Validate() error {
+	return syslogServerProfilesServerInnerSchema.Validate(o)
+}
+
+var tagsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"color":    {Enum: []string{"Red", "Green", "Blue", "Yellow", "Copper", "Orange", "Purple", "Gray", "Light Green", "Cyan", "Light Gray", "Blue Gray", "Lime", "Black", "Gold", "Brown", "Olive", "Maroon", "Red-Orange", "Yellow-Orange", "Forest Green", "Turquoise Blue", "Azure Blue", "Cerulean Blue", "Midnight Blue", "Medium Blue", "Cobalt Blue", "Violet Blue", "Blue Violet", "Medium Violet", "Medium Rose", "Lavender", "Orchid", "Thistle", "Peach", "Salmon", "Magenta", "Red Violet", "Mahogany", "Burnt Sienna", "Chestnut"}},
+		"comments": {MaxLength: 1023},
+		"device":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":   {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":     {Required: true, MaxLength: 127},
+		"snippet":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the tags schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o Tags) Validate() error {
+	return tagsSchema.Validate(o)
+}
@@ -0,0 +1,745 @@
+// Code generated by pagergen. DO NOT EDIT.
+
+package security_services
+
+import (
+	"context"
+	"iter"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// All returns an iterator over every AntiSpywareProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAntiSpywareProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAntiSpywareProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[AntiSpywareProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AntiSpywareProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAntiSpywareProfilesAll returns an iterator over every AntiSpywareProfiles object.
+//
+// It is a shorthand for ListAntiSpywareProfiles(ctx).All(opts...), see ApiListAntiSpywareProfilesRequest.All.
+func (a *AntiSpywareProfilesAPIService) ListAntiSpywareProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AntiSpywareProfiles, error] {
+	return a.ListAntiSpywareProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every AntiSpywareSignatures object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListAntiSpywareSignatures(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListAntiSpywareSignaturesRequest) All(opts ...api.PageOptions) iter.Seq2[AntiSpywareSignatures, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AntiSpywareSignatures, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListAntiSpywareSignaturesAll returns an iterator over every AntiSpywareSignatures object.
+//
+// It is a shorthand for ListAntiSpywareSignatures(ctx).All(opts...), see ApiListAntiSpywareSignaturesRequest.All.
+func (a *AntiSpywareSignaturesAPIService) ListAntiSpywareSignaturesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AntiSpywareSignatures, error] {
+	return a.ListAntiSpywareSignatures(ctx).All(opts...)
+}
+
+// All returns an iterator over every AppOverrideRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListApplicationOverrideRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListApplicationOverrideRulesRequest) All(opts ...api.PageOptions) iter.Seq2[AppOverrideRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]AppOverrideRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListApplicationOverrideRulesAll returns an iterator over every AppOverrideRules object.
+//
+// It is a shorthand for ListApplicationOverrideRules(ctx).All(opts...), see ApiListApplicationOverrideRulesRequest.All.
+func (a *ApplicationOverrideRulesAPIService) ListApplicationOverrideRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[AppOverrideRules, error] {
+	return a.ListApplicationOverrideRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every DnsSecurityProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDNSSecurityProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDNSSecurityProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[DnsSecurityProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DnsSecurityProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDNSSecurityProfilesAll returns an iterator over every DnsSecurityProfiles object.
+//
+// It is a shorthand for ListDNSSecurityProfiles(ctx).All(opts...), see ApiListDNSSecurityProfilesRequest.All.
+func (a *DNSSecurityProfilesAPIService) ListDNSSecurityProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DnsSecurityProfiles, error] {
+	return a.ListDNSSecurityProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every DataFilteringProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDataFilteringProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDataFilteringProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[DataFilteringProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DataFilteringProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDataFilteringProfilesAll returns an iterator over every DataFilteringProfiles object.
+//
+// It is a shorthand for ListDataFilteringProfiles(ctx).All(opts...), see ApiListDataFilteringProfilesRequest.All.
+func (a *DataFilteringAPIService) ListDataFilteringProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DataFilteringProfiles, error] {
+	return a.ListDataFilteringProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every DataObjects object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDataObjects(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDataObjectsRequest) All(opts ...api.PageOptions) iter.Seq2[DataObjects, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DataObjects, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDataObjectsAll returns an iterator over every DataObjects object.
+//
+// It is a shorthand for ListDataObjects(ctx).All(opts...), see ApiListDataObjectsRequest.All.
+func (a *DataObjectsAPIService) ListDataObjectsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DataObjects, error] {
+	return a.ListDataObjects(ctx).All(opts...)
+}
+
+// All returns an iterator over every DecryptionExclusions object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDecryptionExclusions(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDecryptionExclusionsRequest) All(opts ...api.PageOptions) iter.Seq2[DecryptionExclusions, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DecryptionExclusions, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDecryptionExclusionsAll returns an iterator over every DecryptionExclusions object.
+//
+// It is a shorthand for ListDecryptionExclusions(ctx).All(opts...), see ApiListDecryptionExclusionsRequest.All.
+func (a *DecryptionExclusionsAPIService) ListDecryptionExclusionsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DecryptionExclusions, error] {
+	return a.ListDecryptionExclusions(ctx).All(opts...)
+}
+
+// All returns an iterator over every DecryptionProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDecryptionProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDecryptionProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[DecryptionProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DecryptionProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDecryptionProfilesAll returns an iterator over every DecryptionProfiles object.
+//
+// It is a shorthand for ListDecryptionProfiles(ctx).All(opts...), see ApiListDecryptionProfilesRequest.All.
+func (a *DecryptionProfilesAPIService) ListDecryptionProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DecryptionProfiles, error] {
+	return a.ListDecryptionProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every DecryptionRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDecryptionRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDecryptionRulesRequest) All(opts ...api.PageOptions) iter.Seq2[DecryptionRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DecryptionRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDecryptionRulesAll returns an iterator over every DecryptionRules object.
+//
+// It is a shorthand for ListDecryptionRules(ctx).All(opts...), see ApiListDecryptionRulesRequest.All.
+func (a *DecryptionRulesAPIService) ListDecryptionRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DecryptionRules, error] {
+	return a.ListDecryptionRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every DosProtectionProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDoSProtectionProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDoSProtectionProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[DosProtectionProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DosProtectionProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDoSProtectionProfilesAll returns an iterator over every DosProtectionProfiles object.
+//
+// It is a shorthand for ListDoSProtectionProfiles(ctx).All(opts...), see ApiListDoSProtectionProfilesRequest.All.
+func (a *DoSProtectionProfilesAPIService) ListDoSProtectionProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DosProtectionProfiles, error] {
+	return a.ListDoSProtectionProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every DosProtectionRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListDoSProtectionRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListDoSProtectionRulesRequest) All(opts ...api.PageOptions) iter.Seq2[DosProtectionRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]DosProtectionRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListDoSProtectionRulesAll returns an iterator over every DosProtectionRules object.
+//
+// It is a shorthand for ListDoSProtectionRules(ctx).All(opts...), see ApiListDoSProtectionRulesRequest.All.
+func (a *DoSProtectionRulesAPIService) ListDoSProtectionRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[DosProtectionRules, error] {
+	return a.ListDoSProtectionRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every FileBlockingProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListFileBlockingProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListFileBlockingProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[FileBlockingProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]FileBlockingProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListFileBlockingProfilesAll returns an iterator over every FileBlockingProfiles object.
+//
+// It is a shorthand for ListFileBlockingProfiles(ctx).All(opts...), see ApiListFileBlockingProfilesRequest.All.
+func (a *FileBlockingProfilesAPIService) ListFileBlockingProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[FileBlockingProfiles, error] {
+	return a.ListFileBlockingProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every HttpHeaderProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListHTTPHeaderProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListHTTPHeaderProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[HttpHeaderProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]HttpHeaderProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListHTTPHeaderProfilesAll returns an iterator over every HttpHeaderProfiles object.
+//
+// It is a shorthand for ListHTTPHeaderProfiles(ctx).All(opts...), see ApiListHTTPHeaderProfilesRequest.All.
+func (a *HTTPHeaderProfilesAPIService) ListHTTPHeaderProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[HttpHeaderProfiles, error] {
+	return a.ListHTTPHeaderProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every ProfileGroups object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListProfileGroups(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListProfileGroupsRequest) All(opts ...api.PageOptions) iter.Seq2[ProfileGroups, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]ProfileGroups, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListProfileGroupsAll returns an iterator over every ProfileGroups object.
+//
+// It is a shorthand for ListProfileGroups(ctx).All(opts...), see ApiListProfileGroupsRequest.All.
+func (a *ProfileGroupsAPIService) ListProfileGroupsAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[ProfileGroups, error] {
+	return a.ListProfileGroups(ctx).All(opts...)
+}
+
+// All returns an iterator over every SecurityRules object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListRules(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListRulesRequest) All(opts ...api.PageOptions) iter.Seq2[SecurityRules, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]SecurityRules, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListRulesAll returns an iterator over every SecurityRules object.
+//
+// It is a shorthand for ListRules(ctx).All(opts...), see ApiListRulesRequest.All.
+func (a *SecurityRulesAPIService) ListRulesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[SecurityRules, error] {
+	return a.ListRules(ctx).All(opts...)
+}
+
+// All returns an iterator over every UrlAccessProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListURLAccessProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListURLAccessProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[UrlAccessProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]UrlAccessProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListURLAccessProfilesAll returns an iterator over every UrlAccessProfiles object.
+//
+// It is a shorthand for ListURLAccessProfiles(ctx).All(opts...), see ApiListURLAccessProfilesRequest.All.
+func (a *URLAccessProfilesAPIService) ListURLAccessProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[UrlAccessProfiles, error] {
+	return a.ListURLAccessProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every UrlCategories object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListURLCategories(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListURLCategoriesRequest) All(opts ...api.PageOptions) iter.Seq2[UrlCategories, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]UrlCategories, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListURLCategoriesAll returns an iterator over every UrlCategories object.
+//
+// It is a shorthand for ListURLCategories(ctx).All(opts...), see ApiListURLCategoriesRequest.All.
+func (a *URLCategoriesAPIService) ListURLCategoriesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[UrlCategories, error] {
+	return a.ListURLCategories(ctx).All(opts...)
+}
+
+// All returns an iterator over every UrlFilteringCategories object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListURLFilteringCategories(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListURLFilteringCategoriesRequest) All(opts ...api.PageOptions) iter.Seq2[UrlFilteringCategories, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]UrlFilteringCategories, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListURLFilteringCategoriesAll returns an iterator over every UrlFilteringCategories object.
+//
+// It is a shorthand for ListURLFilteringCategories(ctx).All(opts...), see ApiListURLFilteringCategoriesRequest.All.
+func (a *URLFilteringCategoriesAPIService) ListURLFilteringCategoriesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[UrlFilteringCategories, error] {
+	return a.ListURLFilteringCategories(ctx).All(opts...)
+}
+
+// All returns an iterator over every VulnerabilityProtectionProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListVulnerabilityProtectionProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListVulnerabilityProtectionProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[VulnerabilityProtectionProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]VulnerabilityProtectionProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListVulnerabilityProtectionProfilesAll returns an iterator over every VulnerabilityProtectionProfiles object.
+//
+// It is a shorthand for ListVulnerabilityProtectionProfiles(ctx).All(opts...), see ApiListVulnerabilityProtectionProfilesRequest.All.
+func (a *VulnerabilityProtectionProfilesAPIService) ListVulnerabilityProtectionProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[VulnerabilityProtectionProfiles, error] {
+	return a.ListVulnerabilityProtectionProfiles(ctx).All(opts...)
+}
+
+// All returns an iterator over every VulnerabilityProtectionSignatures object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListVulnerabilityProtectionSignatures(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListVulnerabilityProtectionSignaturesRequest) All(opts ...api.PageOptions) iter.Seq2[VulnerabilityProtectionSignatures, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]VulnerabilityProtectionSignatures, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListVulnerabilityProtectionSignaturesAll returns an iterator over every VulnerabilityProtectionSignatures object.
+//
+// It is a shorthand for ListVulnerabilityProtectionSignatures(ctx).All(opts...), see ApiListVulnerabilityProtectionSignaturesRequest.All.
+func (a *VulnerabilityProtectionSignaturesAPIService) ListVulnerabilityProtectionSignaturesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[VulnerabilityProtectionSignatures, error] {
+	return a.ListVulnerabilityProtectionSignatures(ctx).All(opts...)
+}
+
+// All returns an iterator over every WildfireAntiVirusProfiles object matched by the request.
+//
+// Pages are fetched lazily using the filters set on the request, so there is
+// no need to manage Offset and Limit by hand. The page size and the number of
+// pages fetched in parallel can be set with the optional api.PageOptions.
+// Iteration stops at the total reported by the API, on the first error, or
+// when the request context is canceled.
+//
+// Example:
+//
+//	for obj, err := range api.ListWildFireAntiVirusProfiles(ctx).All() {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Printf("Found object\n")
+//	}
+func (r ApiListWildFireAntiVirusProfilesRequest) All(opts ...api.PageOptions) iter.Seq2[WildfireAntiVirusProfiles, error] {
+	return api.Paginate(r.ctx, func(ctx context.Context, offset, limit int32) ([]WildfireAntiVirusProfiles, int32, error) {
+		req := r
+		req.ctx = ctx
+		response, _, err := req.Offset(offset).Limit(limit).Execute()
+		if err != nil || response == nil {
+			return nil, 0, err
+		}
+		return response.Data, response.Total, nil
+	}, opts...)
+}
+
+// ListWildFireAntiVirusProfilesAll returns an iterator over every WildfireAntiVirusProfiles object.
+//
+// It is a shorthand for ListWildFireAntiVirusProfiles(ctx).All(opts...), see ApiListWildFireAntiVirusProfilesRequest.All.
+func (a *WildFireAntiVirusProfilesAPIService) ListWildFireAntiVirusProfilesAll(ctx context.Context, opts ...api.PageOptions) iter.Seq2[WildfireAntiVirusProfiles, error] {
+	return a.ListWildFireAntiVirusProfiles(ctx).All(opts...)
+}
@@ -0,0 +1,2107 @@
+// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.
+
+package security_services
+
+import "github.com/paloaltonetworks/scm-go/validate"
+
+var antiSpywareProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AntiSpywareProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesListResponse) Validate() error {
+	return antiSpywareProfilesListResponseSchema.Validate(o)
+}
+
+var antiSpywareSignaturesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the AntiSpywareSignaturesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesListResponse) Validate() error {
+	return antiSpywareSignaturesListResponseSchema.Validate(o)
+}
+
+var applicationOverrideRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ApplicationOverrideRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ApplicationOverrideRulesListResponse) Validate() error {
+	return applicationOverrideRulesListResponseSchema.Validate(o)
+}
+
+var dNSSecurityProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DNSSecurityProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DNSSecurityProfilesListResponse) Validate() error {
+	return dNSSecurityProfilesListResponseSchema.Validate(o)
+}
+
+var dataFilteringProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DataFilteringProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataFilteringProfilesListResponse) Validate() error {
+	return dataFilteringProfilesListResponseSchema.Validate(o)
+}
+
+var dataObjectsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DataObjectsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsListResponse) Validate() error {
+	return dataObjectsListResponseSchema.Validate(o)
+}
+
+var decryptionExclusionsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DecryptionExclusionsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionExclusionsListResponse) Validate() error {
+	return decryptionExclusionsListResponseSchema.Validate(o)
+}
+
+var decryptionProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DecryptionProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionProfilesListResponse) Validate() error {
+	return decryptionProfilesListResponseSchema.Validate(o)
+}
+
+var decryptionRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DecryptionRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionRulesListResponse) Validate() error {
+	return decryptionRulesListResponseSchema.Validate(o)
+}
+
+var doSProtectionProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DoSProtectionProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DoSProtectionProfilesListResponse) Validate() error {
+	return doSProtectionProfilesListResponseSchema.Validate(o)
+}
+
+var doSProtectionRulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the DoSProtectionRulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DoSProtectionRulesListResponse) Validate() error {
+	return doSProtectionRulesListResponseSchema.Validate(o)
+}
+
+var fileBlockingProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the FileBlockingProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o FileBlockingProfilesListResponse) Validate() error {
+	return fileBlockingProfilesListResponseSchema.Validate(o)
+}
+
+var getSaasTenantRestrictionsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the GetSaasTenantRestrictionsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GetSaasTenantRestrictionsListResponse) Validate() error {
+	return getSaasTenantRestrictionsListResponseSchema.Validate(o)
+}
+
+var hTTPHeaderProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the HTTPHeaderProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HTTPHeaderProfilesListResponse) Validate() error {
+	return hTTPHeaderProfilesListResponseSchema.Validate(o)
+}
+
+var profileGroupsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the ProfileGroupsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ProfileGroupsListResponse) Validate() error {
+	return profileGroupsListResponseSchema.Validate(o)
+}
+
+var rulesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the RulesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RulesListResponse) Validate() error {
+	return rulesListResponseSchema.Validate(o)
+}
+
+var securityRuleListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the SecurityRuleListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SecurityRuleListResponse) Validate() error {
+	return securityRuleListResponseSchema.Validate(o)
+}
+
+var uRLAccessProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the URLAccessProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o URLAccessProfilesListResponse) Validate() error {
+	return uRLAccessProfilesListResponseSchema.Validate(o)
+}
+
+var uRLCategoriesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the URLCategoriesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o URLCategoriesListResponse) Validate() error {
+	return uRLCategoriesListResponseSchema.Validate(o)
+}
+
+var uRLFilteringCategoriesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the URLFilteringCategoriesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o URLFilteringCategoriesListResponse) Validate() error {
+	return uRLFilteringCategoriesListResponseSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the VulnerabilityProtectionProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesListResponse) Validate() error {
+	return vulnerabilityProtectionProfilesListResponseSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the VulnerabilityProtectionSignaturesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesListResponse) Validate() error {
+	return vulnerabilityProtectionSignaturesListResponseSchema.Validate(o)
+}
+
+var wildFireAntiVirusProfilesListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the WildFireAntiVirusProfilesListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o WildFireAntiVirusProfilesListResponse) Validate() error {
+	return wildFireAntiVirusProfilesListResponseSchema.Validate(o)
+}
+
+var antiSpywareProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti-spyware-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfiles) Validate() error {
+	return antiSpywareProfilesSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"comment":    {MaxLength: 256},
+		"device":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"direction":  {Enum: []string{"client2server", "server2client", "both"}},
+		"folder":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"severity":   {Enum: []string{"critical", "low", "high", "medium", "informational"}},
+		"snippet":    {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"threat_id":  {Required: true},
+		"threatname": {Required: true, MaxLength: 1024},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti-spyware-signatures schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignatures) Validate() error {
+	return antiSpywareSignaturesSchema.Validate(o)
+}
+
+var antiSpywareProfilesMicaEngineSpywareEnabledInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"inline_policy_action": {Enum: []string{"alert", "allow", "drop", "reset-both", "reset-client", "reset-server"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_mica_engine_spyware_enabled_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesMicaEngineSpywareEnabledInner) Validate() error {
+	return antiSpywareProfilesMicaEngineSpywareEnabledInnerSchema.Validate(o)
+}
+
+var antiSpywareProfilesRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"category":       {Enum: []string{"adns-adtracking", "adns-benign", "adns-c2", "adns-ddns", "adns-dnsmisconfig", "adns-grayware", "adns-hijacking", "adns-malware", "adns-new-domain", "adns-parked", "adns-phishing", "adns-proxy", "adware", "any", "autogen", "backdoor", "botnet", "browser-hijack", "command-and-control", "cryptominer", "data-theft", "dns", "dns-adtracking", "dns-benign", "dns-c2", "dns-ddns", "dns-grayware", "dns-malware", "dns-new-domain", "dns-parked", "dns-phishing", "dns-proxy", "dns-security", "dns-wildfire", "domain-edl", "downloader", "fraud", "hacktool", "inline-cloud-c2", "keylogger", "net-worm", "p2p-communication", "phishing-kit", "post-exploitation", "spyware", "tls-fingerprint", "webshell"}},
+		"packet_capture": {Enum: []string{"disable", "single-packet", "extended-capture"}},
+		"threat_name":    {MinLength: 3},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesRulesInner) Validate() error {
+	return antiSpywareProfilesRulesInnerSchema.Validate(o)
+}
+
+var antiSpywareProfilesRulesInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_rules_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesRulesInnerAction) Validate() error {
+	return antiSpywareProfilesRulesInnerActionSchema.Validate(o)
+}
+
+var antiSpywareProfilesRulesInnerActionBlockIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"track_by": {Enum: []string{"source-and-destination", "source"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_rules_inner_action_block_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesRulesInnerActionBlockIp) Validate() error {
+	return antiSpywareProfilesRulesInnerActionBlockIpSchema.Validate(o)
+}
+
+var antiSpywareProfilesThreatExceptionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"packet_capture": {Enum: []string{"disable", "single-packet", "extended-capture"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_threat_exception_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesThreatExceptionInner) Validate() error {
+	return antiSpywareProfilesThreatExceptionInnerSchema.Validate(o)
+}
+
+var antiSpywareProfilesThreatExceptionInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_threat_exception_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesThreatExceptionInnerAction) Validate() error {
+	return antiSpywareProfilesThreatExceptionInnerActionSchema.Validate(o)
+}
+
+var antiSpywareProfilesThreatExceptionInnerActionBlockIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"track_by": {Enum: []string{"source-and-destination", "source"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_threat_exception_inner_action_block_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesThreatExceptionInnerActionBlockIp) Validate() error {
+	return antiSpywareProfilesThreatExceptionInnerActionBlockIpSchema.Validate(o)
+}
+
+var antiSpywareProfilesThreatExceptionInnerExemptIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_profiles_threat_exception_inner_exempt_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareProfilesThreatExceptionInnerExemptIpInner) Validate() error {
+	return antiSpywareProfilesThreatExceptionInnerExemptIpInnerSchema.Validate(o)
+}
+
+var antiSpywareSignaturesDefaultActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_default_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesDefaultAction) Validate() error {
+	return antiSpywareSignaturesDefaultActionSchema.Validate(o)
+}
+
+var antiSpywareSignaturesDefaultActionBlockIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"track_by": {Enum: []string{"source-and-destination", "source"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_default_action_block_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesDefaultActionBlockIp) Validate() error {
+	return antiSpywareSignaturesDefaultActionBlockIpSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignature) Validate() error {
+	return antiSpywareSignaturesSignatureSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureCombinationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_combination schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureCombination) Validate() error {
+	return antiSpywareSignaturesSignatureCombinationSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureCombinationAndConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_combination_and_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureCombinationAndConditionInner) Validate() error {
+	return antiSpywareSignaturesSignatureCombinationAndConditionInnerSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureCombinationAndConditionInnerOrConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_combination_and_condition_inner_or_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureCombinationAndConditionInnerOrConditionInner) Validate() error {
+	return antiSpywareSignaturesSignatureCombinationAndConditionInnerOrConditionInnerSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureCombinationTimeAttributeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interval":  {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"threshold": {Minimum: validate.Bound(1), Maximum: validate.Bound(255)},
+		"track_by":  {Enum: []string{"source-and-destination", "source", "destination"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_combination_time_attribute schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureCombinationTimeAttribute) Validate() error {
+	return antiSpywareSignaturesSignatureCombinationTimeAttributeSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"comment": {MaxLength: 256},
+		"name":    {Required: true},
+		"scope":   {Enum: []string{"protocol-data-unit", "session"}},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInner) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerAndConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner_and_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInnerAndConditionInner) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerAndConditionInnerSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner_and_condition_inner_or_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInner) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperator) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"value": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_equal_to schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualTo) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToQualifierInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_equal_to_qualifier_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToQualifierInner) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToQualifierInnerSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThanSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"value": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_greater_than schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThan) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThanSchema.Validate(o)
+}
+
+var antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the anti_spyware_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_pattern_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AntiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatch) Validate() error {
+	return antiSpywareSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatchSchema.Validate(o)
+}
+
+var appOverrideRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 1024},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, Pattern: `^[a-zA-Z0-9._-]+$`, MaxLength: 63},
+		"port":        {Minimum: validate.Bound(0), Maximum: validate.Bound(65535)},
+		"protocol":    {Enum: []string{"tcp", "udp"}},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the app-override-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o AppOverrideRules) Validate() error {
+	return appOverrideRulesSchema.Validate(o)
+}
+
+var baseRulePropertiesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"allow", "deny", "drop", "reset-client", "reset-server", "reset-both"}},
+		"name":   {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the base-rule-properties schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o BaseRuleProperties) Validate() error {
+	return baseRulePropertiesSchema.Validate(o)
+}
+
+var dataFilteringProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the data-filtering-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataFilteringProfiles) Validate() error {
+	return dataFilteringProfilesSchema.Validate(o)
+}
+
+var dataObjectsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the data-objects schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjects) Validate() error {
+	return dataObjectsSchema.Validate(o)
+}
+
+var dataFilteringProfilesRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_filtering_profiles_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataFilteringProfilesRulesInner) Validate() error {
+	return dataFilteringProfilesRulesInnerSchema.Validate(o)
+}
+
+var dataObjectsPatternTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_objects_pattern_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsPatternType) Validate() error {
+	return dataObjectsPatternTypeSchema.Validate(o)
+}
+
+var dataObjectsPatternTypeFilePropertiesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_objects_pattern_type_file_properties schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsPatternTypeFileProperties) Validate() error {
+	return dataObjectsPatternTypeFilePropertiesSchema.Validate(o)
+}
+
+var dataObjectsPatternTypeFilePropertiesPatternInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_objects_pattern_type_file_properties_pattern_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsPatternTypeFilePropertiesPatternInner) Validate() error {
+	return dataObjectsPatternTypeFilePropertiesPatternInnerSchema.Validate(o)
+}
+
+var dataObjectsPatternTypePredefinedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_objects_pattern_type_predefined schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsPatternTypePredefined) Validate() error {
+	return dataObjectsPatternTypePredefinedSchema.Validate(o)
+}
+
+var dataObjectsPatternTypePredefinedPatternInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_objects_pattern_type_predefined_pattern_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsPatternTypePredefinedPatternInner) Validate() error {
+	return dataObjectsPatternTypePredefinedPatternInnerSchema.Validate(o)
+}
+
+var dataObjectsPatternTypeRegexSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_objects_pattern_type_regex schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsPatternTypeRegex) Validate() error {
+	return dataObjectsPatternTypeRegexSchema.Validate(o)
+}
+
+var dataObjectsPatternTypeRegexPatternInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the data_objects_pattern_type_regex_pattern_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DataObjectsPatternTypeRegexPatternInner) Validate() error {
+	return dataObjectsPatternTypeRegexPatternInnerSchema.Validate(o)
+}
+
+var decryptionExclusionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the decryption-exclusions schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionExclusions) Validate() error {
+	return decryptionExclusionsSchema.Validate(o)
+}
+
+var decryptionProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, Pattern: `^[A-Za-z0-9]{1}[A-Za-z0-9_\-\.\s]{0,}$`},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the decryption-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionProfiles) Validate() error {
+	return decryptionProfilesSchema.Validate(o)
+}
+
+var decryptionRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":      {Required: true, Enum: []string{"decrypt", "no-decrypt"}},
+		"category":    {Required: true},
+		"destination": {Required: true},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"from":        {Required: true},
+		"name":        {Required: true},
+		"service":     {Required: true},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"source":      {Required: true},
+		"source_user": {Required: true},
+		"to":          {Required: true},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the decryption-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionRules) Validate() error {
+	return decryptionRulesSchema.Validate(o)
+}
+
+var decryptionProfilesSslForwardProxySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the decryption_profiles_ssl_forward_proxy schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionProfilesSslForwardProxy) Validate() error {
+	return decryptionProfilesSslForwardProxySchema.Validate(o)
+}
+
+var decryptionProfilesSslInboundProxySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the decryption_profiles_ssl_inbound_proxy schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionProfilesSslInboundProxy) Validate() error {
+	return decryptionProfilesSslInboundProxySchema.Validate(o)
+}
+
+var decryptionProfilesSslNoProxySchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the decryption_profiles_ssl_no_proxy schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionProfilesSslNoProxy) Validate() error {
+	return decryptionProfilesSslNoProxySchema.Validate(o)
+}
+
+var decryptionProfilesSslProtocolSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"max_version": {Enum: []string{"sslv3", "tls1-0", "tls1-1", "tls1-2", "tls1-3", "max"}},
+		"min_version": {Enum: []string{"sslv3", "tls1-0", "tls1-1", "tls1-2", "tls1-3"}},
+	},
+}
+
+// Validate checks o against the constraints of the decryption_profiles_ssl_protocol_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionProfilesSslProtocolSettings) Validate() error {
+	return decryptionProfilesSslProtocolSettingsSchema.Validate(o)
+}
+
+var decryptionRulesTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the decryption_rules_type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionRulesType) Validate() error {
+	return decryptionRulesTypeSchema.Validate(o)
+}
+
+var decryptionRulesTypeSslInboundInspectionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the decryption_rules_type_ssl_inbound_inspection schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DecryptionRulesTypeSslInboundInspection) Validate() error {
+	return decryptionRulesTypeSslInboundInspectionSchema.Validate(o)
+}
+
+var dnsSecurityProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the dns-security-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsSecurityProfiles) Validate() error {
+	return dnsSecurityProfilesSchema.Validate(o)
+}
+
+var dnsSecurityProfilesBotnetDomainsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dns_security_profiles_botnet_domains schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsSecurityProfilesBotnetDomains) Validate() error {
+	return dnsSecurityProfilesBotnetDomainsSchema.Validate(o)
+}
+
+var dnsSecurityProfilesBotnetDomainsDnsSecurityCategoriesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":         {Enum: []string{"default", "allow", "block", "sinkhole"}},
+		"log_level":      {Enum: []string{"default", "none", "low", "informational", "medium", "high", "critical"}},
+		"packet_capture": {Enum: []string{"disable", "single-packet", "extended-capture"}},
+	},
+}
+
+// Validate checks o against the constraints of the dns_security_profiles_botnet_domains_dns_security_categories_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsSecurityProfilesBotnetDomainsDnsSecurityCategoriesInner) Validate() error {
+	return dnsSecurityProfilesBotnetDomainsDnsSecurityCategoriesInnerSchema.Validate(o)
+}
+
+var dnsSecurityProfilesBotnetDomainsListsInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name":           {Required: true},
+		"packet_capture": {Enum: []string{"disable", "single-packet", "extended-capture"}},
+	},
+}
+
+// Validate checks o against the constraints of the dns_security_profiles_botnet_domains_lists_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsSecurityProfilesBotnetDomainsListsInner) Validate() error {
+	return dnsSecurityProfilesBotnetDomainsListsInnerSchema.Validate(o)
+}
+
+var dnsSecurityProfilesBotnetDomainsListsInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dns_security_profiles_botnet_domains_lists_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsSecurityProfilesBotnetDomainsListsInnerAction) Validate() error {
+	return dnsSecurityProfilesBotnetDomainsListsInnerActionSchema.Validate(o)
+}
+
+var dnsSecurityProfilesBotnetDomainsSinkholeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"ipv4_address": {Enum: []string{"127.0.0.1", "pan-sinkhole-default-ip"}},
+		"ipv6_address": {Enum: []string{"::1"}},
+	},
+}
+
+// Validate checks o against the constraints of the dns_security_profiles_botnet_domains_sinkhole schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsSecurityProfilesBotnetDomainsSinkhole) Validate() error {
+	return dnsSecurityProfilesBotnetDomainsSinkholeSchema.Validate(o)
+}
+
+var dnsSecurityProfilesBotnetDomainsWhitelistInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dns_security_profiles_botnet_domains_whitelist_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DnsSecurityProfilesBotnetDomainsWhitelistInner) Validate() error {
+	return dnsSecurityProfilesBotnetDomainsWhitelistInnerSchema.Validate(o)
+}
+
+var dosProtectionProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 255},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, MaxLength: 31},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"type":        {Required: true, Enum: []string{"aggregate", "classified"}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the dos-protection-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfiles) Validate() error {
+	return dosProtectionProfilesSchema.Validate(o)
+}
+
+var dosProtectionRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 255},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true, MaxLength: 31},
+		"position":    {Enum: []string{"pre", "post"}},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the dos-protection-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionRules) Validate() error {
+	return dosProtectionRulesSchema.Validate(o)
+}
+
+var dosProtectionProfilesFloodSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_flood schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesFlood) Validate() error {
+	return dosProtectionProfilesFloodSchema.Validate(o)
+}
+
+var dosProtectionProfilesFloodIcmpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_flood_icmp schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesFloodIcmp) Validate() error {
+	return dosProtectionProfilesFloodIcmpSchema.Validate(o)
+}
+
+var dosProtectionProfilesFloodIcmpRedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate-rate": {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(2e+06)},
+		"alarm-rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal-rate":  {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_flood_icmp_red schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesFloodIcmpRed) Validate() error {
+	return dosProtectionProfilesFloodIcmpRedSchema.Validate(o)
+}
+
+var dosProtectionProfilesFloodIcmpRedBlockSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(21600)},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_flood_icmp_red_block schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesFloodIcmpRedBlock) Validate() error {
+	return dosProtectionProfilesFloodIcmpRedBlockSchema.Validate(o)
+}
+
+var dosProtectionProfilesFloodTcpSynSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enable": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_flood_tcp_syn schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesFloodTcpSyn) Validate() error {
+	return dosProtectionProfilesFloodTcpSynSchema.Validate(o)
+}
+
+var dosProtectionProfilesFloodTcpSynSynCookiesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"activate-rate": {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"alarm-rate":    {Required: true, Minimum: validate.Bound(0), Maximum: validate.Bound(2e+06)},
+		"maximal-rate":  {Required: true, Minimum: validate.Bound(1), Maximum: validate.Bound(2e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_flood_tcp_syn_syn_cookies schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesFloodTcpSynSynCookies) Validate() error {
+	return dosProtectionProfilesFloodTcpSynSynCookiesSchema.Validate(o)
+}
+
+var dosProtectionProfilesFloodTcpSynSynCookiesBlockSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(21600)},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_flood_tcp_syn_syn_cookies_block schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesFloodTcpSynSynCookiesBlock) Validate() error {
+	return dosProtectionProfilesFloodTcpSynSynCookiesBlockSchema.Validate(o)
+}
+
+var dosProtectionProfilesResourceSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_resource schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesResource) Validate() error {
+	return dosProtectionProfilesResourceSchema.Validate(o)
+}
+
+var dosProtectionProfilesResourceSessionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"max-concurrent-limit": {Minimum: validate.Bound(1), Maximum: validate.Bound(4.194304e+06)},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_profiles_resource_sessions schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionProfilesResourceSessions) Validate() error {
+	return dosProtectionProfilesResourceSessionsSchema.Validate(o)
+}
+
+var dosProtectionRulesActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dos_protection_rules_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionRulesAction) Validate() error {
+	return dosProtectionRulesActionSchema.Validate(o)
+}
+
+var dosProtectionRulesProtectionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the dos_protection_rules_protection schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionRulesProtection) Validate() error {
+	return dosProtectionRulesProtectionSchema.Validate(o)
+}
+
+var dosProtectionRulesProtectionAggregateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"profile": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_rules_protection_aggregate schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionRulesProtectionAggregate) Validate() error {
+	return dosProtectionRulesProtectionAggregateSchema.Validate(o)
+}
+
+var dosProtectionRulesProtectionClassifiedSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"classification-criteria": {Required: true},
+		"profile":                 {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_rules_protection_classified schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionRulesProtectionClassified) Validate() error {
+	return dosProtectionRulesProtectionClassifiedSchema.Validate(o)
+}
+
+var dosProtectionRulesProtectionClassifiedClassificationCriteriaSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"address": {Required: true, Enum: []string{"source-ip-only", "destination-ip-only", "src-dest-ip-both"}},
+	},
+}
+
+// Validate checks o against the constraints of the dos_protection_rules_protection_classified_classification_criteria schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o DosProtectionRulesProtectionClassifiedClassificationCriteria) Validate() error {
+	return dosProtectionRulesProtectionClassifiedClassificationCriteriaSchema.Validate(o)
+}
+
+var errorDetailCauseInfoSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the error_detail_cause_info schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ErrorDetailCauseInfo) Validate() error {
+	return errorDetailCauseInfoSchema.Validate(o)
+}
+
+var fileBlockingProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the file-blocking-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o FileBlockingProfiles) Validate() error {
+	return fileBlockingProfilesSchema.Validate(o)
+}
+
+var fileBlockingProfilesRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":      {Required: true, Enum: []string{"alert", "block", "continue"}},
+		"application": {Required: true, MinItems: 1, Items: &validate.Rule{Enum: []string{"any", "axifile", "dl-free", "facebook-mail", "file.io", "giphy-base", "glassdoor-uploading", "http-proxy", "redbooth", "send-anywhere", "zoho-mail"}}},
+		"direction":   {Required: true, Enum: []string{"download", "upload", "both"}},
+		"file_type":   {Required: true, MinItems: 1, Items: &validate.Rule{Enum: []string{"7z", "Multi-Level-Encoding", "PE", "access-shortcut", "ace", "ade", "adp", "ai", "aip-encrypted-docx", "aip-encrypted-pptx", "aip-encrypted-xlsx", "any", "apk", "arj", "asp", "avi", "avi-divx", "avi-xvid", "bas", "bat", "bmp", "bmp-upload", "bzip2", "cab", "catpart", "cdr", "chm", "cin", "class", "cmd", "com", "cpl", "csv", "deflate64-zip", "der", "dll", "dmg", "doc", "docm", "docx", "dpx", "dsn", "dwf", "dwg", "dxf", "edif", "elf", "emf", "encrypted-7z", "encrypted-doc", "encrypted-docx", "encrypted-office2007", "encrypted-pdf", "encrypted-ppt", "encrypted-pptx", "encrypted-rar", "encrypted-xls", "encrypted-xlsx", "encrypted-zip", "eps", "exe", "exr", "flash", "flv", "gds", "gif", "gif-upload", "gzip", "hlp", "hta", "hwp", "hwpx", "ichitaro", "iff", "inf", "ins", "iqy", "iso", "its", "iwork-keynote", "iwork-numbers", "iwork-pages", "jar", "jpeg", "jpeg-upload", "js", "jse", "lnk", "lzh", "ma", "macapp", "mach-o", "mb", "mda", "mdb", "mdi", "mdt", "mdw", "mdz", "mht", "microsoft-shell", "mif", "mkv", "mov", "mp3", "mp4", "mpeg", "mpeg-ts", "mpkg", "msc", "msi", "msoffice", "msp", "ocx", "pbix", "pbm", "pcl", "pdf", "pem", "pgp", "pif", "pkg", "pl", "png", "png-upload", "powershell", "ppt", "pptx", "prg", "psd", "py", "rar", "reg", "renamed-zip", "rla", "rm", "rpf", "rtf", "scf", "scr", "sgi", "sh", "shk", "shs", "slk", "softimg", "split-cab", "split-rar", "stp", "svg", "sys", "tar", "tdb", "tif", "tiff", "tmp", "torrent", "url", "vb", "vbe", "vbs", "vxd", "webm", "wmf", "wmv", "wri", "wsf", "wsh", "xll", "xls", "xlsx", "xpm", "zcompressed", "zip"}}},
+		"name":        {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the file_blocking_profiles_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o FileBlockingProfilesRulesInner) Validate() error {
+	return fileBlockingProfilesRulesInnerSchema.Validate(o)
+}
+
+var genericErrorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the generic_error schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GenericError) Validate() error {
+	return genericErrorSchema.Validate(o)
+}
+
+var getSslDecryptionSettingsListResponseSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"data":   {Required: true},
+		"limit":  {Required: true},
+		"offset": {Required: true},
+		"total":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the getSslDecryptionSettingsListResponse schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o GetSslDecryptionSettingsListResponse) Validate() error {
+	return getSslDecryptionSettingsListResponseSchema.Validate(o)
+}
+
+var httpHeaderProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the http-header-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HttpHeaderProfiles) Validate() error {
+	return httpHeaderProfilesSchema.Validate(o)
+}
+
+var httpHeaderProfilesHttpHeaderInsertionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+		"type": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the http_header_profiles_http_header_insertion_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HttpHeaderProfilesHttpHeaderInsertionInner) Validate() error {
+	return httpHeaderProfilesHttpHeaderInsertionInnerSchema.Validate(o)
+}
+
+var httpHeaderProfilesHttpHeaderInsertionInnerTypeInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"domains": {Required: true},
+		"headers": {Required: true},
+		"name":    {Required: true, Enum: []string{"Custom", "Dropbox Network Control", "Dynamic Fields", "Google Apps Access Control", "Microsoft Office365 Tenant Restrictions", "Youtube Safe Search"}},
+	},
+}
+
+// Validate checks o against the constraints of the http_header_profiles_http_header_insertion_inner_type_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HttpHeaderProfilesHttpHeaderInsertionInnerTypeInner) Validate() error {
+	return httpHeaderProfilesHttpHeaderInsertionInnerTypeInnerSchema.Validate(o)
+}
+
+var httpHeaderProfilesHttpHeaderInsertionInnerTypeInnerHeadersInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"header": {Required: true},
+		"name":   {Required: true},
+		"value":  {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the http_header_profiles_http_header_insertion_inner_type_inner_headers_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o HttpHeaderProfilesHttpHeaderInsertionInnerTypeInnerHeadersInner) Validate() error {
+	return httpHeaderProfilesHttpHeaderInsertionInnerTypeInnerHeadersInnerSchema.Validate(o)
+}
+
+var internetRuleTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action": {Enum: []string{"allow", "deny", "drop", "reset-client", "reset-server", "reset-both"}},
+		"name":   {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the internet-rule-type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleType) Validate() error {
+	return internetRuleTypeSchema.Validate(o)
+}
+
+var internetRuleTypeAllowUrlCategoryInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"additional_action":      {Enum: []string{"none", "continue", "redirect", "isolate"}},
+		"credential_enforcement": {Enum: []string{"enabled", "disabled"}},
+		"decryption":             {Enum: []string{"enabled", "disabled"}},
+	},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_allow_url_category_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeAllowUrlCategoryInner) Validate() error {
+	return internetRuleTypeAllowUrlCategoryInnerSchema.Validate(o)
+}
+
+var internetRuleTypeAllowUrlCategoryInnerFileControlSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"download": {Enum: []string{"allow-all-file-types", "best-practice", "block-all-file-types"}},
+		"upload":   {Enum: []string{"allow-all-file-types", "best-practice", "block-all-file-types"}},
+	},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_allow_url_category_inner_file_control schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeAllowUrlCategoryInnerFileControl) Validate() error {
+	return internetRuleTypeAllowUrlCategoryInnerFileControlSchema.Validate(o)
+}
+
+var internetRuleTypeAllowWebApplicationInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_allow_web_application_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeAllowWebApplicationInner) Validate() error {
+	return internetRuleTypeAllowWebApplicationInnerSchema.Validate(o)
+}
+
+var internetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_allow_web_application_inner_saas_enterprise_control schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeAllowWebApplicationInnerSaasEnterpriseControl) Validate() error {
+	return internetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlSchema.Validate(o)
+}
+
+var internetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlConsumerAccessSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enable": {Enum: []string{"yes", "no"}},
+	},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_allow_web_application_inner_saas_enterprise_control_consumer_access schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlConsumerAccess) Validate() error {
+	return internetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlConsumerAccessSchema.Validate(o)
+}
+
+var internetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlEnterpriseAccessSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"enable": {Enum: []string{"yes", "no"}},
+	},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_allow_web_application_inner_saas_enterprise_control_enterprise_access schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlEnterpriseAccess) Validate() error {
+	return internetRuleTypeAllowWebApplicationInnerSaasEnterpriseControlEnterpriseAccessSchema.Validate(o)
+}
+
+var internetRuleTypeAllowWebApplicationInnerTenantControlSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_allow_web_application_inner_tenant_control schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeAllowWebApplicationInnerTenantControl) Validate() error {
+	return internetRuleTypeAllowWebApplicationInnerTenantControlSchema.Validate(o)
+}
+
+var internetRuleTypeDefaultProfileSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_default_profile_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeDefaultProfileSettings) Validate() error {
+	return internetRuleTypeDefaultProfileSettingsSchema.Validate(o)
+}
+
+var internetRuleTypeLogSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_log_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeLogSettings) Validate() error {
+	return internetRuleTypeLogSettingsSchema.Validate(o)
+}
+
+var internetRuleTypeSecuritySettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"anti_spyware":                {Enum: []string{"yes", "no"}},
+		"virus_and_wildfire_analysis": {Enum: []string{"yes", "no"}},
+		"vulnerability":               {Enum: []string{"yes", "no"}},
+	},
+}
+
+// Validate checks o against the constraints of the internet_rule_type_security_settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o InternetRuleTypeSecuritySettings) Validate() error {
+	return internetRuleTypeSecuritySettingsSchema.Validate(o)
+}
+
+var profileGroupsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the profile-groups schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o ProfileGroups) Validate() error {
+	return profileGroupsSchema.Validate(o)
+}
+
+var ruleBasedMoveSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"destination": {Required: true, Enum: []string{"top", "bottom", "before", "after"}},
+		"rulebase":    {Required: true, Enum: []string{"pre", "post"}},
+	},
+}
+
+// Validate checks o against the constraints of the rule-based-move schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o RuleBasedMove) Validate() error {
+	return ruleBasedMoveSchema.Validate(o)
+}
+
+var saasTenantRestrictionsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the saas-tenant-restrictions schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SaasTenantRestrictions) Validate() error {
+	return saasTenantRestrictionsSchema.Validate(o)
+}
+
+var saasTenantRestrictionsHeadersInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the saas_tenant_restrictions_headers_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SaasTenantRestrictionsHeadersInner) Validate() error {
+	return saasTenantRestrictionsHeadersInnerSchema.Validate(o)
+}
+
+var securityRuleTypeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":      {Required: true, Enum: []string{"allow", "deny", "drop", "reset-client", "reset-server", "reset-both"}},
+		"application": {Required: true},
+		"category":    {Required: true},
+		"destination": {Required: true},
+		"from":        {Required: true},
+		"name":        {Required: true},
+		"service":     {Required: true},
+		"source":      {Required: true},
+		"source_user": {Required: true},
+		"to":          {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the security-rule-type schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SecurityRuleType) Validate() error {
+	return securityRuleTypeSchema.Validate(o)
+}
+
+var securityRulesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"action":  {Enum: []string{"allow", "deny", "drop", "reset-client", "reset-server", "reset-both"}},
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the security-rules schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SecurityRules) Validate() error {
+	return securityRulesSchema.Validate(o)
+}
+
+var securityRuleTypeProfileSettingSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the security_rule_type_profile_setting schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SecurityRuleTypeProfileSetting) Validate() error {
+	return securityRuleTypeProfileSettingSchema.Validate(o)
+}
+
+var sslDecryptionSettingsSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ssl-decryption-settings schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SslDecryptionSettings) Validate() error {
+	return sslDecryptionSettingsSchema.Validate(o)
+}
+
+var sslDecryptionSettingsGetPutSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"ssl_decrypt": {Required: true},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the ssl-decryption-settings-get-put schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SslDecryptionSettingsGetPut) Validate() error {
+	return sslDecryptionSettingsGetPutSchema.Validate(o)
+}
+
+var sslDecryptionSettingsForwardTrustCertificateSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ssl_decryption_settings_forward_trust_certificate schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SslDecryptionSettingsForwardTrustCertificate) Validate() error {
+	return sslDecryptionSettingsForwardTrustCertificateSchema.Validate(o)
+}
+
+var sslDecryptionSettingsGetPutSslDecryptSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ssl_decryption_settings_get_put_ssl_decrypt schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SslDecryptionSettingsGetPutSslDecrypt) Validate() error {
+	return sslDecryptionSettingsGetPutSslDecryptSchema.Validate(o)
+}
+
+var sslDecryptionSettingsSslExcludeCertInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the ssl_decryption_settings_ssl_exclude_cert_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o SslDecryptionSettingsSslExcludeCertInner) Validate() error {
+	return sslDecryptionSettingsSslExcludeCertInnerSchema.Validate(o)
+}
+
+var urlAccessProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"description": {MaxLength: 255},
+		"device":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":      {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":        {Required: true},
+		"snippet":     {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the url-access-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UrlAccessProfiles) Validate() error {
+	return urlAccessProfilesSchema.Validate(o)
+}
+
+var urlCategoriesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"type":    {Enum: []string{"URL List", "Category Match"}},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the url-categories schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UrlCategories) Validate() error {
+	return urlCategoriesSchema.Validate(o)
+}
+
+var urlFilteringCategoriesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the url-filtering-categories schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UrlFilteringCategories) Validate() error {
+	return urlFilteringCategoriesSchema.Validate(o)
+}
+
+var urlAccessProfilesCredentialEnforcementSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the url_access_profiles_credential_enforcement schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UrlAccessProfilesCredentialEnforcement) Validate() error {
+	return urlAccessProfilesCredentialEnforcementSchema.Validate(o)
+}
+
+var urlAccessProfilesCredentialEnforcementModeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the url_access_profiles_credential_enforcement_mode schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o UrlAccessProfilesCredentialEnforcementMode) Validate() error {
+	return urlAccessProfilesCredentialEnforcementModeSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, Pattern: `^[a-zA-Z0-9._-]+$`},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability-protection-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfiles) Validate() error {
+	return vulnerabilityProtectionProfilesSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"affected_host": {Required: true},
+		"comment":       {MaxLength: 256},
+		"device":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"direction":     {Required: true, Enum: []string{"client2server", "server2client", "both"}},
+		"folder":        {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"severity":      {Required: true, Enum: []string{"critical", "low", "high", "medium", "informational"}},
+		"signature":     {Required: true},
+		"snippet":       {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"threat_id":     {Required: true},
+		"threatname":    {Required: true, MaxLength: 1024},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability-protection-signatures schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignatures) Validate() error {
+	return vulnerabilityProtectionSignaturesSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"category":       {Enum: []string{"any", "app-id-change", "brute-force", "code-execution", "code-obfuscation", "command-execution", "dos", "exploit-kit", "info-leak", "inline-cloud-exploit", "insecure-credentials", "overflow", "phishing", "protocol-anomaly", "scan", "sql-injection"}},
+		"packet_capture": {Enum: []string{"disable", "single-packet", "extended-capture"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesRulesInner) Validate() error {
+	return vulnerabilityProtectionProfilesRulesInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesRulesInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_rules_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesRulesInnerAction) Validate() error {
+	return vulnerabilityProtectionProfilesRulesInnerActionSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesRulesInnerActionBlockIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"track_by": {Enum: []string{"source-and-destination", "source"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_rules_inner_action_block_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesRulesInnerActionBlockIp) Validate() error {
+	return vulnerabilityProtectionProfilesRulesInnerActionBlockIpSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesThreatExceptionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"packet_capture": {Enum: []string{"disable", "single-packet", "extended-capture"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_threat_exception_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesThreatExceptionInner) Validate() error {
+	return vulnerabilityProtectionProfilesThreatExceptionInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesThreatExceptionInnerActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_threat_exception_inner_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesThreatExceptionInnerAction) Validate() error {
+	return vulnerabilityProtectionProfilesThreatExceptionInnerActionSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesThreatExceptionInnerActionBlockIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"track_by": {Enum: []string{"source-and-destination", "source"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_threat_exception_inner_action_block_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesThreatExceptionInnerActionBlockIp) Validate() error {
+	return vulnerabilityProtectionProfilesThreatExceptionInnerActionBlockIpSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesThreatExceptionInnerExemptIpInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"name": {Required: true},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_threat_exception_inner_exempt_ip_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesThreatExceptionInnerExemptIpInner) Validate() error {
+	return vulnerabilityProtectionProfilesThreatExceptionInnerExemptIpInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionProfilesThreatExceptionInnerTimeAttributeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interval":  {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"threshold": {Minimum: validate.Bound(1), Maximum: validate.Bound(65535)},
+		"track_by":  {Enum: []string{"source", "destination", "source-and-destination"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_profiles_threat_exception_inner_time_attribute schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionProfilesThreatExceptionInnerTimeAttribute) Validate() error {
+	return vulnerabilityProtectionProfilesThreatExceptionInnerTimeAttributeSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesAffectedHostSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_affected_host schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesAffectedHost) Validate() error {
+	return vulnerabilityProtectionSignaturesAffectedHostSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesDefaultActionSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_default_action schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesDefaultAction) Validate() error {
+	return vulnerabilityProtectionSignaturesDefaultActionSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesDefaultActionBlockIpSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"duration": {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"track_by": {Enum: []string{"source-and-destination", "source"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_default_action_block_ip schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesDefaultActionBlockIp) Validate() error {
+	return vulnerabilityProtectionSignaturesDefaultActionBlockIpSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignature) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureCombinationSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_combination schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureCombination) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureCombinationSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureCombinationAndConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_combination_and_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureCombinationAndConditionInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureCombinationAndConditionInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureCombinationAndConditionInnerOrConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_combination_and_condition_inner_or_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureCombinationAndConditionInnerOrConditionInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureCombinationAndConditionInnerOrConditionInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureCombinationTimeAttributeSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"interval":  {Minimum: validate.Bound(1), Maximum: validate.Bound(3600)},
+		"threshold": {Minimum: validate.Bound(1), Maximum: validate.Bound(255)},
+		"track_by":  {Enum: []string{"source-and-destination", "source", "destination"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_combination_time_attribute schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureCombinationTimeAttribute) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureCombinationTimeAttributeSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"comment": {MaxLength: 256},
+		"name":    {Required: true},
+		"scope":   {Enum: []string{"protocol-data-unit", "session"}},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperator) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"value": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_equal_to schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualTo) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToQualifierInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_equal_to_qualifier_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToQualifierInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorEqualToQualifierInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThanSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"value": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_greater_than schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThan) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThanSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThanQualifierInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_greater_than_qualifier_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThanQualifierInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorGreaterThanQualifierInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorLessThanSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"value": {Minimum: validate.Bound(0), Maximum: validate.Bound(4.294967295e+09)},
+	},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_less_than schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorLessThan) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorLessThanSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorLessThanQualifierInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_less_than_qualifier_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorLessThanQualifierInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorLessThanQualifierInnerSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatchSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_pattern_match schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatch) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatchSchema.Validate(o)
+}
+
+var vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatchQualifierInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the vulnerability_protection_signatures_signature_standard_inner_and_condition_inner_or_condition_inner_operator_pattern_match_qualifier_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o VulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatchQualifierInner) Validate() error {
+	return vulnerabilityProtectionSignaturesSignatureStandardInnerAndConditionInnerOrConditionInnerOperatorPatternMatchQualifierInnerSchema.Validate(o)
+}
+
+var wildfireAntiVirusProfilesSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"device":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"folder":  {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+		"name":    {Required: true, Pattern: `^[a-zA-Z0-9._-]+$`},
+		"snippet": {Pattern: `^[a-zA-Z\d\-_\. ]+$`, MaxLength: 64},
+	},
+	OneOf: []validate.OneOf{
+		{Fields: []string{"folder", "snippet", "device"}},
+	},
+}
+
+// Validate checks o against the constraints of the wildfire-anti-virus-profiles schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o WildfireAntiVirusProfiles) Validate() error {
+	return wildfireAntiVirusProfilesSchema.Validate(o)
+}
+
+var wildfireAntiVirusProfilesMlavExceptionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the wildfire_anti_virus_profiles_mlav_exception_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o WildfireAntiVirusProfilesMlavExceptionInner) Validate() error {
+	return wildfireAntiVirusProfilesMlavExceptionInnerSchema.Validate(o)
+}
+
+var wildfireAntiVirusProfilesRulesInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{
+		"analysis":  {Enum: []string{"public-cloud", "private-cloud"}},
+		"direction": {Enum: []string{"download", "upload", "both"}},
+	},
+}
+
+// Validate checks o against the constraints of the wildfire_anti_virus_profiles_rules_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o WildfireAntiVirusProfilesRulesInner) Validate() error {
+	return wildfireAntiVirusProfilesRulesInnerSchema.Validate(o)
+}
+
+var wildfireAntiVirusProfilesThreatExceptionInnerSchema = validate.Schema{
+	Fields: map[string]validate.Rule{},
+}
+
+// Validate checks o against the constraints of the wildfire_anti_virus_profiles_threat_exception_inner schema.
+// It returns validate.Errors, or nil if o is valid.
+func (o WildfireAntiVirusProfilesThreatExceptionInner) Validate() error {
+	return wildfireAntiVirusProfilesThreatExceptionInnerSchema.Validate(o)
+}
@@ -0,0 +1,275 @@
+// Command operationgen builds the table of the operations of the generated
+// API clients, from which the scm package names the operation of each
+// request and validates its body: the method, path template and body model
+// of every XxxExecute method, under each server of the client's
+// Configuration.
+//
+// Usage:
+//
+//	go run ./internal/operationgen -generated ./generated -out operations_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// modulePath is the import path of the scm-go module.
+const modulePath = "github.com/paloaltonetworks/scm-go"
+
+// operation is an operation of a generated API client.  body is the type of
+// its request body, qualified by its package, or an empty string if it has
+// none.
+type operation struct {
+	method, path, name, body string
+}
+
+func main() {
+	generated := flag.String("generated", "./generated", "directory holding the generated API clients")
+	out := flag.String("out", "operations_gen.go", "output file")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*generated, "*", "configuration.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var ops []operation
+	var pkgs []string
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		found, err := load(dir)
+		if err != nil {
+			log.Fatalf("%s: %s", dir, err)
+		}
+		ops = append(ops, found...)
+		pkgs = append(pkgs, filepath.Base(dir))
+	}
+	slices.SortFunc(ops, func(a, b operation) int {
+		if c := strings.Compare(a.path, b.path); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.method, b.method); c != 0 {
+			return c
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	if err = write(*out, pkgs, ops); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load returns the operations of the package in dir, under each of its
+// servers.
+func load(dir string) ([]operation, error) {
+	pkg := filepath.Base(dir)
+	fset := token.NewFileSet()
+	cfg, err := parser.ParseFile(fset, filepath.Join(dir, "configuration.go"), nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	var bases []string
+	ast.Inspect(cfg, func(n ast.Node) bool {
+		if kv, ok := n.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "URL" {
+				if s, ok := stringLit(kv.Value); ok {
+					u, err := url.Parse(s)
+					if err == nil && !slices.Contains(bases, u.Path) {
+						bases = append(bases, u.Path)
+					}
+				}
+			}
+		}
+		return true
+	})
+	if len(bases) == 0 {
+		return nil, fmt.Errorf("no server URL")
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "api_*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var parsed []*ast.File
+	structs := make(map[string]*ast.StructType)
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+		for _, decl := range f.Decls {
+			if d, ok := decl.(*ast.GenDecl); ok {
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						if st, ok := ts.Type.(*ast.StructType); ok {
+							structs[ts.Name.Name] = st
+						}
+					}
+				}
+			}
+		}
+	}
+
+	var ans []operation
+	for _, f := range parsed {
+		for _, decl := range f.Decls {
+			d, ok := decl.(*ast.FuncDecl)
+			if !ok || d.Recv == nil || d.Body == nil || !strings.HasSuffix(d.Name.Name, "Execute") {
+				continue
+			}
+			if star, ok := d.Recv.List[0].Type.(*ast.StarExpr); !ok || !strings.HasSuffix(types.ExprString(star.X), "APIService") {
+				continue
+			}
+			op, field, err := asOperation(d)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", d.Name.Name, err)
+			}
+			if field != "" {
+				// The body is a field of the request, the only parameter.
+				st := structs[types.ExprString(d.Type.Params.List[0].Type)]
+				t := fieldType(st, field)
+				if t == nil {
+					return nil, fmt.Errorf("%s: no request field %s", d.Name.Name, field)
+				}
+				if star, ok := t.(*ast.StarExpr); ok {
+					t = star.X
+				}
+				op.body = qualify(pkg, t)
+			}
+			for _, base := range bases {
+				ans = append(ans, operation{op.method, base + op.path, op.name, op.body})
+			}
+		}
+	}
+	return ans, nil
+}
+
+// asOperation returns the operation of the XxxExecute method d, whose path is
+// relative to the server URL, and the field of the request holding its body,
+// if any:
+//
+//	localVarHTTPMethod = http.MethodPost
+//	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "AddressesAPIService.CreateAddresses")
+//	localVarPath := localBasePath + "/addresses"
+//	localVarPostBody = r.addresses
+func asOperation(d *ast.FuncDecl) (operation, string, error) {
+	var op operation
+	var field string
+	ast.Inspect(d.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			if len(n.Names) == 1 && n.Names[0].Name == "localVarHTTPMethod" && len(n.Values) == 1 {
+				if sel, ok := n.Values[0].(*ast.SelectorExpr); ok {
+					op.method = sel.Sel.Name
+				}
+			}
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "ServerURLWithContext" && len(n.Args) == 2 {
+				op.name, _ = stringLit(n.Args[1])
+			}
+		case *ast.AssignStmt:
+			if id, ok := n.Lhs[0].(*ast.Ident); ok && id.Name == "localVarPath" && n.Tok == token.DEFINE {
+				if bin, ok := n.Rhs[0].(*ast.BinaryExpr); ok {
+					op.path, _ = stringLit(bin.Y)
+				}
+			}
+			if id, ok := n.Lhs[0].(*ast.Ident); ok && id.Name == "localVarPostBody" && n.Tok == token.ASSIGN {
+				if sel, ok := n.Rhs[0].(*ast.SelectorExpr); ok {
+					field = sel.Sel.Name
+				}
+			}
+		}
+		return true
+	})
+	if !strings.HasPrefix(op.method, "Method") || op.name == "" || op.path == "" {
+		return op, "", fmt.Errorf("no method, operation name or path")
+	}
+	return op, field, nil
+}
+
+// fieldType returns the type of the field of st with the given name, or nil
+// if there is none.
+func fieldType(st *ast.StructType, name string) ast.Expr {
+	if st == nil {
+		return nil
+	}
+	for _, f := range st.Fields.List {
+		for _, n := range f.Names {
+			if n.Name == name {
+				return f.Type
+			}
+		}
+	}
+	return nil
+}
+
+// qualify returns the type t of package pkg as written outside of it.
+func qualify(pkg string, t ast.Expr) string {
+	switch t := t.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return pkg + "." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + qualify(pkg, t.X)
+	case *ast.ArrayType:
+		return "[]" + qualify(pkg, t.Elt)
+	case *ast.MapType:
+		return "map[" + qualify(pkg, t.Key) + "]" + qualify(pkg, t.Value)
+	}
+	return types.ExprString(t)
+}
+
+// stringLit returns the value of the string literal e.
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// write writes the table of ops, the operations of the packages pkgs, to
+// file.
+func write(file string, pkgs []string, ops []operation) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by operationgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package scm\n\n")
+	fmt.Fprintf(&b, "import (\n")
+	fmt.Fprintf(&b, "\"net/http\"\n\n")
+	for _, pkg := range pkgs {
+		fmt.Fprintf(&b, "%q\n", modulePath+"/generated/"+pkg)
+	}
+	fmt.Fprintf(&b, ")\n\n")
+	fmt.Fprintf(&b, "// apiOperations are the operations of the generated API clients.\n")
+	fmt.Fprintf(&b, "var apiOperations = []apiOperation{\n")
+	for _, op := range ops {
+		body := "nil"
+		if op.body != "" {
+			body = "newBody[" + op.body + "]"
+		}
+		fmt.Fprintf(&b, "{http.%s, %q, %q, %s},\n", op.method, op.path, op.name, body)
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, src, 0644)
+}
@@ -0,0 +1,85 @@
+package scm
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func newLoggingTestClient(t *testing.T, srv *scmtest.Server, c *Client) *Client {
+	t.Helper()
+
+	c.Host = srv.Host()
+	c.Protocol = "http"
+	c.AuthUrl = srv.AuthURL()
+	c.ClientId = scmtest.ClientID
+	c.ClientSecret = scmtest.ClientSecret
+	c.Scope = scmtest.Scope
+	require.NoError(t, c.Setup())
+	return c
+}
+
+func TestSlogLogger_RedactsSecrets(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	c := newLoggingTestClient(t, srv, &Client{
+		SlogLogger: slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	})
+	ctx := context.Background()
+	require.NoError(t, c.RefreshJwt(ctx))
+
+	_, _, err := GetObjectsAPIClient(c).AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+	require.NoError(t, err)
+	_, err = c.Do(ctx, http.MethodGet, "/config/objects/v1/tags", url.Values{"folder": {"Shared"}}, nil, nil)
+	require.NoError(t, err)
+
+	out := buf.String()
+	jwt, _ := c.jwt()
+	assert.NotContains(t, out, jwt)
+	assert.NotContains(t, out, scmtest.ClientSecret)
+	assert.Contains(t, out, `"path":"/config/objects/v1/addresses"`)
+	assert.Contains(t, out, `"path":"/config/objects/v1/tags"`)
+	assert.Contains(t, out, `"request_id"`)
+	assert.Contains(t, out, `"duration"`)
+}
+
+func TestSlogLogger_Levels(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		logging string
+		request bool
+		body    bool
+	}{
+		{"quiet", false, false},
+		{"basic", true, false},
+		{"detailed", true, true},
+	} {
+		t.Run(tc.logging, func(t *testing.T) {
+			var buf bytes.Buffer
+			c := newLoggingTestClient(t, srv, &Client{
+				Logging:    tc.logging,
+				SlogLogger: slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+			})
+			ctx := context.Background()
+			require.NoError(t, c.RefreshJwt(ctx))
+			_, err := c.Do(ctx, http.MethodGet, "/config/objects/v1/tags", url.Values{"folder": {"Shared"}}, nil, nil)
+			require.NoError(t, err)
+
+			out := buf.String()
+			assert.Equal(t, tc.request, bytes.Contains(buf.Bytes(), []byte(`"msg":"API response"`)), out)
+			assert.Equal(t, tc.body, bytes.Contains(buf.Bytes(), []byte(`"msg":"API request"`)), out)
+		})
+	}
+}
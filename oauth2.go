@@ -0,0 +1,86 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// ClientCredentialsConfig returns the OAuth2 client credentials config
+// matching the client's AuthUrl, ClientId, ClientSecret and Scope.
+func (c *Client) ClientCredentialsConfig() *clientcredentials.Config {
+	return &clientcredentials.Config{
+		ClientID:     c.ClientId,
+		ClientSecret: c.ClientSecret,
+		TokenURL:     c.AuthUrl,
+		Scopes:       []string{c.Scope},
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+}
+
+// ClientCredentialsTokenSource returns a token source fetching tokens from
+// AuthUrl with the client credentials grant, using the client's Transport.
+//
+// Tokens are cached and reused until they expire.  The returned source can
+// be assigned to another Client's TokenSource so that both share one token.
+func (c *Client) ClientCredentialsTokenSource(ctx context.Context) oauth2.TokenSource {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+		Transport: c.Transport,
+		Timeout:   30 * time.Second,
+	})
+	return c.ClientCredentialsConfig().TokenSource(ctx)
+}
+
+// OAuth2TokenSource returns a token source handing out the client's JWT.
+//
+// The JWT is refreshed the same way as for Do() and the API clients, so the
+// source can be used with oauth2.Transport, or passed to the generated API
+// clients through their ContextOAuth2 context key.
+func (c *Client) OAuth2TokenSource() oauth2.TokenSource {
+	return clientTokenSource{c}
+}
+
+// clientTokenSource is the oauth2.TokenSource returned by OAuth2TokenSource.
+type clientTokenSource struct {
+	c *Client
+}
+
+// Token implements oauth2.TokenSource.
+func (s clientTokenSource) Token() (*oauth2.Token, error) {
+	ctx := context.Background()
+
+	jwt, err := s.c.currentJwt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if jwt == "" {
+		if err = s.c.refreshJwt(ctx, &jwt); err != nil {
+			return nil, err
+		}
+	}
+
+	jwt, expiresAt := s.c.jwt()
+	return &oauth2.Token{
+		AccessToken: jwt,
+		TokenType:   "Bearer",
+		Expiry:      expiresAt,
+	}, nil
+}
+
+// fetchJwtFromSource obtains a new JWT from the TokenSource and stores it on
+// the client.
+func (c *Client) fetchJwtFromSource() error {
+	tok, err := c.TokenSource.Token()
+	if err != nil {
+		return err
+	} else if !tok.Valid() {
+		return fmt.Errorf("token source returned an invalid token")
+	}
+
+	c.setJwtToken(tok)
+	return nil
+}
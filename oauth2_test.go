@@ -0,0 +1,127 @@
+package scm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestTokenSource_UsedByDoAndAPIClients(t *testing.T) {
+	var auths []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[],"limit":200,"offset":0,"total":0}`))
+	}))
+	defer server.Close()
+
+	c := &Client{
+		Host:                  strings.TrimPrefix(server.URL, "https://"),
+		SkipVerifyCertificate: true,
+		SkipLoggingTransport:  true,
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: "pre-minted",
+			Expiry:      time.Now().Add(time.Hour),
+		}),
+	}
+	require.NoError(t, c.Setup())
+
+	// The first JWT is obtained by the first request.
+	_, err := c.Do(context.Background(), http.MethodGet, "/config/objects/v1/addresses", nil, nil, nil)
+	require.NoError(t, err)
+	_, _, err = GetObjectsAPIClient(c).AddressesAPI.ListAddresses(context.Background()).Execute()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer pre-minted", "Bearer pre-minted"}, auths)
+}
+
+func TestClientCredentialsTokenSource(t *testing.T) {
+	var calls int32
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		require.NoError(t, r.ParseForm())
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "client-id", user)
+		assert.Equal(t, "client-secret", pass)
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "tsg_id:1234567890", r.PostForm.Get("scope"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"minted","token_type":"Bearer","expires_in":900}`))
+	}))
+	defer auth.Close()
+
+	c := &Client{
+		ClientId:     "client-id",
+		ClientSecret: "client-secret",
+		Scope:        "tsg_id:1234567890",
+		AuthUrl:      auth.URL,
+	}
+	require.NoError(t, c.Setup())
+
+	other := &Client{
+		Host:        "example.com",
+		TokenSource: c.ClientCredentialsTokenSource(context.Background()),
+	}
+	require.NoError(t, other.Setup())
+	require.NoError(t, other.RefreshJwt(context.Background()))
+	require.NoError(t, other.RefreshJwt(context.Background()))
+
+	jwt, expiresAt := other.jwt()
+	assert.Equal(t, "minted", jwt)
+	assert.WithinDuration(t, time.Now().Add(840*time.Second), expiresAt, 5*time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestTokenSource_Expiry(t *testing.T) {
+	exp := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp.Unix())))
+
+	for _, tc := range []struct {
+		name      string
+		tok       *oauth2.Token
+		expiresAt time.Time
+	}{
+		{"expiry", &oauth2.Token{AccessToken: "opaque", Expiry: time.Now().Add(time.Hour)}, time.Now().Add(time.Hour - time.Minute)},
+		{"exp claim", &oauth2.Token{AccessToken: "e30." + claims + ".sig"}, exp.Add(-time.Minute)},
+		{"no expiry", &oauth2.Token{AccessToken: "opaque"}, time.Now().Add(noExpiryRecheckInterval)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{Host: "example.com", TokenSource: oauth2.StaticTokenSource(tc.tok)}
+			require.NoError(t, c.Setup())
+			require.NoError(t, c.RefreshJwt(context.Background()))
+
+			jwt, expiresAt := c.jwt()
+			assert.Equal(t, tc.tok.AccessToken, jwt)
+			assert.WithinDuration(t, tc.expiresAt, expiresAt, 5*time.Second)
+		})
+	}
+}
+
+func TestOAuth2TokenSource(t *testing.T) {
+	var authCalls int32
+	c := newTokenTestClient(t, 0, &authCalls)
+
+	ts := c.OAuth2TokenSource()
+	tok, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-1", tok.AccessToken)
+	assert.Equal(t, "Bearer", tok.TokenType)
+	assert.True(t, tok.Valid())
+
+	tok, err = ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "jwt-1", tok.AccessToken)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&authCalls))
+}
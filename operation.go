@@ -0,0 +1,198 @@
+package scm
+
+//go:generate go run ./internal/operationgen -generated ./generated -out operations_gen.go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+	"github.com/paloaltonetworks/scm-go/validate"
+)
+
+// apiOperation is an operation of the generated API clients: its HTTP
+// method, its path template, such as "/config/objects/v1/addresses/{id}",
+// its name, such as "AddressesAPIService.GetAddressesByID", and the function
+// returning a new model of its request body, nil if it takes none.
+type apiOperation struct {
+	method, path, name string
+	body               func() interface{}
+}
+
+// newBody returns a new T, for apiOperation.body.
+func newBody[T any]() interface{} {
+	return new(T)
+}
+
+// OperationTransport names the API operation of each request after the
+// operation of the generated API clients with the same method and path, so
+// that middleware can read it with api.Operation.  Requests whose context
+// already carries an operation, or matching no operation, are sent as is.
+// Requests whose context is canceled fail with the cause of the
+// cancellation, such as the error given to api.WithError.
+//
+// The header of each error response to an operation is recorded with
+// scmErrors.RecordResponse, so that the typed error of the generated API
+// client's error has its Retry-After and X-Request-ID.
+type OperationTransport struct {
+	Wrapped http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *OperationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Err() != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, context.Cause(req.Context())
+	}
+	op := findOperation(req.Method, req.URL.EscapedPath())
+	if op != nil && api.Operation(req.Context()) == "" {
+		req = req.WithContext(api.WithOperation(req.Context(), op.name))
+	}
+	resp, err := t.Wrapped.RoundTrip(req)
+	if err != nil || op == nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+
+	// The generated API clients read the whole body of error responses.
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	scmErrors.RecordResponse(resp.StatusCode, resp.Header, body)
+	return resp, nil
+}
+
+// ValidateTransport validates the body of each request for an operation of
+// the generated API clients against the constraints of the operation's body
+// model (see package validate), failing invalid requests without sending
+// them.  Bodies of other requests, or not decoding to the model, are sent as
+// is.
+type ValidateTransport struct {
+	Wrapped http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *ValidateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := findOperation(req.Method, req.URL.EscapedPath())
+	if op == nil || op.body == nil || req.Body == nil || req.Body == http.NoBody {
+		return t.Wrapped.RoundTrip(req)
+	}
+
+	var data []byte
+	var err error
+	if req.GetBody != nil {
+		var body io.ReadCloser
+		if body, err = req.GetBody(); err == nil {
+			data, err = io.ReadAll(body)
+			body.Close()
+		}
+	} else {
+		data, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+	}
+	if err == nil {
+		v := op.body()
+		if json.Unmarshal(data, v) == nil {
+			err = validate.Value(v)
+		}
+	}
+	if err != nil {
+		req.Body.Close()
+		return nil, err
+	}
+	return t.Wrapped.RoundTrip(req)
+}
+
+// operationSegment is a segment of a path template: a literal, or a
+// parameter between a literal prefix and suffix, as in "{id}:move".
+type operationSegment struct {
+	prefix, suffix string
+	param          bool
+}
+
+// operationRoute is an apiOperation with its path template split in
+// segments.
+type operationRoute struct {
+	op       *apiOperation
+	segments []operationSegment
+	literals int
+}
+
+// operationKey is the method and number of path segments of a request.
+type operationKey struct {
+	method   string
+	segments int
+}
+
+// operationRoutes returns the routes of apiOperations by operationKey.
+var operationRoutes = sync.OnceValue(func() map[operationKey][]operationRoute {
+	ans := make(map[operationKey][]operationRoute)
+	for i := range apiOperations {
+		op := &apiOperations[i]
+		r := operationRoute{op: op}
+		for _, s := range strings.Split(op.path, "/") {
+			var seg operationSegment
+			if i := strings.Index(s, "{"); i >= 0 {
+				if j := strings.Index(s[i:], "}"); j >= 0 {
+					seg = operationSegment{prefix: s[:i], suffix: s[i+j+1:], param: true}
+				}
+			}
+			if !seg.param {
+				seg.prefix = s
+			}
+			r.segments = append(r.segments, seg)
+			r.literals += len(seg.prefix) + len(seg.suffix)
+		}
+		key := operationKey{op.method, len(r.segments)}
+		ans[key] = append(ans[key], r)
+	}
+	return ans
+})
+
+// findOperation returns the operation with the given method and path, or nil
+// if there is none.  When several path templates match, the one with the
+// most literal text wins, so that "/config-versions/candidate:push" is not
+// taken for "/config-versions/{version}".
+func findOperation(method, path string) *apiOperation {
+	segments := strings.Split(path, "/")
+	best := -1
+	var ans *apiOperation
+	for _, r := range operationRoutes()[operationKey{method, len(segments)}] {
+		if r.literals > best && r.match(segments) {
+			best, ans = r.literals, r.op
+		}
+	}
+	return ans
+}
+
+// match returns whether the segments of a path match the route.
+func (r operationRoute) match(segments []string) bool {
+	for i, seg := range r.segments {
+		s := segments[i]
+		if !seg.param {
+			if s != seg.prefix {
+				return false
+			}
+			continue
+		}
+		if len(s) <= len(seg.prefix)+len(seg.suffix) || !strings.HasPrefix(s, seg.prefix) || !strings.HasSuffix(s, seg.suffix) {
+			return false
+		}
+	}
+	return true
+}
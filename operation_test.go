@@ -0,0 +1,104 @@
+package scm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+	"github.com/paloaltonetworks/scm-go/generated/config_setup"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func TestFindOperation(t *testing.T) {
+	tests := []struct {
+		method, path, want string
+	}{
+		{http.MethodPost, "/config/objects/v1/addresses", "AddressesAPIService.CreateAddresses"},
+		{http.MethodGet, "/config/objects/v1/addresses", "AddressesAPIService.ListAddresses"},
+		{http.MethodPut, "/config/objects/v1/addresses/0a1b%2F2c", "AddressesAPIService.UpdateAddressesByID"},
+		{http.MethodGet, "/sse/config/v1/addresses/1", "AddressesAPIService.GetAddressesByID"},
+		{http.MethodGet, "/config/operations/v1/config-versions/running", "ConfigVersionsAPIService.GetRunningConfigVersions"},
+		{http.MethodGet, "/config/operations/v1/config-versions/7", "ConfigVersionsAPIService.GetConfigVersionsByID"},
+		{http.MethodPost, "/config/operations/v1/config-versions/candidate:push", "ConfigVersionsAPIService.PushCandidateConfigVersions"},
+		{http.MethodPost, "/config/security/v1/security-rules/1:move", "SecurityRulesAPIService.MoveSecurityRulesByID"},
+		{http.MethodPost, "/config/security/v1/security-rules/:move", ""},
+		{http.MethodPatch, "/config/objects/v1/addresses", ""},
+		{http.MethodGet, "/config/objects/v1/addresses/1/2", ""},
+		{http.MethodGet, "/oauth2/access_token", ""},
+	}
+	for _, tc := range tests {
+		name := ""
+		if op := findOperation(tc.method, tc.path); op != nil {
+			name = op.name
+		}
+		assert.Equal(t, tc.want, name, "%s %s", tc.method, tc.path)
+	}
+
+	// The operations know the model of their body.
+	assert.IsType(t, &objects.Addresses{}, findOperation(http.MethodPost, "/config/objects/v1/addresses").body())
+	assert.IsType(t, &[]config_setup.AddSubscriberRequestPayloadInner{}, findOperation(http.MethodPost, "/config/setup/v1/subscribed-tenants").body())
+	assert.Nil(t, findOperation(http.MethodGet, "/config/objects/v1/addresses").body)
+}
+
+func TestOperationTransport(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true})
+	require.NoError(t, client.RefreshJwt(ctx))
+
+	var ops []string
+	sdk := NewSDK(client, func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ops = append(ops, api.Operation(req.Context()))
+			return next.RoundTrip(req)
+		})
+	})
+
+	_, _, err := sdk.Objects().AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+	require.NoError(t, err)
+	_, _, err = sdk.Objects().TagsAPI.ListTags(api.WithOperation(ctx, "sync-tags")).Folder("Shared").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AddressesAPIService.ListAddresses", "sync-tags"}, ops)
+}
+
+func TestOperationTransport_ErrorHeader(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true})
+	require.NoError(t, client.RefreshJwt(ctx))
+
+	sdk := NewSDK(client, func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			header := http.Header{"Content-Type": {"application/json"}, "Retry-After": {"12"}, "X-Request-Id": {"req-429"}}
+			return &http.Response{
+				Status:     "429 Too Many Requests",
+				StatusCode: http.StatusTooManyRequests,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"_errors":[{"code":"E012","message":"Too Many Requests"}]}`)),
+				Request:    req,
+			}, nil
+		})
+	})
+
+	_, _, err := sdk.Objects().AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+	rateErr, ok := scmErrors.AsTooManyRequests(err)
+	require.True(t, ok, "%v", err)
+	assert.Equal(t, 12, rateErr.RetryAfter)
+	assert.Equal(t, "req-429", rateErr.RequestID)
+
+	var again *scmErrors.TooManyRequestsError
+	require.ErrorAs(t, err, &again)
+	assert.Same(t, rateErr, again)
+}
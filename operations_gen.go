@@ -0,0 +1,1276 @@
+// Code generated by operationgen. DO NOT EDIT.
+
+package scm
+
+import (
+	"net/http"
+
+	"github.com/paloaltonetworks/scm-go/generated/config_operations"
+	"github.com/paloaltonetworks/scm-go/generated/config_setup"
+	"github.com/paloaltonetworks/scm-go/generated/deployment_services"
+	"github.com/paloaltonetworks/scm-go/generated/device_settings"
+	"github.com/paloaltonetworks/scm-go/generated/identity_services"
+	"github.com/paloaltonetworks/scm-go/generated/network_services"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/generated/security_services"
+)
+
+// apiOperations are the operations of the generated API clients.
+var apiOperations = []apiOperation{
+	{http.MethodDelete, "/config/deployment/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.DeleteBandwidthAllocations", nil},
+	{http.MethodGet, "/config/deployment/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.ListBandwidthAllocations", nil},
+	{http.MethodPost, "/config/deployment/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.CreateBandwidthAllocations", newBody[deployment_services.BandwidthAllocations]},
+	{http.MethodPut, "/config/deployment/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.UpdateBandwidthAllocations", newBody[deployment_services.BandwidthAllocations]},
+	{http.MethodGet, "/config/deployment/v1/bgp-routing", "BGPRoutingAPIService.GetBGPRouting", nil},
+	{http.MethodPut, "/config/deployment/v1/bgp-routing", "BGPRoutingAPIService.UpdateBGPRouting", newBody[deployment_services.BgpRouting]},
+	{http.MethodPost, "/config/deployment/v1/enable", "ApplicationDefaultsAPIService.CreateApplicationDefaults", nil},
+	{http.MethodGet, "/config/deployment/v1/internal-dns-servers", "InternalDNSServersAPIService.ListInternalDNSServers", nil},
+	{http.MethodPost, "/config/deployment/v1/internal-dns-servers", "InternalDNSServersAPIService.CreateInternalDNSServers", newBody[deployment_services.InternalDnsServers]},
+	{http.MethodDelete, "/config/deployment/v1/internal-dns-servers/{id}", "InternalDNSServersAPIService.DeleteInternalDNSServersByID", nil},
+	{http.MethodGet, "/config/deployment/v1/internal-dns-servers/{id}", "InternalDNSServersAPIService.GetInternalDNSServersByID", nil},
+	{http.MethodPut, "/config/deployment/v1/internal-dns-servers/{id}", "InternalDNSServersAPIService.UpdateInternalDNSServersByID", newBody[deployment_services.InternalDnsServers]},
+	{http.MethodGet, "/config/deployment/v1/locations", "NetworkLocationsAPIService.ListLocations", nil},
+	{http.MethodGet, "/config/deployment/v1/remote-networks", "RemoteNetworksAPIService.ListRemoteNetworks", nil},
+	{http.MethodPost, "/config/deployment/v1/remote-networks", "RemoteNetworksAPIService.CreateRemoteNetworks", newBody[deployment_services.RemoteNetworks]},
+	{http.MethodDelete, "/config/deployment/v1/remote-networks/{id}", "RemoteNetworksAPIService.DeleteRemoteNetworksByID", nil},
+	{http.MethodGet, "/config/deployment/v1/remote-networks/{id}", "RemoteNetworksAPIService.GetRemoteNetworksByID", nil},
+	{http.MethodPut, "/config/deployment/v1/remote-networks/{id}", "RemoteNetworksAPIService.UpdateRemoteNetworksByID", newBody[deployment_services.RemoteNetworks]},
+	{http.MethodGet, "/config/deployment/v1/service-connection-groups", "ServiceConnectionGroupsAPIService.ListServiceConnectionGroups", nil},
+	{http.MethodPost, "/config/deployment/v1/service-connection-groups", "ServiceConnectionGroupsAPIService.CreateServiceConnectionGroups", newBody[deployment_services.ServiceConnectionGroups]},
+	{http.MethodDelete, "/config/deployment/v1/service-connection-groups/{id}", "ServiceConnectionGroupsAPIService.DeleteServiceConnectionGroupsByID", nil},
+	{http.MethodGet, "/config/deployment/v1/service-connection-groups/{id}", "ServiceConnectionGroupsAPIService.GetServiceConnectionGroupsByID", nil},
+	{http.MethodPut, "/config/deployment/v1/service-connection-groups/{id}", "ServiceConnectionGroupsAPIService.UpdateServiceConnectionGroupsByID", newBody[deployment_services.ServiceConnectionGroups]},
+	{http.MethodGet, "/config/deployment/v1/service-connections", "ServiceConnectionsAPIService.ListServiceConnections", nil},
+	{http.MethodPost, "/config/deployment/v1/service-connections", "ServiceConnectionsAPIService.CreateServiceConnections", newBody[deployment_services.ServiceConnections]},
+	{http.MethodDelete, "/config/deployment/v1/service-connections/{id}", "ServiceConnectionsAPIService.DeleteServiceConnectionsByID", nil},
+	{http.MethodGet, "/config/deployment/v1/service-connections/{id}", "ServiceConnectionsAPIService.GetServiceConnectionsByID", nil},
+	{http.MethodPut, "/config/deployment/v1/service-connections/{id}", "ServiceConnectionsAPIService.UpdateServiceConnectionsByID", newBody[deployment_services.ServiceConnections]},
+	{http.MethodGet, "/config/deployment/v1/shared-infrastructure-settings", "SharedInfrastructureSettingsAPIService.GetSharedInfrastructureSettings", nil},
+	{http.MethodPut, "/config/deployment/v1/shared-infrastructure-settings", "SharedInfrastructureSettingsAPIService.UpdateSharedInfrastructureSettings", newBody[deployment_services.EditSharedInfrastructureSettings]},
+	{http.MethodGet, "/config/deployment/v1/sites", "SitesAPIService.ListSites", nil},
+	{http.MethodPost, "/config/deployment/v1/sites", "SitesAPIService.CreateSites", newBody[deployment_services.Sites]},
+	{http.MethodDelete, "/config/deployment/v1/sites/{id}", "SitesAPIService.DeleteSitesByID", nil},
+	{http.MethodGet, "/config/deployment/v1/sites/{id}", "SitesAPIService.GetSitesByID", nil},
+	{http.MethodPut, "/config/deployment/v1/sites/{id}", "SitesAPIService.UpdateSitesByID", newBody[deployment_services.Sites]},
+	{http.MethodGet, "/config/deployment/v1/traffic-steering-rules", "TrafficSteeringRulesAPIService.ListTrafficSteeringRules", nil},
+	{http.MethodPost, "/config/deployment/v1/traffic-steering-rules", "TrafficSteeringRulesAPIService.CreateTrafficSteeringRules", newBody[deployment_services.TrafficSteeringRules]},
+	{http.MethodDelete, "/config/deployment/v1/traffic-steering-rules/{id}", "TrafficSteeringRulesAPIService.DeleteTrafficSteeringRulesByID", nil},
+	{http.MethodGet, "/config/deployment/v1/traffic-steering-rules/{id}", "TrafficSteeringRulesAPIService.GetTrafficSteeringRulesByID", nil},
+	{http.MethodPut, "/config/deployment/v1/traffic-steering-rules/{id}", "TrafficSteeringRulesAPIService.UpdateTrafficSteeringRulesByID", newBody[deployment_services.TrafficSteeringRules]},
+	{http.MethodGet, "/config/device/v1/authentication-settings", "AuthenticationSettingsAPIService.ListAuthenticationSettings", nil},
+	{http.MethodPost, "/config/device/v1/authentication-settings", "AuthenticationSettingsAPIService.CreateAuthenticationSettings", newBody[device_settings.AuthenticationSettings]},
+	{http.MethodDelete, "/config/device/v1/authentication-settings/{id}", "AuthenticationSettingsAPIService.DeleteAuthenticationSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/authentication-settings/{id}", "AuthenticationSettingsAPIService.GetAuthenticationSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/authentication-settings/{id}", "AuthenticationSettingsAPIService.UpdateAuthenticationSettingsByID", newBody[device_settings.AuthenticationSettings]},
+	{http.MethodGet, "/config/device/v1/content-id-settings", "ContentIDSettingsAPIService.ListContentIDSettings", nil},
+	{http.MethodPost, "/config/device/v1/content-id-settings", "ContentIDSettingsAPIService.CreateContentIDSettings", newBody[device_settings.ContentIdSettings]},
+	{http.MethodDelete, "/config/device/v1/content-id-settings/{id}", "ContentIDSettingsAPIService.DeleteContentIDSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/content-id-settings/{id}", "ContentIDSettingsAPIService.GetContentIDSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/content-id-settings/{id}", "ContentIDSettingsAPIService.UpdateContentIDSettingsByID", newBody[device_settings.ContentIdSettings]},
+	{http.MethodGet, "/config/device/v1/device-redistribution-collector", "DeviceRedistributionCollectorSettingsAPIService.ListDeviceRedistributionCollectorSettings", nil},
+	{http.MethodPost, "/config/device/v1/device-redistribution-collector", "DeviceRedistributionCollectorSettingsAPIService.CreateDeviceRedistributionCollectorSettings", newBody[device_settings.DeviceRedistributionCollector]},
+	{http.MethodDelete, "/config/device/v1/device-redistribution-collector/{id}", "DeviceRedistributionCollectorSettingsAPIService.DeleteDeviceRedistributionCollectorSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/device-redistribution-collector/{id}", "DeviceRedistributionCollectorSettingsAPIService.GetDeviceRedistributionCollectorSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/device-redistribution-collector/{id}", "DeviceRedistributionCollectorSettingsAPIService.UpdateDeviceRedistributionCollectorSettingsByID", newBody[device_settings.DeviceRedistributionCollector]},
+	{http.MethodGet, "/config/device/v1/general-settings", "GeneralSettingsAPIService.ListGeneralSettings", nil},
+	{http.MethodPost, "/config/device/v1/general-settings", "GeneralSettingsAPIService.CreateGeneralSettings", newBody[device_settings.GeneralSettings]},
+	{http.MethodDelete, "/config/device/v1/general-settings/{id}", "GeneralSettingsAPIService.DeleteGeneralSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/general-settings/{id}", "GeneralSettingsAPIService.GetGeneralSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/general-settings/{id}", "GeneralSettingsAPIService.UpdateGeneralSettingsByID", newBody[device_settings.GeneralSettings]},
+	{http.MethodGet, "/config/device/v1/ha-devices", "HighAvailabilityDevicesAPIService.ListHADevices", nil},
+	{http.MethodGet, "/config/device/v1/management-interface", "ManagementInterfaceSettingsAPIService.ListManagementInterfaceSettings", nil},
+	{http.MethodPost, "/config/device/v1/management-interface", "ManagementInterfaceSettingsAPIService.CreateManagementInterfaceSettings", newBody[device_settings.ManagementInterface]},
+	{http.MethodDelete, "/config/device/v1/management-interface/{id}", "ManagementInterfaceSettingsAPIService.DeleteManagementInterfaceSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/management-interface/{id}", "ManagementInterfaceSettingsAPIService.GetManagementInterfaceSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/management-interface/{id}", "ManagementInterfaceSettingsAPIService.UpdateManagementInterfaceSettingsByID", newBody[device_settings.ManagementInterface]},
+	{http.MethodGet, "/config/device/v1/motd-banner-settings", "LoginBannerSettingsAPIService.ListLoginBannerSettings", nil},
+	{http.MethodPost, "/config/device/v1/motd-banner-settings", "LoginBannerSettingsAPIService.CreateLoginBannerSettings", newBody[device_settings.MotdBannerSettings]},
+	{http.MethodDelete, "/config/device/v1/motd-banner-settings/{id}", "LoginBannerSettingsAPIService.DeleteLoginBannerSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/motd-banner-settings/{id}", "LoginBannerSettingsAPIService.GetLoginBannerSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/motd-banner-settings/{id}", "LoginBannerSettingsAPIService.UpdateLoginBannerSettingsByID", newBody[device_settings.MotdBannerSettings]},
+	{http.MethodGet, "/config/device/v1/service-route", "ServiceRouteSettingsAPIService.ListServiceRouteSettings", nil},
+	{http.MethodPost, "/config/device/v1/service-route", "ServiceRouteSettingsAPIService.CreateServiceRouteSettings", newBody[device_settings.ServiceRoute]},
+	{http.MethodDelete, "/config/device/v1/service-route/{id}", "ServiceRouteSettingsAPIService.DeleteServiceRouteSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/service-route/{id}", "ServiceRouteSettingsAPIService.GetServiceRouteSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/service-route/{id}", "ServiceRouteSettingsAPIService.UpdateServiceRouteSettingsByID", newBody[device_settings.ServiceRoute]},
+	{http.MethodGet, "/config/device/v1/service-settings", "ServiceSettingsAPIService.ListServiceSettings", nil},
+	{http.MethodPost, "/config/device/v1/service-settings", "ServiceSettingsAPIService.CreateServiceSettings", newBody[device_settings.ServiceSettings]},
+	{http.MethodDelete, "/config/device/v1/service-settings/{id}", "ServiceSettingsAPIService.DeleteServiceSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/service-settings/{id}", "ServiceSettingsAPIService.GetServiceSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/service-settings/{id}", "ServiceSettingsAPIService.UpdateServiceSettingsByID", newBody[device_settings.ServiceSettings]},
+	{http.MethodGet, "/config/device/v1/session-settings", "SessionSettingsAPIService.ListSessionSettings", nil},
+	{http.MethodPost, "/config/device/v1/session-settings", "SessionSettingsAPIService.CreateSessionSettings", newBody[device_settings.SessionSettings]},
+	{http.MethodDelete, "/config/device/v1/session-settings/{id}", "SessionSettingsAPIService.DeleteSessionSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/session-settings/{id}", "SessionSettingsAPIService.GetSessionSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/session-settings/{id}", "SessionSettingsAPIService.UpdateSessionSettingsByID", newBody[device_settings.SessionSettings]},
+	{http.MethodGet, "/config/device/v1/session-timeouts", "SessionTimeoutsSettingsAPIService.ListSessionTimeoutsSettings", nil},
+	{http.MethodPost, "/config/device/v1/session-timeouts", "SessionTimeoutsSettingsAPIService.CreateSessionTimeoutsSettings", newBody[device_settings.SessionTimeouts]},
+	{http.MethodDelete, "/config/device/v1/session-timeouts/{id}", "SessionTimeoutsSettingsAPIService.DeleteSessionTimeoutsSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/session-timeouts/{id}", "SessionTimeoutsSettingsAPIService.GetSessionTimeoutsSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/session-timeouts/{id}", "SessionTimeoutsSettingsAPIService.UpdateSessionTimeoutsSettingsByID", newBody[device_settings.SessionTimeouts]},
+	{http.MethodGet, "/config/device/v1/tcp-settings", "TCPSettingsAPIService.ListTCPSettings", nil},
+	{http.MethodPost, "/config/device/v1/tcp-settings", "TCPSettingsAPIService.CreateTCPSettings", newBody[device_settings.TcpSettings]},
+	{http.MethodDelete, "/config/device/v1/tcp-settings/{id}", "TCPSettingsAPIService.DeleteTCPSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/tcp-settings/{id}", "TCPSettingsAPIService.GetTCPSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/tcp-settings/{id}", "TCPSettingsAPIService.UpdateTCPSettingsByID", newBody[device_settings.TcpSettings]},
+	{http.MethodGet, "/config/device/v1/update-schedule", "UpdateScheduleSettingsAPIService.ListUpdateScheduleSettings", nil},
+	{http.MethodPost, "/config/device/v1/update-schedule", "UpdateScheduleSettingsAPIService.CreateUpdateScheduleSettings", newBody[device_settings.UpdateSchedule]},
+	{http.MethodDelete, "/config/device/v1/update-schedule/{id}", "UpdateScheduleSettingsAPIService.DeleteUpdateScheduleSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/update-schedule/{id}", "UpdateScheduleSettingsAPIService.GetUpdateScheduleSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/update-schedule/{id}", "UpdateScheduleSettingsAPIService.UpdateUpdateScheduleSettingsByID", newBody[device_settings.UpdateSchedule]},
+	{http.MethodGet, "/config/device/v1/vpn-settings", "VPNSettingsAPIService.ListVPNSettings", nil},
+	{http.MethodPost, "/config/device/v1/vpn-settings", "VPNSettingsAPIService.CreateVPNSettings", newBody[device_settings.VpnSettings]},
+	{http.MethodDelete, "/config/device/v1/vpn-settings/{id}", "VPNSettingsAPIService.DeleteVPNSettingsByID", nil},
+	{http.MethodGet, "/config/device/v1/vpn-settings/{id}", "VPNSettingsAPIService.GetVPNSettingsByID", nil},
+	{http.MethodPut, "/config/device/v1/vpn-settings/{id}", "VPNSettingsAPIService.UpdateVPNSettingsByID", newBody[device_settings.VpnSettings]},
+	{http.MethodGet, "/config/identity/v1/authentication-portals", "AuthenticationPortalsAPIService.ListAuthenticationPortals", nil},
+	{http.MethodPost, "/config/identity/v1/authentication-portals", "AuthenticationPortalsAPIService.CreateAuthenticationPortals", newBody[identity_services.AuthenticationPortals]},
+	{http.MethodDelete, "/config/identity/v1/authentication-portals/{id}", "AuthenticationPortalsAPIService.DeleteAuthenticationPortalsByID", nil},
+	{http.MethodGet, "/config/identity/v1/authentication-portals/{id}", "AuthenticationPortalsAPIService.GetAuthenticationPortalsByID", nil},
+	{http.MethodPut, "/config/identity/v1/authentication-portals/{id}", "AuthenticationPortalsAPIService.UpdateAuthenticationPortalsByID", newBody[identity_services.AuthenticationPortals]},
+	{http.MethodGet, "/config/identity/v1/authentication-profiles", "AuthenticationProfilesAPIService.ListAuthenticationProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/authentication-profiles", "AuthenticationProfilesAPIService.CreateAuthenticationProfiles", newBody[identity_services.AuthenticationProfiles]},
+	{http.MethodDelete, "/config/identity/v1/authentication-profiles/{id}", "AuthenticationProfilesAPIService.DeleteAuthenticationProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/authentication-profiles/{id}", "AuthenticationProfilesAPIService.GetAuthenticationProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/authentication-profiles/{id}", "AuthenticationProfilesAPIService.UpdateAuthenticationProfilesByID", newBody[identity_services.AuthenticationProfiles]},
+	{http.MethodGet, "/config/identity/v1/authentication-rules", "AuthenticationRulesAPIService.ListAuthenticationRules", nil},
+	{http.MethodPost, "/config/identity/v1/authentication-rules", "AuthenticationRulesAPIService.CreateAuthenticationRules", newBody[identity_services.AuthenticationRules]},
+	{http.MethodDelete, "/config/identity/v1/authentication-rules/{id}", "AuthenticationRulesAPIService.DeleteAuthenticationRulesByID", nil},
+	{http.MethodGet, "/config/identity/v1/authentication-rules/{id}", "AuthenticationRulesAPIService.GetAuthenticationRulesByID", nil},
+	{http.MethodPut, "/config/identity/v1/authentication-rules/{id}", "AuthenticationRulesAPIService.UpdateAuthenticationRulesByID", newBody[identity_services.AuthenticationRules]},
+	{http.MethodPost, "/config/identity/v1/authentication-rules/{id}:move", "AuthenticationRulesAPIService.MoveAuthenticationRulesByID", newBody[identity_services.RuleBasedMove]},
+	{http.MethodGet, "/config/identity/v1/authentication-sequences", "AuthenticationSequencesAPIService.ListAuthenticationSequences", nil},
+	{http.MethodPost, "/config/identity/v1/authentication-sequences", "AuthenticationSequencesAPIService.CreateAuthenticationSequences", newBody[identity_services.AuthenticationSequences]},
+	{http.MethodDelete, "/config/identity/v1/authentication-sequences/{id}", "AuthenticationSequencesAPIService.DeleteAuthenticationSequencesByID", nil},
+	{http.MethodGet, "/config/identity/v1/authentication-sequences/{id}", "AuthenticationSequencesAPIService.GetAuthenticationSequencesByID", nil},
+	{http.MethodPut, "/config/identity/v1/authentication-sequences/{id}", "AuthenticationSequencesAPIService.UpdateAuthenticationSequencesByID", newBody[identity_services.AuthenticationSequences]},
+	{http.MethodGet, "/config/identity/v1/certificate-profiles", "CertificateProfilesAPIService.ListCertificateProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/certificate-profiles", "CertificateProfilesAPIService.CreateCertificateProfiles", newBody[identity_services.CertificateProfiles]},
+	{http.MethodDelete, "/config/identity/v1/certificate-profiles/{id}", "CertificateProfilesAPIService.DeleteCertificateProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/certificate-profiles/{id}", "CertificateProfilesAPIService.GetCertificateProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/certificate-profiles/{id}", "CertificateProfilesAPIService.UpdateCertificateProfilesByID", newBody[identity_services.CertificateProfiles]},
+	{http.MethodGet, "/config/identity/v1/certificates", "CertificatesAPIService.ListCertificates", nil},
+	{http.MethodPost, "/config/identity/v1/certificates", "CertificatesAPIService.CreateCertificates", newBody[identity_services.CertificatesPost]},
+	{http.MethodDelete, "/config/identity/v1/certificates/{id}", "CertificatesAPIService.DeleteCertificatesByID", nil},
+	{http.MethodGet, "/config/identity/v1/certificates/{id}", "CertificatesAPIService.GetCertificatesByID", nil},
+	{http.MethodPost, "/config/identity/v1/certificates/{id}:export", "CertificatesAPIService.ExportCertificateByID", newBody[identity_services.ExportCertificatePayload]},
+	{http.MethodGet, "/config/identity/v1/kerberos-server-profiles", "KerberosServerProfilesAPIService.ListKerberosServerProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/kerberos-server-profiles", "KerberosServerProfilesAPIService.CreateKerberosServerProfiles", newBody[identity_services.KerberosServerProfiles]},
+	{http.MethodDelete, "/config/identity/v1/kerberos-server-profiles/{id}", "KerberosServerProfilesAPIService.DeleteKerberosServerProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/kerberos-server-profiles/{id}", "KerberosServerProfilesAPIService.GetKerberosServerProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/kerberos-server-profiles/{id}", "KerberosServerProfilesAPIService.UpdateKerberosServerProfilesByID", newBody[identity_services.KerberosServerProfiles]},
+	{http.MethodGet, "/config/identity/v1/ldap-server-profiles", "LDAPServerProfilesAPIService.ListLDAPServerProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/ldap-server-profiles", "LDAPServerProfilesAPIService.CreateLDAPServerProfiles", newBody[identity_services.LdapServerProfiles]},
+	{http.MethodDelete, "/config/identity/v1/ldap-server-profiles/{id}", "LDAPServerProfilesAPIService.DeleteLDAPServerProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/ldap-server-profiles/{id}", "LDAPServerProfilesAPIService.GetLDAPServerProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/ldap-server-profiles/{id}", "LDAPServerProfilesAPIService.UpdateLDAPServerProfiles", newBody[identity_services.LdapServerProfiles]},
+	{http.MethodGet, "/config/identity/v1/local-user-groups", "LocalUserGroupsAPIService.ListLocalUserGroups", nil},
+	{http.MethodPost, "/config/identity/v1/local-user-groups", "LocalUserGroupsAPIService.CreateLocalUserGroups", newBody[identity_services.LocalUserGroups]},
+	{http.MethodDelete, "/config/identity/v1/local-user-groups/{id}", "LocalUserGroupsAPIService.DeleteLocalUserGroupsByID", nil},
+	{http.MethodGet, "/config/identity/v1/local-user-groups/{id}", "LocalUserGroupsAPIService.GetLocalUserGroupsByID", nil},
+	{http.MethodPut, "/config/identity/v1/local-user-groups/{id}", "LocalUserGroupsAPIService.UpdateLocalUserGroupsByID", newBody[identity_services.LocalUserGroups]},
+	{http.MethodGet, "/config/identity/v1/local-users", "LocalUsersAPIService.ListLocalUsers", nil},
+	{http.MethodPost, "/config/identity/v1/local-users", "LocalUsersAPIService.CreateLocalUsers", newBody[identity_services.LocalUsers]},
+	{http.MethodDelete, "/config/identity/v1/local-users/{id}", "LocalUsersAPIService.DeleteLocalUsersByID", nil},
+	{http.MethodGet, "/config/identity/v1/local-users/{id}", "LocalUsersAPIService.GetLocalUsersByID", nil},
+	{http.MethodPut, "/config/identity/v1/local-users/{id}", "LocalUsersAPIService.UpdateLocalUsersByID", newBody[identity_services.LocalUsers]},
+	{http.MethodGet, "/config/identity/v1/mfa-servers", "MFAServersAPIService.ListMFAServers", nil},
+	{http.MethodPost, "/config/identity/v1/mfa-servers", "MFAServersAPIService.CreateMFAServers", newBody[identity_services.MfaServers]},
+	{http.MethodDelete, "/config/identity/v1/mfa-servers/{id}", "MFAServersAPIService.DeleteMFAServersByID", nil},
+	{http.MethodGet, "/config/identity/v1/mfa-servers/{id}", "MFAServersAPIService.GetMFAServersByID", nil},
+	{http.MethodPut, "/config/identity/v1/mfa-servers/{id}", "MFAServersAPIService.UpdateMFAServersByID", newBody[identity_services.MfaServers]},
+	{http.MethodGet, "/config/identity/v1/ocsp-responders", "OCSPRespondersAPIService.ListOCSPResponders", nil},
+	{http.MethodPost, "/config/identity/v1/ocsp-responders", "OCSPRespondersAPIService.CreateOCSPResponders", newBody[identity_services.OcspResponders]},
+	{http.MethodDelete, "/config/identity/v1/ocsp-responders/{id}", "OCSPRespondersAPIService.DeleteOCSPRespondersByID", nil},
+	{http.MethodGet, "/config/identity/v1/ocsp-responders/{id}", "OCSPRespondersAPIService.GetOCSPRespondersByID", nil},
+	{http.MethodPut, "/config/identity/v1/ocsp-responders/{id}", "OCSPRespondersAPIService.UpdateOCSPRespondersByID", newBody[identity_services.OcspResponders]},
+	{http.MethodGet, "/config/identity/v1/radius-server-profiles", "RADIUSServerProfilesAPIService.ListRADIUSServerProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/radius-server-profiles", "RADIUSServerProfilesAPIService.CreateRADIUSServerProfiles", newBody[identity_services.RadiusServerProfiles]},
+	{http.MethodDelete, "/config/identity/v1/radius-server-profiles/{id}", "RADIUSServerProfilesAPIService.DeleteRADIUSServerProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/radius-server-profiles/{id}", "RADIUSServerProfilesAPIService.GetRADIUSServerProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/radius-server-profiles/{id}", "RADIUSServerProfilesAPIService.UpdateRADIUSServerProfilesByID", newBody[identity_services.RadiusServerProfiles]},
+	{http.MethodGet, "/config/identity/v1/saml-server-profiles", "SAMLServerProfilesAPIService.ListSAMLServerProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/saml-server-profiles", "SAMLServerProfilesAPIService.CreateSAMLServerProfiles", newBody[identity_services.SamlServerProfiles]},
+	{http.MethodDelete, "/config/identity/v1/saml-server-profiles/{id}", "SAMLServerProfilesAPIService.DeleteSAMLServerProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/saml-server-profiles/{id}", "SAMLServerProfilesAPIService.GetSAMLServerProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/saml-server-profiles/{id}", "SAMLServerProfilesAPIService.UpdateSAMLServerProfilesByID", newBody[identity_services.SamlServerProfiles]},
+	{http.MethodGet, "/config/identity/v1/scep-profiles", "SCEPProfilesAPIService.ListSCEPProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/scep-profiles", "SCEPProfilesAPIService.CreateSCEPProfiles", newBody[identity_services.ScepProfiles]},
+	{http.MethodDelete, "/config/identity/v1/scep-profiles/{id}", "SCEPProfilesAPIService.DeleteSCEPProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/scep-profiles/{id}", "SCEPProfilesAPIService.GetSCEPProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/scep-profiles/{id}", "SCEPProfilesAPIService.UpdateSCEPProfilesByID", newBody[identity_services.ScepProfiles]},
+	{http.MethodGet, "/config/identity/v1/tacacs-server-profiles", "TACACSServerProfilesAPIService.ListTACACSServerProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/tacacs-server-profiles", "TACACSServerProfilesAPIService.CreateTACACSServerProfiles", newBody[identity_services.TacacsServerProfiles]},
+	{http.MethodDelete, "/config/identity/v1/tacacs-server-profiles/{id}", "TACACSServerProfilesAPIService.DeleteTACACSServerProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/tacacs-server-profiles/{id}", "TACACSServerProfilesAPIService.GetTACACSServerProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/tacacs-server-profiles/{id}", "TACACSServerProfilesAPIService.UpdateTACACSServerProfilesByID", newBody[identity_services.TacacsServerProfiles]},
+	{http.MethodGet, "/config/identity/v1/tls-service-profiles", "TLSServiceProfilesAPIService.ListTLSServiceProfiles", nil},
+	{http.MethodPost, "/config/identity/v1/tls-service-profiles", "TLSServiceProfilesAPIService.CreateTLSServiceProfiles", newBody[identity_services.TlsServiceProfiles]},
+	{http.MethodDelete, "/config/identity/v1/tls-service-profiles/{id}", "TLSServiceProfilesAPIService.DeleteTLSServiceProfilesByID", nil},
+	{http.MethodGet, "/config/identity/v1/tls-service-profiles/{id}", "TLSServiceProfilesAPIService.GetTLSServiceProfilesByID", nil},
+	{http.MethodPut, "/config/identity/v1/tls-service-profiles/{id}", "TLSServiceProfilesAPIService.UpdateTLSServiceProfilesByID", newBody[identity_services.TlsServiceProfiles]},
+	{http.MethodGet, "/config/identity/v1/trusted-certificate-authorities", "TrustedCertificateAuthoritiesAPIService.ListTrustedCertificateAuthorities", nil},
+	{http.MethodGet, "/config/network/v1/aggregate-interfaces", "AggregateInterfacesAPIService.ListAggregateInterfaces", nil},
+	{http.MethodPost, "/config/network/v1/aggregate-interfaces", "AggregateInterfacesAPIService.CreateAggregateInterfaces", newBody[network_services.AggregateInterfaces]},
+	{http.MethodDelete, "/config/network/v1/aggregate-interfaces/{id}", "AggregateInterfacesAPIService.DeleteAggregateInterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/aggregate-interfaces/{id}", "AggregateInterfacesAPIService.GetAggregateInterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/aggregate-interfaces/{id}", "AggregateInterfacesAPIService.UpdateAggregateInterfacesByID", newBody[network_services.AggregateInterfaces]},
+	{http.MethodGet, "/config/network/v1/auto-vpn-clusters", "AutoVPNClustersAPIService.ListAutoVPNClusters", nil},
+	{http.MethodPost, "/config/network/v1/auto-vpn-clusters", "AutoVPNClustersAPIService.CreateAutoVPNClusters", newBody[network_services.AutoVpnClusters]},
+	{http.MethodDelete, "/config/network/v1/auto-vpn-clusters/{id}", "AutoVPNClustersAPIService.DeleteAutoVPNClustersByID", nil},
+	{http.MethodGet, "/config/network/v1/auto-vpn-clusters/{id}", "AutoVPNClustersAPIService.GetAutoVPNClustersByID", nil},
+	{http.MethodPut, "/config/network/v1/auto-vpn-clusters/{id}", "AutoVPNClustersAPIService.UpdateAutoVPNClustersByID", newBody[network_services.AutoVpnClusters]},
+	{http.MethodGet, "/config/network/v1/auto-vpn-monitor", "AutoVPNMonitorAPIService.GetAutoVPNMonitor", nil},
+	{http.MethodPost, "/config/network/v1/auto-vpn-push", "AutoVPNConfigPushAPIService.CreateAutoVPNPushConfigs", newBody[network_services.AutoVpnPushConfig]},
+	{http.MethodGet, "/config/network/v1/auto-vpn-settings", "AutoVPNSettingsAPIService.GetAutoVPNSettings", nil},
+	{http.MethodPut, "/config/network/v1/auto-vpn-settings", "AutoVPNSettingsAPIService.UpdateAutoVPNSettings", newBody[network_services.AutoVpnSettings]},
+	{http.MethodGet, "/config/network/v1/bgp-address-family-profiles", "BGPAddressFamilyProfilesAPIService.ListBGPAddressFamilyProfiles", nil},
+	{http.MethodPost, "/config/network/v1/bgp-address-family-profiles", "BGPAddressFamilyProfilesAPIService.CreateBGPAddressFamilyProfiles", newBody[network_services.BgpAddressFamilyProfiles]},
+	{http.MethodDelete, "/config/network/v1/bgp-address-family-profiles/{id}", "BGPAddressFamilyProfilesAPIService.DeleteBGPAddressFamilyProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/bgp-address-family-profiles/{id}", "BGPAddressFamilyProfilesAPIService.GetBGPAddressFamilyProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/bgp-address-family-profiles/{id}", "BGPAddressFamilyProfilesAPIService.UpdateBGPAddressFamilyProfilesByID", newBody[network_services.BgpAddressFamilyProfiles]},
+	{http.MethodGet, "/config/network/v1/bgp-auth-profiles", "BGPAuthenticationProfilesAPIService.ListBGPAuthenticationProfiles", nil},
+	{http.MethodPost, "/config/network/v1/bgp-auth-profiles", "BGPAuthenticationProfilesAPIService.CreateBGPAuthenticationProfiles", newBody[network_services.BgpAuthProfiles]},
+	{http.MethodDelete, "/config/network/v1/bgp-auth-profiles/{id}", "BGPAuthenticationProfilesAPIService.DeleteBGPAuthenticationProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/bgp-auth-profiles/{id}", "BGPAuthenticationProfilesAPIService.GetBGPAuthenticationProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/bgp-auth-profiles/{id}", "BGPAuthenticationProfilesAPIService.UpdateBGPAuthenticationProfilesByID", newBody[network_services.BgpAuthProfiles]},
+	{http.MethodGet, "/config/network/v1/bgp-filtering-profiles", "BGPFilteringProfilesAPIService.ListBGPFilteringProfiles", nil},
+	{http.MethodPost, "/config/network/v1/bgp-filtering-profiles", "BGPFilteringProfilesAPIService.CreateBGPFilteringProfiles", newBody[network_services.BgpFilteringProfiles]},
+	{http.MethodDelete, "/config/network/v1/bgp-filtering-profiles/{id}", "BGPFilteringProfilesAPIService.DeleteBGPFilteringProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/bgp-filtering-profiles/{id}", "BGPFilteringProfilesAPIService.GetBGPFilteringProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/bgp-filtering-profiles/{id}", "BGPFilteringProfilesAPIService.UpdateBGPFilteringProfilesByID", newBody[network_services.BgpFilteringProfiles]},
+	{http.MethodGet, "/config/network/v1/bgp-redistribution-profiles", "BGPRedistributionProfilesAPIService.ListBGPRedistributionProfiles", nil},
+	{http.MethodPost, "/config/network/v1/bgp-redistribution-profiles", "BGPRedistributionProfilesAPIService.CreateBGPRedistributionProfiles", newBody[network_services.BgpRedistributionProfiles]},
+	{http.MethodDelete, "/config/network/v1/bgp-redistribution-profiles/{id}", "BGPRedistributionProfilesAPIService.DeleteBGPRedistributionProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/bgp-redistribution-profiles/{id}", "BGPRedistributionProfilesAPIService.GetBGPRedistributionProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/bgp-redistribution-profiles/{id}", "BGPRedistributionProfilesAPIService.UpdateBGPRedistributionProfilesByID", newBody[network_services.BgpRedistributionProfiles]},
+	{http.MethodGet, "/config/network/v1/bgp-route-map-redistributions", "BGPRouteMapRedistributionsAPIService.ListBGPRouteMapRedistributions", nil},
+	{http.MethodPost, "/config/network/v1/bgp-route-map-redistributions", "BGPRouteMapRedistributionsAPIService.CreateBGPRouteMapRedistributions", newBody[network_services.BgpRouteMapRedistributions]},
+	{http.MethodDelete, "/config/network/v1/bgp-route-map-redistributions/{id}", "BGPRouteMapRedistributionsAPIService.DeleteBGPRouteMapRedistributionsByID", nil},
+	{http.MethodGet, "/config/network/v1/bgp-route-map-redistributions/{id}", "BGPRouteMapRedistributionsAPIService.GetBGPRouteMapRedistributionsByID", nil},
+	{http.MethodPut, "/config/network/v1/bgp-route-map-redistributions/{id}", "BGPRouteMapRedistributionsAPIService.UpdateBGPRouteMapRedistributionsByID", newBody[network_services.BgpRouteMapRedistributions]},
+	{http.MethodGet, "/config/network/v1/bgp-route-maps", "BGPRouteMapsAPIService.ListBGPRouteMaps", nil},
+	{http.MethodPost, "/config/network/v1/bgp-route-maps", "BGPRouteMapsAPIService.CreateBGPRouteMaps", newBody[network_services.BgpRouteMaps]},
+	{http.MethodDelete, "/config/network/v1/bgp-route-maps/{id}", "BGPRouteMapsAPIService.DeleteBGPRouteMapsByID", nil},
+	{http.MethodGet, "/config/network/v1/bgp-route-maps/{id}", "BGPRouteMapsAPIService.GetBGPRouteMapsByID", nil},
+	{http.MethodPut, "/config/network/v1/bgp-route-maps/{id}", "BGPRouteMapsAPIService.UpdateBGPRouteMapsByID", newBody[network_services.BgpRouteMaps]},
+	{http.MethodGet, "/config/network/v1/config-match-list", "ConfigMatchListAPIService.ListConfigMatchList", nil},
+	{http.MethodPost, "/config/network/v1/config-match-list", "ConfigMatchListAPIService.CreateConfigMatchList", newBody[network_services.ConfigMatchList]},
+	{http.MethodDelete, "/config/network/v1/config-match-list/{id}", "ConfigMatchListAPIService.DeleteConfigMatchListByID", nil},
+	{http.MethodGet, "/config/network/v1/config-match-list/{id}", "ConfigMatchListAPIService.GetConfigMatchListByID", nil},
+	{http.MethodPut, "/config/network/v1/config-match-list/{id}", "ConfigMatchListAPIService.UpdateConfigMatchListByID", newBody[network_services.ConfigMatchList]},
+	{http.MethodGet, "/config/network/v1/dhcp-interfaces", "DHCPInterfacesAPIService.ListDHCPInterfaces", nil},
+	{http.MethodPost, "/config/network/v1/dhcp-interfaces", "DHCPInterfacesAPIService.CreateDHCPInterfaces", newBody[network_services.DhcpInterfaces]},
+	{http.MethodDelete, "/config/network/v1/dhcp-interfaces/{id}", "DHCPInterfacesAPIService.DeleteDHCPInterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/dhcp-interfaces/{id}", "DHCPInterfacesAPIService.GetDHCPInterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/dhcp-interfaces/{id}", "DHCPInterfacesAPIService.UpdateDHCPInterfacesByID", newBody[network_services.DhcpInterfaces]},
+	{http.MethodGet, "/config/network/v1/dns-proxies", "DNSProxiesAPIService.ListDNSProxies", nil},
+	{http.MethodPost, "/config/network/v1/dns-proxies", "DNSProxiesAPIService.CreateDNSProxies", newBody[network_services.DnsProxies]},
+	{http.MethodDelete, "/config/network/v1/dns-proxies/{id}", "DNSProxiesAPIService.DeleteDNSProxiesByID", nil},
+	{http.MethodGet, "/config/network/v1/dns-proxies/{id}", "DNSProxiesAPIService.GetDNSProxiesByID", nil},
+	{http.MethodPut, "/config/network/v1/dns-proxies/{id}", "DNSProxiesAPIService.UpdateDNSProxiesByID", newBody[network_services.DnsProxies]},
+	{http.MethodGet, "/config/network/v1/ethernet-interfaces", "EthernetInterfacesAPIService.ListEthernetInterfaces", nil},
+	{http.MethodPost, "/config/network/v1/ethernet-interfaces", "EthernetInterfacesAPIService.CreateEthernetInterfaces", newBody[network_services.EthernetInterfaces]},
+	{http.MethodDelete, "/config/network/v1/ethernet-interfaces/{id}", "EthernetInterfacesAPIService.DeleteEthernetInterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/ethernet-interfaces/{id}", "EthernetInterfacesAPIService.GetEthernetInterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/ethernet-interfaces/{id}", "EthernetInterfacesAPIService.UpdateEthernetInterfacesByID", newBody[network_services.EthernetInterfaces]},
+	{http.MethodGet, "/config/network/v1/globalprotect-match-list", "GlobalprotectMatchListAPIService.ListGlobalprotectMatchList", nil},
+	{http.MethodPost, "/config/network/v1/globalprotect-match-list", "GlobalprotectMatchListAPIService.CreateGlobalprotectMatchList", newBody[network_services.GlobalprotectMatchList]},
+	{http.MethodDelete, "/config/network/v1/globalprotect-match-list/{id}", "GlobalprotectMatchListAPIService.DeleteGlobalprotectMatchListByID", nil},
+	{http.MethodGet, "/config/network/v1/globalprotect-match-list/{id}", "GlobalprotectMatchListAPIService.GetGlobalprotectMatchListByID", nil},
+	{http.MethodPut, "/config/network/v1/globalprotect-match-list/{id}", "GlobalprotectMatchListAPIService.UpdateGlobalprotectMatchListByID", newBody[network_services.GlobalprotectMatchList]},
+	{http.MethodGet, "/config/network/v1/hipmatch-match-list", "HipmatchMatchListAPIService.ListHipmatchMatchList", nil},
+	{http.MethodPost, "/config/network/v1/hipmatch-match-list", "HipmatchMatchListAPIService.CreateHipmatchMatchList", newBody[network_services.HipmatchMatchList]},
+	{http.MethodDelete, "/config/network/v1/hipmatch-match-list/{id}", "HipmatchMatchListAPIService.DeleteHipmatchMatchListByID", nil},
+	{http.MethodGet, "/config/network/v1/hipmatch-match-list/{id}", "HipmatchMatchListAPIService.GetHipmatchMatchListByID", nil},
+	{http.MethodPut, "/config/network/v1/hipmatch-match-list/{id}", "HipmatchMatchListAPIService.UpdateHipmatchMatchListByID", newBody[network_services.HipmatchMatchList]},
+	{http.MethodGet, "/config/network/v1/ike-crypto-profiles", "IKECryptoProfilesAPIService.ListIKECryptoProfiles", nil},
+	{http.MethodPost, "/config/network/v1/ike-crypto-profiles", "IKECryptoProfilesAPIService.CreateIKECryptoProfiles", newBody[network_services.IkeCryptoProfiles]},
+	{http.MethodDelete, "/config/network/v1/ike-crypto-profiles/{id}", "IKECryptoProfilesAPIService.DeleteIKECryptoProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/ike-crypto-profiles/{id}", "IKECryptoProfilesAPIService.GetIKECryptoProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/ike-crypto-profiles/{id}", "IKECryptoProfilesAPIService.UpdateIKECryptoProfilesByID", newBody[network_services.IkeCryptoProfiles]},
+	{http.MethodGet, "/config/network/v1/ike-gateways", "IKEGatewaysAPIService.ListIKEGateways", nil},
+	{http.MethodPost, "/config/network/v1/ike-gateways", "IKEGatewaysAPIService.CreateIKEGateways", newBody[network_services.IkeGateways]},
+	{http.MethodDelete, "/config/network/v1/ike-gateways/{id}", "IKEGatewaysAPIService.DeleteIKEGatewaysByID", nil},
+	{http.MethodGet, "/config/network/v1/ike-gateways/{id}", "IKEGatewaysAPIService.GetIKEGatewaysByID", nil},
+	{http.MethodPut, "/config/network/v1/ike-gateways/{id}", "IKEGatewaysAPIService.UpdateIKEGatewaysByID", newBody[network_services.IkeGateways]},
+	{http.MethodGet, "/config/network/v1/interface-management-profiles", "InterfaceManagementProfilesAPIService.ListInterfaceManagementProfiles", nil},
+	{http.MethodPost, "/config/network/v1/interface-management-profiles", "InterfaceManagementProfilesAPIService.CreateInterfaceManagementProfiles", newBody[network_services.InterfaceManagementProfiles]},
+	{http.MethodDelete, "/config/network/v1/interface-management-profiles/{id}", "InterfaceManagementProfilesAPIService.DeleteInterfaceManagementProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/interface-management-profiles/{id}", "InterfaceManagementProfilesAPIService.GetInterfaceManagementProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/interface-management-profiles/{id}", "InterfaceManagementProfilesAPIService.UpdateInterfaceManagementProfilesByID", newBody[network_services.InterfaceManagementProfiles]},
+	{http.MethodGet, "/config/network/v1/ipsec-crypto-profiles", "IPsecCryptoProfilesAPIService.ListIPsecCryptoProfiles", nil},
+	{http.MethodPost, "/config/network/v1/ipsec-crypto-profiles", "IPsecCryptoProfilesAPIService.CreateIPsecCryptoProfiles", newBody[network_services.IpsecCryptoProfiles]},
+	{http.MethodDelete, "/config/network/v1/ipsec-crypto-profiles/{id}", "IPsecCryptoProfilesAPIService.DeleteIPsecCryptoProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/ipsec-crypto-profiles/{id}", "IPsecCryptoProfilesAPIService.GetIPsecCryptoProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/ipsec-crypto-profiles/{id}", "IPsecCryptoProfilesAPIService.UpdateIPsecCryptoProfilesByID", newBody[network_services.IpsecCryptoProfiles]},
+	{http.MethodGet, "/config/network/v1/ipsec-tunnels", "IPsecTunnelsAPIService.ListIPsecTunnels", nil},
+	{http.MethodPost, "/config/network/v1/ipsec-tunnels", "IPsecTunnelsAPIService.CreateIPsecTunnels", newBody[network_services.IpsecTunnels]},
+	{http.MethodDelete, "/config/network/v1/ipsec-tunnels/{id}", "IPsecTunnelsAPIService.DeleteIPsecTunnelsByID", nil},
+	{http.MethodGet, "/config/network/v1/ipsec-tunnels/{id}", "IPsecTunnelsAPIService.GetIPsecTunnelsByID", nil},
+	{http.MethodPut, "/config/network/v1/ipsec-tunnels/{id}", "IPsecTunnelsAPIService.UpdateIPsecTunnelsByID", newBody[network_services.IpsecTunnels]},
+	{http.MethodGet, "/config/network/v1/iptag-match-list", "IptagMatchListAPIService.ListIptagMatchList", nil},
+	{http.MethodPost, "/config/network/v1/iptag-match-list", "IptagMatchListAPIService.CreateIptagMatchList", newBody[network_services.IptagMatchList]},
+	{http.MethodDelete, "/config/network/v1/iptag-match-list/{id}", "IptagMatchListAPIService.DeleteIptagMatchListByID", nil},
+	{http.MethodGet, "/config/network/v1/iptag-match-list/{id}", "IptagMatchListAPIService.GetIptagMatchListByID", nil},
+	{http.MethodPut, "/config/network/v1/iptag-match-list/{id}", "IptagMatchListAPIService.UpdateIptagMatchListByID", newBody[network_services.IptagMatchList]},
+	{http.MethodGet, "/config/network/v1/layer2-subinterfaces", "Layer2SubinterfacesAPIService.ListLayer2Subinterfaces", nil},
+	{http.MethodPost, "/config/network/v1/layer2-subinterfaces", "Layer2SubinterfacesAPIService.CreateLayer2Subinterfaces", newBody[network_services.Layer2Subinterfaces]},
+	{http.MethodDelete, "/config/network/v1/layer2-subinterfaces/{id}", "Layer2SubinterfacesAPIService.DeleteLayer2SubinterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/layer2-subinterfaces/{id}", "Layer2SubinterfacesAPIService.GetLayer2SubinterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/layer2-subinterfaces/{id}", "Layer2SubinterfacesAPIService.UpdateLayer2SubinterfacesByID", newBody[network_services.Layer2Subinterfaces]},
+	{http.MethodGet, "/config/network/v1/layer3-subinterfaces", "Layer3SubinterfacesAPIService.ListLayer3Subinterfaces", nil},
+	{http.MethodPost, "/config/network/v1/layer3-subinterfaces", "Layer3SubinterfacesAPIService.CreateLayer3Subinterfaces", newBody[network_services.Layer3Subinterfaces]},
+	{http.MethodDelete, "/config/network/v1/layer3-subinterfaces/{id}", "Layer3SubinterfacesAPIService.DeleteLayer3SubinterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/layer3-subinterfaces/{id}", "Layer3SubinterfacesAPIService.GetLayer3SubinterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/layer3-subinterfaces/{id}", "Layer3SubinterfacesAPIService.UpdateLayer3SubinterfacesByID", newBody[network_services.Layer3Subinterfaces]},
+	{http.MethodGet, "/config/network/v1/link-tags", "LinkTagsAPIService.ListLinkTags", nil},
+	{http.MethodPost, "/config/network/v1/link-tags", "LinkTagsAPIService.CreateLinkTags", newBody[network_services.LinkTags]},
+	{http.MethodDelete, "/config/network/v1/link-tags/{id}", "LinkTagsAPIService.DeleteLinkTagsByID", nil},
+	{http.MethodGet, "/config/network/v1/link-tags/{id}", "LinkTagsAPIService.GetLinkTagsByID", nil},
+	{http.MethodPut, "/config/network/v1/link-tags/{id}", "LinkTagsAPIService.UpdateLinkTagsByID", newBody[network_services.LinkTags]},
+	{http.MethodGet, "/config/network/v1/lldp-profiles", "LLDPProfilesAPIService.ListLLDPProfiles", nil},
+	{http.MethodPost, "/config/network/v1/lldp-profiles", "LLDPProfilesAPIService.CreateLLDPProfiles", newBody[network_services.LldpProfiles]},
+	{http.MethodDelete, "/config/network/v1/lldp-profiles/{id}", "LLDPProfilesAPIService.DeleteLLDPProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/lldp-profiles/{id}", "LLDPProfilesAPIService.GetLLDPProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/lldp-profiles/{id}", "LLDPProfilesAPIService.UpdateLLDPProfilesByID", newBody[network_services.LldpProfiles]},
+	{http.MethodGet, "/config/network/v1/logical-routers", "LogicalRoutersAPIService.ListLogicalRouters", nil},
+	{http.MethodPost, "/config/network/v1/logical-routers", "LogicalRoutersAPIService.CreateLogicalRouters", newBody[network_services.LogicalRouters]},
+	{http.MethodDelete, "/config/network/v1/logical-routers/{id}", "LogicalRoutersAPIService.DeleteLogicalRoutersByID", nil},
+	{http.MethodGet, "/config/network/v1/logical-routers/{id}", "LogicalRoutersAPIService.GetLogicalRoutersByID", nil},
+	{http.MethodPut, "/config/network/v1/logical-routers/{id}", "LogicalRoutersAPIService.UpdateLogicalRoutersByID", newBody[network_services.LogicalRouters]},
+	{http.MethodGet, "/config/network/v1/loopback-interfaces", "LoopbackInterfacesAPIService.ListLoopbackInterfaces", nil},
+	{http.MethodPost, "/config/network/v1/loopback-interfaces", "LoopbackInterfacesAPIService.CreateLoopbackInterfaces", newBody[network_services.LoopbackInterfaces]},
+	{http.MethodDelete, "/config/network/v1/loopback-interfaces/{id}", "LoopbackInterfacesAPIService.DeleteLoopbackInterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/loopback-interfaces/{id}", "LoopbackInterfacesAPIService.GetLoopbackInterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/loopback-interfaces/{id}", "LoopbackInterfacesAPIService.UpdateLoopbackInterfacesByID", newBody[network_services.LoopbackInterfaces]},
+	{http.MethodGet, "/config/network/v1/nat-rules", "NATRulesAPIService.ListNatRules", nil},
+	{http.MethodPost, "/config/network/v1/nat-rules", "NATRulesAPIService.CreateNatRules", newBody[network_services.NatRules]},
+	{http.MethodDelete, "/config/network/v1/nat-rules/{id}", "NATRulesAPIService.DeleteNatRulesByID", nil},
+	{http.MethodGet, "/config/network/v1/nat-rules/{id}", "NATRulesAPIService.GetNatRulesByID", nil},
+	{http.MethodPut, "/config/network/v1/nat-rules/{id}", "NATRulesAPIService.UpdateNatRulesByID", newBody[network_services.NatRules]},
+	{http.MethodGet, "/config/network/v1/ospf-auth-profiles", "OSPFAuthenticationProfilesAPIService.ListOSPFAuthenticationProfiles", nil},
+	{http.MethodPost, "/config/network/v1/ospf-auth-profiles", "OSPFAuthenticationProfilesAPIService.CreateOSPFAuthenticationProfiles", newBody[network_services.OspfAuthProfiles]},
+	{http.MethodDelete, "/config/network/v1/ospf-auth-profiles/{id}", "OSPFAuthenticationProfilesAPIService.DeleteOSPFAuthenticationProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/ospf-auth-profiles/{id}", "OSPFAuthenticationProfilesAPIService.GetOSPFAuthenticationProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/ospf-auth-profiles/{id}", "OSPFAuthenticationProfilesAPIService.UpdateOSPFAuthenticationProfilesByID", newBody[network_services.OspfAuthProfiles]},
+	{http.MethodGet, "/config/network/v1/pbf-rules", "PBFRulesAPIService.ListPBFRules", nil},
+	{http.MethodPost, "/config/network/v1/pbf-rules", "PBFRulesAPIService.CreatePBFRules", newBody[network_services.PbfRules]},
+	{http.MethodDelete, "/config/network/v1/pbf-rules/{id}", "PBFRulesAPIService.DeletePBFRulesByID", nil},
+	{http.MethodGet, "/config/network/v1/pbf-rules/{id}", "PBFRulesAPIService.GetPBFRulesByID", nil},
+	{http.MethodPut, "/config/network/v1/pbf-rules/{id}", "PBFRulesAPIService.UpdatePBFRulesByID", newBody[network_services.PbfRules]},
+	{http.MethodGet, "/config/network/v1/qos-policy-rules", "QoSRulesAPIService.ListQoSPolicyRules", nil},
+	{http.MethodPost, "/config/network/v1/qos-policy-rules", "QoSRulesAPIService.CreateQoSPolicyRules", newBody[network_services.QosPolicyRules]},
+	{http.MethodDelete, "/config/network/v1/qos-policy-rules/{id}", "QoSRulesAPIService.DeleteQoSPolicyRulesByID", nil},
+	{http.MethodGet, "/config/network/v1/qos-policy-rules/{id}", "QoSRulesAPIService.GetQoSPolicyRulesByID", nil},
+	{http.MethodPut, "/config/network/v1/qos-policy-rules/{id}", "QoSRulesAPIService.UpdateQoSPolicyRulesByID", newBody[network_services.QosPolicyRules]},
+	{http.MethodPost, "/config/network/v1/qos-policy-rules/{id}:move", "QoSRulesAPIService.MoveQoSPolicyRulesByID", newBody[network_services.RuleBasedMove]},
+	{http.MethodGet, "/config/network/v1/qos-profiles", "QoSProfilesAPIService.ListQoSProfiles", nil},
+	{http.MethodPost, "/config/network/v1/qos-profiles", "QoSProfilesAPIService.CreateQoSProfiles", newBody[network_services.QosProfiles]},
+	{http.MethodDelete, "/config/network/v1/qos-profiles/{id}", "QoSProfilesAPIService.DeleteQoSProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/qos-profiles/{id}", "QoSProfilesAPIService.GetQoSProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/qos-profiles/{id}", "QoSProfilesAPIService.UpdateQoSProfilesByID", newBody[network_services.QosProfiles]},
+	{http.MethodGet, "/config/network/v1/remote-networks-license-info", "RemoteNetworksLicenseAPIService.GetRemoteNetworksLicenseInfo", nil},
+	{http.MethodGet, "/config/network/v1/route-access-lists", "RouteAccessListsAPIService.ListRouteAccessLists", nil},
+	{http.MethodPost, "/config/network/v1/route-access-lists", "RouteAccessListsAPIService.CreateRouteAccessLists", newBody[network_services.RouteAccessLists]},
+	{http.MethodDelete, "/config/network/v1/route-access-lists/{id}", "RouteAccessListsAPIService.DeleteRouteAccessListsByID", nil},
+	{http.MethodGet, "/config/network/v1/route-access-lists/{id}", "RouteAccessListsAPIService.GetRouteAccessListsByID", nil},
+	{http.MethodPut, "/config/network/v1/route-access-lists/{id}", "RouteAccessListsAPIService.UpdateRouteAccessListsByID", newBody[network_services.RouteAccessLists]},
+	{http.MethodGet, "/config/network/v1/route-community-lists", "RouteCommunityListsAPIService.ListRouteCommunityLists", nil},
+	{http.MethodPost, "/config/network/v1/route-community-lists", "RouteCommunityListsAPIService.CreateRouteCommunityLists", newBody[network_services.RouteCommunityLists]},
+	{http.MethodDelete, "/config/network/v1/route-community-lists/{id}", "RouteCommunityListsAPIService.DeleteRouteCommunityListsByID", nil},
+	{http.MethodGet, "/config/network/v1/route-community-lists/{id}", "RouteCommunityListsAPIService.GetRouteCommunityListsByID", nil},
+	{http.MethodPut, "/config/network/v1/route-community-lists/{id}", "RouteCommunityListsAPIService.UpdateRouteCommunityListsByID", newBody[network_services.RouteCommunityLists]},
+	{http.MethodGet, "/config/network/v1/route-path-access-lists", "RoutePathAccessListsAPIService.ListRoutePathAccessLists", nil},
+	{http.MethodPost, "/config/network/v1/route-path-access-lists", "RoutePathAccessListsAPIService.CreateRoutePathAccessLists", newBody[network_services.RoutePathAccessLists]},
+	{http.MethodDelete, "/config/network/v1/route-path-access-lists/{id}", "RoutePathAccessListsAPIService.DeleteRoutePathAccessListsByID", nil},
+	{http.MethodGet, "/config/network/v1/route-path-access-lists/{id}", "RoutePathAccessListsAPIService.GetRoutePathAccessListsByID", nil},
+	{http.MethodPut, "/config/network/v1/route-path-access-lists/{id}", "RoutePathAccessListsAPIService.UpdateRoutePathAccessListsByID", newBody[network_services.RoutePathAccessLists]},
+	{http.MethodGet, "/config/network/v1/route-prefix-lists", "RoutePrefixListsAPIService.ListRoutePrefixLists", nil},
+	{http.MethodPost, "/config/network/v1/route-prefix-lists", "RoutePrefixListsAPIService.CreateRoutePrefixLists", newBody[network_services.RoutePrefixLists]},
+	{http.MethodDelete, "/config/network/v1/route-prefix-lists/{id}", "RoutePrefixListsAPIService.DeleteRoutePrefixListsByID", nil},
+	{http.MethodGet, "/config/network/v1/route-prefix-lists/{id}", "RoutePrefixListsAPIService.GetRoutePrefixListsByID", nil},
+	{http.MethodPut, "/config/network/v1/route-prefix-lists/{id}", "RoutePrefixListsAPIService.UpdateRoutePrefixListsByID", newBody[network_services.RoutePrefixLists]},
+	{http.MethodGet, "/config/network/v1/sdwan-error-correction-profiles", "SDWANErrorCorrectionProfilesAPIService.ListSDWANErrorCorrectionProfiles", nil},
+	{http.MethodPost, "/config/network/v1/sdwan-error-correction-profiles", "SDWANErrorCorrectionProfilesAPIService.CreateSDWANErrorCorrectionProfiles", newBody[network_services.SdwanErrorCorrectionProfiles]},
+	{http.MethodDelete, "/config/network/v1/sdwan-error-correction-profiles/{id}", "SDWANErrorCorrectionProfilesAPIService.DeleteSDWANErrorCorrectionProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/sdwan-error-correction-profiles/{id}", "SDWANErrorCorrectionProfilesAPIService.GetSDWANErrorCorrectionProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/sdwan-error-correction-profiles/{id}", "SDWANErrorCorrectionProfilesAPIService.UpdateSDWANErrorCorrectionProfilesByID", newBody[network_services.SdwanErrorCorrectionProfiles]},
+	{http.MethodGet, "/config/network/v1/sdwan-path-quality-profiles", "SDWANPathQualityProfilesAPIService.ListSDWANPathQualityProfiles", nil},
+	{http.MethodPost, "/config/network/v1/sdwan-path-quality-profiles", "SDWANPathQualityProfilesAPIService.CreateSDWANPathQualityProfiles", newBody[network_services.SdwanPathQualityProfiles]},
+	{http.MethodDelete, "/config/network/v1/sdwan-path-quality-profiles/{id}", "SDWANPathQualityProfilesAPIService.DeleteSDWANPathQualityProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/sdwan-path-quality-profiles/{id}", "SDWANPathQualityProfilesAPIService.GetSDWANPathQualityProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/sdwan-path-quality-profiles/{id}", "SDWANPathQualityProfilesAPIService.UpdateSDWANPathQualityProfilesByID", newBody[network_services.SdwanPathQualityProfiles]},
+	{http.MethodGet, "/config/network/v1/sdwan-rules", "SDWANRulesAPIService.ListSDWANRules", nil},
+	{http.MethodPost, "/config/network/v1/sdwan-rules", "SDWANRulesAPIService.CreateSDWANRules", newBody[network_services.SdwanRules]},
+	{http.MethodDelete, "/config/network/v1/sdwan-rules/{id}", "SDWANRulesAPIService.DeleteSDWANRulesByID", nil},
+	{http.MethodGet, "/config/network/v1/sdwan-rules/{id}", "SDWANRulesAPIService.GetSDWANRulesByID", nil},
+	{http.MethodPut, "/config/network/v1/sdwan-rules/{id}", "SDWANRulesAPIService.UpdateSDWANRulesByID", newBody[network_services.SdwanRules]},
+	{http.MethodGet, "/config/network/v1/sdwan-saas-quality-profiles", "SDWANSaaSQualityProfilesAPIService.ListSDWANSaaSQualityProfiles", nil},
+	{http.MethodPost, "/config/network/v1/sdwan-saas-quality-profiles", "SDWANSaaSQualityProfilesAPIService.CreateSDWANSaaSQualityProfiles", newBody[network_services.SdwanSaasQualityProfiles]},
+	{http.MethodDelete, "/config/network/v1/sdwan-saas-quality-profiles/{id}", "SDWANSaaSQualityProfilesAPIService.DeleteSDWANSaaSQualityProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/sdwan-saas-quality-profiles/{id}", "SDWANSaaSQualityProfilesAPIService.GetSDWANSaaSQualityProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/sdwan-saas-quality-profiles/{id}", "SDWANSaaSQualityProfilesAPIService.UpdateSDWANSaaSQualityProfilesByID", newBody[network_services.SdwanSaasQualityProfiles]},
+	{http.MethodGet, "/config/network/v1/sdwan-traffic-distribution-profiles", "SDWANTrafficDistributionProfilesAPIService.ListSDWANTrafficDistributionProfiles", nil},
+	{http.MethodPost, "/config/network/v1/sdwan-traffic-distribution-profiles", "SDWANTrafficDistributionProfilesAPIService.CreateSDWANTrafficDistributionProfiles", newBody[network_services.SdwanTrafficDistributionProfiles]},
+	{http.MethodDelete, "/config/network/v1/sdwan-traffic-distribution-profiles/{id}", "SDWANTrafficDistributionProfilesAPIService.DeleteSDWANTrafficDistributionProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/sdwan-traffic-distribution-profiles/{id}", "SDWANTrafficDistributionProfilesAPIService.GetSDWANTrafficDistributionProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/sdwan-traffic-distribution-profiles/{id}", "SDWANTrafficDistributionProfilesAPIService.UpdateSDWANTrafficDistributionProfilesByID", newBody[network_services.SdwanTrafficDistributionProfiles]},
+	{http.MethodGet, "/config/network/v1/system-match-list", "SystemMatchListAPIService.ListSystemMatchList", nil},
+	{http.MethodPost, "/config/network/v1/system-match-list", "SystemMatchListAPIService.CreateSystemMatchList", newBody[network_services.SystemMatchList]},
+	{http.MethodDelete, "/config/network/v1/system-match-list/{id}", "SystemMatchListAPIService.DeleteSystemMatchListByID", nil},
+	{http.MethodGet, "/config/network/v1/system-match-list/{id}", "SystemMatchListAPIService.GetSystemMatchListByID", nil},
+	{http.MethodPut, "/config/network/v1/system-match-list/{id}", "SystemMatchListAPIService.UpdateSystemMatchListByID", newBody[network_services.SystemMatchList]},
+	{http.MethodGet, "/config/network/v1/tunnel-interfaces", "TunnelInterfacesAPIService.ListTunnelInterfaces", nil},
+	{http.MethodPost, "/config/network/v1/tunnel-interfaces", "TunnelInterfacesAPIService.CreateTunnelInterfaces", newBody[network_services.TunnelInterfaces]},
+	{http.MethodDelete, "/config/network/v1/tunnel-interfaces/{id}", "TunnelInterfacesAPIService.DeleteTunnelInterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/tunnel-interfaces/{id}", "TunnelInterfacesAPIService.GetTunnelInterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/tunnel-interfaces/{id}", "TunnelInterfacesAPIService.UpdateTunnelInterfacesByID", newBody[network_services.TunnelInterfaces]},
+	{http.MethodGet, "/config/network/v1/userid-match-list", "UseridMatchListAPIService.ListUseridMatchList", nil},
+	{http.MethodPost, "/config/network/v1/userid-match-list", "UseridMatchListAPIService.CreateUseridMatchList", newBody[network_services.UseridMatchList]},
+	{http.MethodDelete, "/config/network/v1/userid-match-list/{id}", "UseridMatchListAPIService.DeleteUseridMatchListByID", nil},
+	{http.MethodGet, "/config/network/v1/userid-match-list/{id}", "UseridMatchListAPIService.GetUseridMatchListByID", nil},
+	{http.MethodPut, "/config/network/v1/userid-match-list/{id}", "UseridMatchListAPIService.UpdateUseridMatchListByID", newBody[network_services.UseridMatchList]},
+	{http.MethodGet, "/config/network/v1/vlan-interfaces", "VLANInterfacesAPIService.ListVLANInterfaces", nil},
+	{http.MethodPost, "/config/network/v1/vlan-interfaces", "VLANInterfacesAPIService.CreateVLANInterfaces", newBody[network_services.VlanInterfaces]},
+	{http.MethodDelete, "/config/network/v1/vlan-interfaces/{id}", "VLANInterfacesAPIService.DeleteVLANInterfacesByID", nil},
+	{http.MethodGet, "/config/network/v1/vlan-interfaces/{id}", "VLANInterfacesAPIService.GetVLANInterfacesByID", nil},
+	{http.MethodPut, "/config/network/v1/vlan-interfaces/{id}", "VLANInterfacesAPIService.UpdateVLANlInterfacesByID", newBody[network_services.VlanInterfaces]},
+	{http.MethodGet, "/config/network/v1/zone-protection-profiles", "ZoneProtectionProfilesAPIService.ListZoneProtectionProfiles", nil},
+	{http.MethodPost, "/config/network/v1/zone-protection-profiles", "ZoneProtectionProfilesAPIService.CreateZoneProtectionProfiles", newBody[network_services.ZoneProtectionProfiles]},
+	{http.MethodDelete, "/config/network/v1/zone-protection-profiles/{id}", "ZoneProtectionProfilesAPIService.DeleteZoneProtectionProfilesByID", nil},
+	{http.MethodGet, "/config/network/v1/zone-protection-profiles/{id}", "ZoneProtectionProfilesAPIService.GetZoneProtectionProfilesByID", nil},
+	{http.MethodPut, "/config/network/v1/zone-protection-profiles/{id}", "ZoneProtectionProfilesAPIService.UpdateZoneProtectionProfilesByID", newBody[network_services.ZoneProtectionProfiles]},
+	{http.MethodGet, "/config/network/v1/zones", "SecurityZonesAPIService.ListZones", nil},
+	{http.MethodPost, "/config/network/v1/zones", "SecurityZonesAPIService.CreateZones", newBody[network_services.Zones]},
+	{http.MethodDelete, "/config/network/v1/zones/{id}", "SecurityZonesAPIService.DeleteZonesByID", nil},
+	{http.MethodGet, "/config/network/v1/zones/{id}", "SecurityZonesAPIService.GetZonesByID", nil},
+	{http.MethodPut, "/config/network/v1/zones/{id}", "SecurityZonesAPIService.UpdateZonesByID", newBody[network_services.Zones]},
+	{http.MethodGet, "/config/objects/v1/address-groups", "AddressGroupsAPIService.ListAddressGroups", nil},
+	{http.MethodPost, "/config/objects/v1/address-groups", "AddressGroupsAPIService.CreateAddressGroups", newBody[objects.AddressGroups]},
+	{http.MethodDelete, "/config/objects/v1/address-groups/{id}", "AddressGroupsAPIService.DeleteAddressGroupsByID", nil},
+	{http.MethodGet, "/config/objects/v1/address-groups/{id}", "AddressGroupsAPIService.GetAddressGroupsByID", nil},
+	{http.MethodPut, "/config/objects/v1/address-groups/{id}", "AddressGroupsAPIService.UpdateAddressGroupsByID", newBody[objects.AddressGroups]},
+	{http.MethodGet, "/config/objects/v1/addresses", "AddressesAPIService.ListAddresses", nil},
+	{http.MethodPost, "/config/objects/v1/addresses", "AddressesAPIService.CreateAddresses", newBody[objects.Addresses]},
+	{http.MethodDelete, "/config/objects/v1/addresses/{id}", "AddressesAPIService.DeleteAddressesByID", nil},
+	{http.MethodGet, "/config/objects/v1/addresses/{id}", "AddressesAPIService.GetAddressesByID", nil},
+	{http.MethodPut, "/config/objects/v1/addresses/{id}", "AddressesAPIService.UpdateAddressesByID", newBody[objects.Addresses]},
+	{http.MethodGet, "/config/objects/v1/application-filters", "ApplicationFiltersAPIService.ListApplicationFilters", nil},
+	{http.MethodPost, "/config/objects/v1/application-filters", "ApplicationFiltersAPIService.CreateApplicationFilters", newBody[objects.ApplicationFilters]},
+	{http.MethodDelete, "/config/objects/v1/application-filters/{id}", "ApplicationFiltersAPIService.DeleteApplicationFiltersByID", nil},
+	{http.MethodGet, "/config/objects/v1/application-filters/{id}", "ApplicationFiltersAPIService.GetApplicationFiltersByID", nil},
+	{http.MethodPut, "/config/objects/v1/application-filters/{id}", "ApplicationFiltersAPIService.UpdateApplicationFiltersByID", newBody[objects.ApplicationFilters]},
+	{http.MethodGet, "/config/objects/v1/application-groups", "ApplicationGroupsAPIService.ListApplicationGroups", nil},
+	{http.MethodPost, "/config/objects/v1/application-groups", "ApplicationGroupsAPIService.CreateApplicationGroups", newBody[objects.ApplicationGroups]},
+	{http.MethodDelete, "/config/objects/v1/application-groups/{id}", "ApplicationGroupsAPIService.DeleteApplicationGroupsByID", nil},
+	{http.MethodGet, "/config/objects/v1/application-groups/{id}", "ApplicationGroupsAPIService.GetApplicationGroupsByID", nil},
+	{http.MethodPut, "/config/objects/v1/application-groups/{id}", "ApplicationGroupsAPIService.UpdateApplicationGroupsByID", newBody[objects.ApplicationGroups]},
+	{http.MethodGet, "/config/objects/v1/applications", "ApplicationsAPIService.ListApplications", nil},
+	{http.MethodPost, "/config/objects/v1/applications", "ApplicationsAPIService.CreateApplications", newBody[objects.Applications]},
+	{http.MethodDelete, "/config/objects/v1/applications/{id}", "ApplicationsAPIService.DeleteApplicationsByID", nil},
+	{http.MethodGet, "/config/objects/v1/applications/{id}", "ApplicationsAPIService.GetApplicationsByID", nil},
+	{http.MethodPut, "/config/objects/v1/applications/{id}", "ApplicationsAPIService.UpdateApplicationsByID", newBody[objects.Applications]},
+	{http.MethodDelete, "/config/objects/v1/auto-tag-actions", "AutoTagActionsAPIService.DeleteAutoTagActions", nil},
+	{http.MethodGet, "/config/objects/v1/auto-tag-actions", "AutoTagActionsAPIService.ListAutoTagActions", nil},
+	{http.MethodPost, "/config/objects/v1/auto-tag-actions", "AutoTagActionsAPIService.CreateAutoTagActions", newBody[objects.AutoTagActions]},
+	{http.MethodPut, "/config/objects/v1/auto-tag-actions", "AutoTagActionsAPIService.UpdateAutoTagActions", newBody[objects.AutoTagActions]},
+	{http.MethodGet, "/config/objects/v1/dynamic-user-groups", "DynamicUserGroupsAPIService.ListDynamicUserGroups", nil},
+	{http.MethodPost, "/config/objects/v1/dynamic-user-groups", "DynamicUserGroupsAPIService.CreateDynamicUserGroups", newBody[objects.DynamicUserGroups]},
+	{http.MethodDelete, "/config/objects/v1/dynamic-user-groups/{id}", "DynamicUserGroupsAPIService.DeleteDynamicUserGroupsByID", nil},
+	{http.MethodGet, "/config/objects/v1/dynamic-user-groups/{id}", "DynamicUserGroupsAPIService.GetDynamicUserGroupsByID", nil},
+	{http.MethodPut, "/config/objects/v1/dynamic-user-groups/{id}", "DynamicUserGroupsAPIService.UpdateDynamicUserGroupsByID", newBody[objects.DynamicUserGroups]},
+	{http.MethodGet, "/config/objects/v1/external-dynamic-lists", "ExternalDynamicListsAPIService.ListExternalDynamicLists", nil},
+	{http.MethodPost, "/config/objects/v1/external-dynamic-lists", "ExternalDynamicListsAPIService.CreateExternalDynamicLists", newBody[objects.ExternalDynamicLists]},
+	{http.MethodDelete, "/config/objects/v1/external-dynamic-lists/{id}", "ExternalDynamicListsAPIService.DeleteExternalDynamicListsByID", nil},
+	{http.MethodGet, "/config/objects/v1/external-dynamic-lists/{id}", "ExternalDynamicListsAPIService.GetExternalDynamicListsByID", nil},
+	{http.MethodPut, "/config/objects/v1/external-dynamic-lists/{id}", "ExternalDynamicListsAPIService.UpdateExternalDynamicListsByID", newBody[objects.ExternalDynamicLists]},
+	{http.MethodGet, "/config/objects/v1/hip-objects", "HIPObjectsAPIService.ListHIPObjects", nil},
+	{http.MethodPost, "/config/objects/v1/hip-objects", "HIPObjectsAPIService.CreateHIPObjects", newBody[objects.HipObjects]},
+	{http.MethodDelete, "/config/objects/v1/hip-objects/{id}", "HIPObjectsAPIService.DeleteHIPObjectsByID", nil},
+	{http.MethodGet, "/config/objects/v1/hip-objects/{id}", "HIPObjectsAPIService.GetHIPObjectsByID", nil},
+	{http.MethodPut, "/config/objects/v1/hip-objects/{id}", "HIPObjectsAPIService.UpdateHIPObjectsByID", newBody[objects.HipObjects]},
+	{http.MethodGet, "/config/objects/v1/hip-profiles", "HIPProfilesAPIService.ListHIPProfiles", nil},
+	{http.MethodPost, "/config/objects/v1/hip-profiles", "HIPProfilesAPIService.CreateHIPProfiles", newBody[objects.HipProfiles]},
+	{http.MethodDelete, "/config/objects/v1/hip-profiles/{id}", "HIPProfilesAPIService.DeleteHIPProfilesByID", nil},
+	{http.MethodGet, "/config/objects/v1/hip-profiles/{id}", "HIPProfilesAPIService.GetHIPProfilesByID", nil},
+	{http.MethodPut, "/config/objects/v1/hip-profiles/{id}", "HIPProfilesAPIService.UpdateHIPProfilesByID", newBody[objects.HipProfiles]},
+	{http.MethodGet, "/config/objects/v1/http-server-profiles", "HTTPServerProfilesAPIService.ListHTTPServerProfiles", nil},
+	{http.MethodPost, "/config/objects/v1/http-server-profiles", "HTTPServerProfilesAPIService.CreateHTTPServerProfiles", newBody[objects.HttpServerProfiles]},
+	{http.MethodDelete, "/config/objects/v1/http-server-profiles/{id}", "HTTPServerProfilesAPIService.DeleteHTTPServerProfilesByID", nil},
+	{http.MethodGet, "/config/objects/v1/http-server-profiles/{id}", "HTTPServerProfilesAPIService.GetHTTPServerProfilesByID", nil},
+	{http.MethodPut, "/config/objects/v1/http-server-profiles/{id}", "HTTPServerProfilesAPIService.UpdateHTTPServerProfilesByID", newBody[objects.HttpServerProfiles]},
+	{http.MethodGet, "/config/objects/v1/log-forwarding-profiles", "LogForwardingProfilesAPIService.ListLogForwardingProfiles", nil},
+	{http.MethodPost, "/config/objects/v1/log-forwarding-profiles", "LogForwardingProfilesAPIService.CreateLogForwardingProfiles", newBody[objects.LogForwardingProfiles]},
+	{http.MethodDelete, "/config/objects/v1/log-forwarding-profiles/{id}", "LogForwardingProfilesAPIService.DeleteLogForwardingProfilesByID", nil},
+	{http.MethodGet, "/config/objects/v1/log-forwarding-profiles/{id}", "LogForwardingProfilesAPIService.GetLogForwardingProfilesByID", nil},
+	{http.MethodPut, "/config/objects/v1/log-forwarding-profiles/{id}", "LogForwardingProfilesAPIService.UpdateLogForwardingProfilesByID", newBody[objects.LogForwardingProfiles]},
+	{http.MethodDelete, "/config/objects/v1/quarantined-devices", "QuarantinedDevicesAPIService.DeleteQuarantinedDevices", nil},
+	{http.MethodGet, "/config/objects/v1/quarantined-devices", "QuarantinedDevicesAPIService.ListQuarantinedDevices", nil},
+	{http.MethodPost, "/config/objects/v1/quarantined-devices", "QuarantinedDevicesAPIService.CreateQuarantinedDevices", newBody[objects.QuarantinedDevices]},
+	{http.MethodGet, "/config/objects/v1/regions", "RegionsAPIService.ListRegions", nil},
+	{http.MethodPost, "/config/objects/v1/regions", "RegionsAPIService.CreateRegions", newBody[objects.Regions]},
+	{http.MethodDelete, "/config/objects/v1/regions/{id}", "RegionsAPIService.DeleteRegionsByID", nil},
+	{http.MethodGet, "/config/objects/v1/regions/{id}", "RegionsAPIService.GetRegionsByID", nil},
+	{http.MethodPut, "/config/objects/v1/regions/{id}", "RegionsAPIService.UpdateRegionsByID", newBody[objects.Regions]},
+	{http.MethodGet, "/config/objects/v1/schedules", "SchedulesAPIService.ListSchedules", nil},
+	{http.MethodPost, "/config/objects/v1/schedules", "SchedulesAPIService.CreateSchedules", newBody[objects.Schedules]},
+	{http.MethodDelete, "/config/objects/v1/schedules/{id}", "SchedulesAPIService.DeleteSchedulesByID", nil},
+	{http.MethodGet, "/config/objects/v1/schedules/{id}", "SchedulesAPIService.GetSchedulesByID", nil},
+	{http.MethodPut, "/config/objects/v1/schedules/{id}", "SchedulesAPIService.UpdateSchedulesByID", newBody[objects.Schedules]},
+	{http.MethodGet, "/config/objects/v1/service-groups", "ServiceGroupsAPIService.ListServiceGroups", nil},
+	{http.MethodPost, "/config/objects/v1/service-groups", "ServiceGroupsAPIService.CreateServiceGroups", newBody[objects.ServiceGroups]},
+	{http.MethodDelete, "/config/objects/v1/service-groups/{id}", "ServiceGroupsAPIService.DeleteServiceGroupsByID", nil},
+	{http.MethodGet, "/config/objects/v1/service-groups/{id}", "ServiceGroupsAPIService.GetServiceGroupsByID", nil},
+	{http.MethodPut, "/config/objects/v1/service-groups/{id}", "ServiceGroupsAPIService.UpdateServiceGroupsByID", newBody[objects.ServiceGroups]},
+	{http.MethodGet, "/config/objects/v1/services", "ServicesAPIService.ListServices", nil},
+	{http.MethodPost, "/config/objects/v1/services", "ServicesAPIService.CreateServices", newBody[objects.Services]},
+	{http.MethodDelete, "/config/objects/v1/services/{id}", "ServicesAPIService.DeleteServicesByID", nil},
+	{http.MethodGet, "/config/objects/v1/services/{id}", "ServicesAPIService.GetServicesByID", nil},
+	{http.MethodPut, "/config/objects/v1/services/{id}", "ServicesAPIService.UpdateServicesByID", newBody[objects.Services]},
+	{http.MethodGet, "/config/objects/v1/syslog-server-profiles", "SyslogServerProfilesAPIService.ListSyslogServerProfiles", nil},
+	{http.MethodPost, "/config/objects/v1/syslog-server-profiles", "SyslogServerProfilesAPIService.CreateSyslogServerProfiles", newBody[objects.SyslogServerProfiles]},
+	{http.MethodDelete, "/config/objects/v1/syslog-server-profiles/{id}", "SyslogServerProfilesAPIService.DeleteSyslogServerProfilesByID", nil},
+	{http.MethodGet, "/config/objects/v1/syslog-server-profiles/{id}", "SyslogServerProfilesAPIService.GetSyslogServerProfilesByID", nil},
+	{http.MethodPut, "/config/objects/v1/syslog-server-profiles/{id}", "SyslogServerProfilesAPIService.UpdateSyslogServerProfilesByID", newBody[objects.SyslogServerProfiles]},
+	{http.MethodGet, "/config/objects/v1/tags", "TagsAPIService.ListTags", nil},
+	{http.MethodPost, "/config/objects/v1/tags", "TagsAPIService.CreateTags", newBody[objects.Tags]},
+	{http.MethodDelete, "/config/objects/v1/tags/{id}", "TagsAPIService.DeleteTagsByID", nil},
+	{http.MethodGet, "/config/objects/v1/tags/{id}", "TagsAPIService.GetTagsByID", nil},
+	{http.MethodPut, "/config/objects/v1/tags/{id}", "TagsAPIService.UpdateTagsByID", newBody[objects.Tags]},
+	{http.MethodGet, "/config/operations/v1/config-versions", "ConfigVersionsAPIService.ListConfigVersions", nil},
+	{http.MethodDelete, "/config/operations/v1/config-versions/candidate", "ConfigVersionsAPIService.DeleteCandidateConfigVersions", nil},
+	{http.MethodPost, "/config/operations/v1/config-versions/candidate:push", "ConfigVersionsAPIService.PushCandidateConfigVersions", newBody[config_operations.PushCandidateConfigVersionsRequest]},
+	{http.MethodGet, "/config/operations/v1/config-versions/running", "ConfigVersionsAPIService.GetRunningConfigVersions", nil},
+	{http.MethodGet, "/config/operations/v1/config-versions/{version}", "ConfigVersionsAPIService.GetConfigVersionsByID", nil},
+	{http.MethodPost, "/config/operations/v1/config-versions:load", "ConfigVersionsAPIService.LoadConfigVersions", newBody[config_operations.LoadConfig]},
+	{http.MethodGet, "/config/operations/v1/jobs", "JobsAPIService.ListJobs", nil},
+	{http.MethodGet, "/config/operations/v1/jobs/{id}", "JobsAPIService.GetJobsByID", nil},
+	{http.MethodGet, "/config/security/v1/anti-spyware-profiles", "AntiSpywareProfilesAPIService.ListAntiSpywareProfiles", nil},
+	{http.MethodPost, "/config/security/v1/anti-spyware-profiles", "AntiSpywareProfilesAPIService.CreateAntiSpywareProfiles", newBody[security_services.AntiSpywareProfiles]},
+	{http.MethodDelete, "/config/security/v1/anti-spyware-profiles/{id}", "AntiSpywareProfilesAPIService.DeleteAntiSpywareProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/anti-spyware-profiles/{id}", "AntiSpywareProfilesAPIService.GetAntiSpywareProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/anti-spyware-profiles/{id}", "AntiSpywareProfilesAPIService.UpdateAntiSpywareProfilesByID", newBody[security_services.AntiSpywareProfiles]},
+	{http.MethodGet, "/config/security/v1/anti-spyware-signatures", "AntiSpywareSignaturesAPIService.ListAntiSpywareSignatures", nil},
+	{http.MethodPost, "/config/security/v1/anti-spyware-signatures", "AntiSpywareSignaturesAPIService.CreateAntiSpywareSignatures", newBody[security_services.AntiSpywareSignatures]},
+	{http.MethodDelete, "/config/security/v1/anti-spyware-signatures/{id}", "AntiSpywareSignaturesAPIService.DeleteAntiSpywareSignaturesByID", nil},
+	{http.MethodGet, "/config/security/v1/anti-spyware-signatures/{id}", "AntiSpywareSignaturesAPIService.GetAntiSpywareSignaturesByID", nil},
+	{http.MethodPut, "/config/security/v1/anti-spyware-signatures/{id}", "AntiSpywareSignaturesAPIService.UpdateAntiSpywareSignaturesByID", newBody[security_services.AntiSpywareSignatures]},
+	{http.MethodGet, "/config/security/v1/app-override-rules", "ApplicationOverrideRulesAPIService.ListApplicationOverrideRules", nil},
+	{http.MethodPost, "/config/security/v1/app-override-rules", "ApplicationOverrideRulesAPIService.CreateApplicationOverrideRules", newBody[security_services.AppOverrideRules]},
+	{http.MethodDelete, "/config/security/v1/app-override-rules/{id}", "ApplicationOverrideRulesAPIService.DeleteApplicationOverrideRulesByID", nil},
+	{http.MethodGet, "/config/security/v1/app-override-rules/{id}", "ApplicationOverrideRulesAPIService.GetApplicationOverrideRulesByID", nil},
+	{http.MethodPut, "/config/security/v1/app-override-rules/{id}", "ApplicationOverrideRulesAPIService.UpdateApplicationOverrideRulesByID", newBody[security_services.AppOverrideRules]},
+	{http.MethodPost, "/config/security/v1/app-override-rules/{id}:move", "ApplicationOverrideRulesAPIService.MoveApplicationOverrideRulesByID", newBody[security_services.RuleBasedMove]},
+	{http.MethodGet, "/config/security/v1/data-filtering-profiles", "DataFilteringAPIService.ListDataFilteringProfiles", nil},
+	{http.MethodPost, "/config/security/v1/data-filtering-profiles", "DataFilteringAPIService.CreateDataFilteringProfiles", newBody[security_services.DataFilteringProfiles]},
+	{http.MethodDelete, "/config/security/v1/data-filtering-profiles/{id}", "DataFilteringAPIService.DeleteDataFilteringProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/data-filtering-profiles/{id}", "DataFilteringAPIService.GetDataFilteringProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/data-filtering-profiles/{id}", "DataFilteringAPIService.UpdateDataFilteringProfilesByID", newBody[security_services.DataFilteringProfiles]},
+	{http.MethodGet, "/config/security/v1/data-objects", "DataObjectsAPIService.ListDataObjects", nil},
+	{http.MethodPost, "/config/security/v1/data-objects", "DataObjectsAPIService.CreateDataObjects", newBody[security_services.DataObjects]},
+	{http.MethodDelete, "/config/security/v1/data-objects/{id}", "DataObjectsAPIService.DeleteDataObjectsByID", nil},
+	{http.MethodGet, "/config/security/v1/data-objects/{id}", "DataObjectsAPIService.GetDataObjectsByID", nil},
+	{http.MethodPut, "/config/security/v1/data-objects/{id}", "DataObjectsAPIService.UpdateDataObjectsByID", newBody[security_services.DataObjects]},
+	{http.MethodGet, "/config/security/v1/decryption-exclusions", "DecryptionExclusionsAPIService.ListDecryptionExclusions", nil},
+	{http.MethodPost, "/config/security/v1/decryption-exclusions", "DecryptionExclusionsAPIService.CreateDecryptionExclusions", newBody[security_services.DecryptionExclusions]},
+	{http.MethodDelete, "/config/security/v1/decryption-exclusions/{id}", "DecryptionExclusionsAPIService.DeleteDecryptionExclusionsByID", nil},
+	{http.MethodGet, "/config/security/v1/decryption-exclusions/{id}", "DecryptionExclusionsAPIService.GetDecryptionExclusionsByID", nil},
+	{http.MethodPut, "/config/security/v1/decryption-exclusions/{id}", "DecryptionExclusionsAPIService.UpdateDecryptionExclusionsByID", newBody[security_services.DecryptionExclusions]},
+	{http.MethodGet, "/config/security/v1/decryption-profiles", "DecryptionProfilesAPIService.ListDecryptionProfiles", nil},
+	{http.MethodPost, "/config/security/v1/decryption-profiles", "DecryptionProfilesAPIService.CreateDecryptionProfiles", newBody[security_services.DecryptionProfiles]},
+	{http.MethodDelete, "/config/security/v1/decryption-profiles/{id}", "DecryptionProfilesAPIService.DeleteDecryptionProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/decryption-profiles/{id}", "DecryptionProfilesAPIService.GetDecryptionProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/decryption-profiles/{id}", "DecryptionProfilesAPIService.UpdateDecryptionProfilesByID", newBody[security_services.DecryptionProfiles]},
+	{http.MethodGet, "/config/security/v1/decryption-rules", "DecryptionRulesAPIService.ListDecryptionRules", nil},
+	{http.MethodPost, "/config/security/v1/decryption-rules", "DecryptionRulesAPIService.CreateDecryptionRules", newBody[security_services.DecryptionRules]},
+	{http.MethodDelete, "/config/security/v1/decryption-rules/{id}", "DecryptionRulesAPIService.DeleteDecryptionRulesByID", nil},
+	{http.MethodGet, "/config/security/v1/decryption-rules/{id}", "DecryptionRulesAPIService.GetDecryptionRulesByID", nil},
+	{http.MethodPut, "/config/security/v1/decryption-rules/{id}", "DecryptionRulesAPIService.UpdateDecryptionRulesByID", newBody[security_services.DecryptionRules]},
+	{http.MethodPost, "/config/security/v1/decryption-rules/{id}:move", "DecryptionRulesAPIService.MoveDecryptionRulesByID", newBody[security_services.RuleBasedMove]},
+	{http.MethodGet, "/config/security/v1/dns-security-profiles", "DNSSecurityProfilesAPIService.ListDNSSecurityProfiles", nil},
+	{http.MethodPost, "/config/security/v1/dns-security-profiles", "DNSSecurityProfilesAPIService.CreateDNSSecurityProfiles", newBody[security_services.DnsSecurityProfiles]},
+	{http.MethodDelete, "/config/security/v1/dns-security-profiles/{id}", "DNSSecurityProfilesAPIService.DeleteDNSSecurityProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/dns-security-profiles/{id}", "DNSSecurityProfilesAPIService.GetDNSSecurityProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/dns-security-profiles/{id}", "DNSSecurityProfilesAPIService.UpdateDNSSecurityProfilesByID", newBody[security_services.DnsSecurityProfiles]},
+	{http.MethodGet, "/config/security/v1/dos-protection-profiles", "DoSProtectionProfilesAPIService.ListDoSProtectionProfiles", nil},
+	{http.MethodPost, "/config/security/v1/dos-protection-profiles", "DoSProtectionProfilesAPIService.CreateDoSProtectionProfiles", newBody[security_services.DosProtectionProfiles]},
+	{http.MethodDelete, "/config/security/v1/dos-protection-profiles/{id}", "DoSProtectionProfilesAPIService.DeleteDoSProtectionProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/dos-protection-profiles/{id}", "DoSProtectionProfilesAPIService.GetDoSProtectionProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/dos-protection-profiles/{id}", "DoSProtectionProfilesAPIService.UpdateDoSProtectionProfilesByID", newBody[security_services.DosProtectionProfiles]},
+	{http.MethodGet, "/config/security/v1/dos-protection-rules", "DoSProtectionRulesAPIService.ListDoSProtectionRules", nil},
+	{http.MethodPost, "/config/security/v1/dos-protection-rules", "DoSProtectionRulesAPIService.CreateDoSProtectionRules", newBody[security_services.DosProtectionRules]},
+	{http.MethodDelete, "/config/security/v1/dos-protection-rules/{id}", "DoSProtectionRulesAPIService.DeleteDoSProtectionRulesByID", nil},
+	{http.MethodGet, "/config/security/v1/dos-protection-rules/{id}", "DoSProtectionRulesAPIService.GetDoSProtectionRulesByID", nil},
+	{http.MethodPut, "/config/security/v1/dos-protection-rules/{id}", "DoSProtectionRulesAPIService.UpdateDoSProtectionRulesByID", newBody[security_services.DosProtectionRules]},
+	{http.MethodGet, "/config/security/v1/file-blocking-profiles", "FileBlockingProfilesAPIService.ListFileBlockingProfiles", nil},
+	{http.MethodPost, "/config/security/v1/file-blocking-profiles", "FileBlockingProfilesAPIService.CreateFileBlockingProfiles", newBody[security_services.FileBlockingProfiles]},
+	{http.MethodDelete, "/config/security/v1/file-blocking-profiles/{id}", "FileBlockingProfilesAPIService.DeleteFileBlockingProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/file-blocking-profiles/{id}", "FileBlockingProfilesAPIService.GetFileBlockingProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/file-blocking-profiles/{id}", "FileBlockingProfilesAPIService.UpdateFileBlockingProfilesByID", newBody[security_services.FileBlockingProfiles]},
+	{http.MethodGet, "/config/security/v1/http-header-profiles", "HTTPHeaderProfilesAPIService.ListHTTPHeaderProfiles", nil},
+	{http.MethodPost, "/config/security/v1/http-header-profiles", "HTTPHeaderProfilesAPIService.CreateHTTPHeaderProfiles", newBody[security_services.HttpHeaderProfiles]},
+	{http.MethodDelete, "/config/security/v1/http-header-profiles/{id}", "HTTPHeaderProfilesAPIService.DeleteHTTPHeaderProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/http-header-profiles/{id}", "HTTPHeaderProfilesAPIService.GetHTTPHeaderProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/http-header-profiles/{id}", "HTTPHeaderProfilesAPIService.UpdateHTTPHeaderProfilesByID", newBody[security_services.HttpHeaderProfiles]},
+	{http.MethodGet, "/config/security/v1/profile-groups", "ProfileGroupsAPIService.ListProfileGroups", nil},
+	{http.MethodPost, "/config/security/v1/profile-groups", "ProfileGroupsAPIService.CreateProfileGroups", newBody[security_services.ProfileGroups]},
+	{http.MethodDelete, "/config/security/v1/profile-groups/{id}", "ProfileGroupsAPIService.DeleteProfileGroupsByID", nil},
+	{http.MethodGet, "/config/security/v1/profile-groups/{id}", "ProfileGroupsAPIService.GetProfileGroupsByID", nil},
+	{http.MethodPut, "/config/security/v1/profile-groups/{id}", "ProfileGroupsAPIService.UpdateProfileGroupsByID", newBody[security_services.ProfileGroups]},
+	{http.MethodGet, "/config/security/v1/saas-tenant-restrictions", "SaasTenantRestrictionsAPIService.GetSaasTenantRestrictions", nil},
+	{http.MethodPut, "/config/security/v1/saas-tenant-restrictions", "SaasTenantRestrictionsAPIService.UpdateSaasTenantRestrictions", newBody[security_services.SaasTenantRestrictions]},
+	{http.MethodGet, "/config/security/v1/security-rules", "SecurityRulesAPIService.ListRules", nil},
+	{http.MethodPost, "/config/security/v1/security-rules", "SecurityRulesAPIService.CreateSecurityRules", newBody[security_services.SecurityRules]},
+	{http.MethodDelete, "/config/security/v1/security-rules/{id}", "SecurityRulesAPIService.DeleteSecurityRulesByID", nil},
+	{http.MethodGet, "/config/security/v1/security-rules/{id}", "SecurityRulesAPIService.GetSecurityRulesByID", nil},
+	{http.MethodPut, "/config/security/v1/security-rules/{id}", "SecurityRulesAPIService.UpdateSecurityRulesByID", newBody[security_services.SecurityRules]},
+	{http.MethodPost, "/config/security/v1/security-rules/{id}:move", "SecurityRulesAPIService.MoveSecurityRulesByID", newBody[security_services.RuleBasedMove]},
+	{http.MethodDelete, "/config/security/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.DeleteSslDecryptionSettings", nil},
+	{http.MethodGet, "/config/security/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.GetSslDecryptionSettings", nil},
+	{http.MethodPost, "/config/security/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.PostSslDecryptionSettings", newBody[security_services.SslDecryptionSettings]},
+	{http.MethodPut, "/config/security/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.PutSslDecryptionSettings", newBody[security_services.SslDecryptionSettingsGetPut]},
+	{http.MethodGet, "/config/security/v1/url-access-profiles", "URLAccessProfilesAPIService.ListURLAccessProfiles", nil},
+	{http.MethodPost, "/config/security/v1/url-access-profiles", "URLAccessProfilesAPIService.CreateURLAccessProfiles", newBody[security_services.UrlAccessProfiles]},
+	{http.MethodDelete, "/config/security/v1/url-access-profiles/{id}", "URLAccessProfilesAPIService.DeleteURLAccessProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/url-access-profiles/{id}", "URLAccessProfilesAPIService.GetURLAccessProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/url-access-profiles/{id}", "URLAccessProfilesAPIService.UpdateURLAccessProfilesByID", newBody[security_services.UrlAccessProfiles]},
+	{http.MethodGet, "/config/security/v1/url-categories", "URLCategoriesAPIService.ListURLCategories", nil},
+	{http.MethodPost, "/config/security/v1/url-categories", "URLCategoriesAPIService.CreateURLCategories", newBody[security_services.UrlCategories]},
+	{http.MethodDelete, "/config/security/v1/url-categories/{id}", "URLCategoriesAPIService.DeleteURLCategoriesByID", nil},
+	{http.MethodGet, "/config/security/v1/url-categories/{id}", "URLCategoriesAPIService.GetURLCategoriesByID", nil},
+	{http.MethodPut, "/config/security/v1/url-categories/{id}", "URLCategoriesAPIService.UpdateURLCategoriesByID", newBody[security_services.UrlCategories]},
+	{http.MethodGet, "/config/security/v1/url-filtering-categories", "URLFilteringCategoriesAPIService.ListURLFilteringCategories", nil},
+	{http.MethodGet, "/config/security/v1/vulnerability-protection-profiles", "VulnerabilityProtectionProfilesAPIService.ListVulnerabilityProtectionProfiles", nil},
+	{http.MethodPost, "/config/security/v1/vulnerability-protection-profiles", "VulnerabilityProtectionProfilesAPIService.CreateVulnerabilityProtectionProfiles", newBody[security_services.VulnerabilityProtectionProfiles]},
+	{http.MethodDelete, "/config/security/v1/vulnerability-protection-profiles/{id}", "VulnerabilityProtectionProfilesAPIService.DeleteVulnerabilityProtectionProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/vulnerability-protection-profiles/{id}", "VulnerabilityProtectionProfilesAPIService.GetVulnerabilityProtectionProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/vulnerability-protection-profiles/{id}", "VulnerabilityProtectionProfilesAPIService.UpdateVulnerabilityProtectionProfilesByID", newBody[security_services.VulnerabilityProtectionProfiles]},
+	{http.MethodGet, "/config/security/v1/vulnerability-protection-signatures", "VulnerabilityProtectionSignaturesAPIService.ListVulnerabilityProtectionSignatures", nil},
+	{http.MethodPost, "/config/security/v1/vulnerability-protection-signatures", "VulnerabilityProtectionSignaturesAPIService.CreateVulnerabilityProtectionSignatures", newBody[security_services.VulnerabilityProtectionSignatures]},
+	{http.MethodDelete, "/config/security/v1/vulnerability-protection-signatures/{id}", "VulnerabilityProtectionSignaturesAPIService.DeleteVulnerabilityProtectionSignaturesByID", nil},
+	{http.MethodGet, "/config/security/v1/vulnerability-protection-signatures/{id}", "VulnerabilityProtectionSignaturesAPIService.GetVulnerabilityProtectionSignaturesByID", nil},
+	{http.MethodPut, "/config/security/v1/vulnerability-protection-signatures/{id}", "VulnerabilityProtectionSignaturesAPIService.UpdateVulnerabilityProtectionSignaturesByID", newBody[security_services.VulnerabilityProtectionSignatures]},
+	{http.MethodGet, "/config/security/v1/wildfire-anti-virus-profiles", "WildFireAntiVirusProfilesAPIService.ListWildFireAntiVirusProfiles", nil},
+	{http.MethodPost, "/config/security/v1/wildfire-anti-virus-profiles", "WildFireAntiVirusProfilesAPIService.CreateWildFireAntiVirusProfiles", newBody[security_services.WildfireAntiVirusProfiles]},
+	{http.MethodDelete, "/config/security/v1/wildfire-anti-virus-profiles/{id}", "WildFireAntiVirusProfilesAPIService.DeleteWildFireAntiVirusProfilesByID", nil},
+	{http.MethodGet, "/config/security/v1/wildfire-anti-virus-profiles/{id}", "WildFireAntiVirusProfilesAPIService.GetWildFireAntiVirusProfilesByID", nil},
+	{http.MethodPut, "/config/security/v1/wildfire-anti-virus-profiles/{id}", "WildFireAntiVirusProfilesAPIService.UpdateWildFireAntiVirusProfilesByID", newBody[security_services.WildfireAntiVirusProfiles]},
+	{http.MethodGet, "/config/setup/v1/folders", "FoldersAPIService.ListFolders", nil},
+	{http.MethodPost, "/config/setup/v1/folders", "FoldersAPIService.CreateFolder", newBody[config_setup.Folders]},
+	{http.MethodDelete, "/config/setup/v1/folders/{id}", "FoldersAPIService.DeleteFolderByID", nil},
+	{http.MethodGet, "/config/setup/v1/folders/{id}", "FoldersAPIService.GetFolderByID", nil},
+	{http.MethodPut, "/config/setup/v1/folders/{id}", "FoldersAPIService.UpdateFolderByID", newBody[config_setup.Folders]},
+	{http.MethodGet, "/config/setup/v1/labels", "LabelsAPIService.ListLabels", nil},
+	{http.MethodPost, "/config/setup/v1/labels", "LabelsAPIService.CreateLabel", newBody[config_setup.Labels]},
+	{http.MethodDelete, "/config/setup/v1/labels/{id}", "LabelsAPIService.DeleteLabelByID", nil},
+	{http.MethodGet, "/config/setup/v1/labels/{id}", "LabelsAPIService.GetLabelByID", nil},
+	{http.MethodPut, "/config/setup/v1/labels/{id}", "LabelsAPIService.UpdateLabelByID", newBody[config_setup.Labels]},
+	{http.MethodGet, "/config/setup/v1/shared-snippets", "SharedSnippetsAPIService.ListSharedSnippets", nil},
+	{http.MethodPut, "/config/setup/v1/shared-snippets", "SharedSnippetsAPIService.ConvertSharedSnippets", newBody[config_setup.SnippetShareUploadPayload]},
+	{http.MethodPost, "/config/setup/v1/shared-snippets:load", "SharedSnippetsAPIService.LoadSharedSnippets", newBody[config_setup.SnippetShareLoadPayload]},
+	{http.MethodPost, "/config/setup/v1/snippet-audit-logs", "SnippetAuditLogsAPIService.CreateSnippetAuditLogs", newBody[config_setup.SnippetAuditPayload]},
+	{http.MethodGet, "/config/setup/v1/snippet-audit-logs/{id}", "SnippetAuditLogsAPIService.GetSnippetAuditLogsByID", nil},
+	{http.MethodGet, "/config/setup/v1/snippet-categories", "SnippetCategoriesAPIService.ListSnippetCategories", nil},
+	{http.MethodDelete, "/config/setup/v1/snippet-categories/{id}", "SnippetCategoriesAPIService.DeleteSnippetCategoryByID", nil},
+	{http.MethodGet, "/config/setup/v1/snippet-categories/{id}", "SnippetCategoriesAPIService.GetSnippetCategoryByID", nil},
+	{http.MethodPost, "/config/setup/v1/snippet-snapshots", "SnippetSnapshotsAPIService.SaveSnippetSnapshot", newBody[config_setup.SaveSnippetSnapshotPayload]},
+	{http.MethodPost, "/config/setup/v1/snippet-snapshots:compare", "SnippetSnapshotsAPIService.CompareSnippetSnapshot", newBody[config_setup.CompareSnippetSnapshotConfigPayload]},
+	{http.MethodPost, "/config/setup/v1/snippet-snapshots:convert", "SnippetSnapshotsAPIService.ConvertSnippetSnapshot", newBody[config_setup.CommonSnippetSnapshotPayload]},
+	{http.MethodPost, "/config/setup/v1/snippet-snapshots:diff", "SnippetSnapshotsAPIService.DiffSnippetSnapshot", newBody[config_setup.CompareTloPayload]},
+	{http.MethodPost, "/config/setup/v1/snippet-snapshots:load", "SnippetSnapshotsAPIService.LoadSnippetSnapshot", newBody[config_setup.SnippetSnapshotLoadSnippetPayload]},
+	{http.MethodPost, "/config/setup/v1/snippet-snapshots:publish", "SnippetSnapshotsAPIService.PublishSnippetSnapshot", newBody[config_setup.SnippetSnapshotPublishRequest]},
+	{http.MethodPost, "/config/setup/v1/snippet-snapshots:updates", "SnippetSnapshotsAPIService.UpdateSnippetSnapshot", newBody[config_setup.SnippetSnapshotSubscriberComparePayload]},
+	{http.MethodGet, "/config/setup/v1/snippets", "SnippetsAPIService.ListSnippets", nil},
+	{http.MethodPost, "/config/setup/v1/snippets", "SnippetsAPIService.CreateSnippet", newBody[config_setup.Snippets]},
+	{http.MethodDelete, "/config/setup/v1/snippets/{id}", "SnippetsAPIService.DeleteSnippetByID", nil},
+	{http.MethodGet, "/config/setup/v1/snippets/{id}", "SnippetsAPIService.GetSnippetByID", nil},
+	{http.MethodPut, "/config/setup/v1/snippets/{id}", "SnippetsAPIService.UpdateSnippetByID", newBody[config_setup.Snippets]},
+	{http.MethodDelete, "/config/setup/v1/subscribed-tenants", "SubscribedTenantsAPIService.DeleteSubscribedTenantBySnippedID", nil},
+	{http.MethodPost, "/config/setup/v1/subscribed-tenants", "SubscribedTenantsAPIService.CreateSubscribedTenant", newBody[[]config_setup.AddSubscriberRequestPayloadInner]},
+	{http.MethodPut, "/config/setup/v1/subscribed-tenants", "SubscribedTenantsAPIService.UpdateSubscribedTenantBySnippetID", newBody[config_setup.SubscriberPropertyPayload]},
+	{http.MethodGet, "/config/setup/v1/subscribed-tenants/{id}", "SubscribedTenantsAPIService.ListSubscribedTenantsByID", nil},
+	{http.MethodPost, "/config/setup/v1/trust-validations", "TrustValidationsAPIService.ValidateTrust", newBody[config_setup.TrustsValidationPayload]},
+	{http.MethodGet, "/config/setup/v1/trusted-tenant-overview", "TrustedTenantsOverviewAPIService.GetTrustedTenantsOverview", nil},
+	{http.MethodGet, "/config/setup/v1/trusted-tenants", "TrustInformationAPIService.ListTrustedTenantsWithSnippets", nil},
+	{http.MethodDelete, "/config/setup/v1/trusts", "TrustsAPIService.DeleteTrust", nil},
+	{http.MethodPost, "/config/setup/v1/trusts", "TrustsAPIService.CreateTrust", newBody[config_setup.Trusts]},
+	{http.MethodGet, "/config/setup/v1/variables", "VariablesAPIService.ListVariables", nil},
+	{http.MethodPost, "/config/setup/v1/variables", "VariablesAPIService.CreateVariable", newBody[config_setup.Variables]},
+	{http.MethodDelete, "/config/setup/v1/variables/{id}", "VariablesAPIService.DeleteVariableByID", nil},
+	{http.MethodGet, "/config/setup/v1/variables/{id}", "VariablesAPIService.GetVariableByID", nil},
+	{http.MethodPut, "/config/setup/v1/variables/{id}", "VariablesAPIService.UpdateVariableByID", newBody[config_setup.Variables]},
+	{http.MethodGet, "/sse/config/v1/address-groups", "AddressGroupsAPIService.ListAddressGroups", nil},
+	{http.MethodPost, "/sse/config/v1/address-groups", "AddressGroupsAPIService.CreateAddressGroups", newBody[objects.AddressGroups]},
+	{http.MethodDelete, "/sse/config/v1/address-groups/{id}", "AddressGroupsAPIService.DeleteAddressGroupsByID", nil},
+	{http.MethodGet, "/sse/config/v1/address-groups/{id}", "AddressGroupsAPIService.GetAddressGroupsByID", nil},
+	{http.MethodPut, "/sse/config/v1/address-groups/{id}", "AddressGroupsAPIService.UpdateAddressGroupsByID", newBody[objects.AddressGroups]},
+	{http.MethodGet, "/sse/config/v1/addresses", "AddressesAPIService.ListAddresses", nil},
+	{http.MethodPost, "/sse/config/v1/addresses", "AddressesAPIService.CreateAddresses", newBody[objects.Addresses]},
+	{http.MethodDelete, "/sse/config/v1/addresses/{id}", "AddressesAPIService.DeleteAddressesByID", nil},
+	{http.MethodGet, "/sse/config/v1/addresses/{id}", "AddressesAPIService.GetAddressesByID", nil},
+	{http.MethodPut, "/sse/config/v1/addresses/{id}", "AddressesAPIService.UpdateAddressesByID", newBody[objects.Addresses]},
+	{http.MethodGet, "/sse/config/v1/aggregate-interfaces", "AggregateInterfacesAPIService.ListAggregateInterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/aggregate-interfaces", "AggregateInterfacesAPIService.CreateAggregateInterfaces", newBody[network_services.AggregateInterfaces]},
+	{http.MethodDelete, "/sse/config/v1/aggregate-interfaces/{id}", "AggregateInterfacesAPIService.DeleteAggregateInterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/aggregate-interfaces/{id}", "AggregateInterfacesAPIService.GetAggregateInterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/aggregate-interfaces/{id}", "AggregateInterfacesAPIService.UpdateAggregateInterfacesByID", newBody[network_services.AggregateInterfaces]},
+	{http.MethodGet, "/sse/config/v1/anti-spyware-profiles", "AntiSpywareProfilesAPIService.ListAntiSpywareProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/anti-spyware-profiles", "AntiSpywareProfilesAPIService.CreateAntiSpywareProfiles", newBody[security_services.AntiSpywareProfiles]},
+	{http.MethodDelete, "/sse/config/v1/anti-spyware-profiles/{id}", "AntiSpywareProfilesAPIService.DeleteAntiSpywareProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/anti-spyware-profiles/{id}", "AntiSpywareProfilesAPIService.GetAntiSpywareProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/anti-spyware-profiles/{id}", "AntiSpywareProfilesAPIService.UpdateAntiSpywareProfilesByID", newBody[security_services.AntiSpywareProfiles]},
+	{http.MethodGet, "/sse/config/v1/anti-spyware-signatures", "AntiSpywareSignaturesAPIService.ListAntiSpywareSignatures", nil},
+	{http.MethodPost, "/sse/config/v1/anti-spyware-signatures", "AntiSpywareSignaturesAPIService.CreateAntiSpywareSignatures", newBody[security_services.AntiSpywareSignatures]},
+	{http.MethodDelete, "/sse/config/v1/anti-spyware-signatures/{id}", "AntiSpywareSignaturesAPIService.DeleteAntiSpywareSignaturesByID", nil},
+	{http.MethodGet, "/sse/config/v1/anti-spyware-signatures/{id}", "AntiSpywareSignaturesAPIService.GetAntiSpywareSignaturesByID", nil},
+	{http.MethodPut, "/sse/config/v1/anti-spyware-signatures/{id}", "AntiSpywareSignaturesAPIService.UpdateAntiSpywareSignaturesByID", newBody[security_services.AntiSpywareSignatures]},
+	{http.MethodGet, "/sse/config/v1/app-override-rules", "ApplicationOverrideRulesAPIService.ListApplicationOverrideRules", nil},
+	{http.MethodPost, "/sse/config/v1/app-override-rules", "ApplicationOverrideRulesAPIService.CreateApplicationOverrideRules", newBody[security_services.AppOverrideRules]},
+	{http.MethodDelete, "/sse/config/v1/app-override-rules/{id}", "ApplicationOverrideRulesAPIService.DeleteApplicationOverrideRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/app-override-rules/{id}", "ApplicationOverrideRulesAPIService.GetApplicationOverrideRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/app-override-rules/{id}", "ApplicationOverrideRulesAPIService.UpdateApplicationOverrideRulesByID", newBody[security_services.AppOverrideRules]},
+	{http.MethodPost, "/sse/config/v1/app-override-rules/{id}:move", "ApplicationOverrideRulesAPIService.MoveApplicationOverrideRulesByID", newBody[security_services.RuleBasedMove]},
+	{http.MethodGet, "/sse/config/v1/application-filters", "ApplicationFiltersAPIService.ListApplicationFilters", nil},
+	{http.MethodPost, "/sse/config/v1/application-filters", "ApplicationFiltersAPIService.CreateApplicationFilters", newBody[objects.ApplicationFilters]},
+	{http.MethodDelete, "/sse/config/v1/application-filters/{id}", "ApplicationFiltersAPIService.DeleteApplicationFiltersByID", nil},
+	{http.MethodGet, "/sse/config/v1/application-filters/{id}", "ApplicationFiltersAPIService.GetApplicationFiltersByID", nil},
+	{http.MethodPut, "/sse/config/v1/application-filters/{id}", "ApplicationFiltersAPIService.UpdateApplicationFiltersByID", newBody[objects.ApplicationFilters]},
+	{http.MethodGet, "/sse/config/v1/application-groups", "ApplicationGroupsAPIService.ListApplicationGroups", nil},
+	{http.MethodPost, "/sse/config/v1/application-groups", "ApplicationGroupsAPIService.CreateApplicationGroups", newBody[objects.ApplicationGroups]},
+	{http.MethodDelete, "/sse/config/v1/application-groups/{id}", "ApplicationGroupsAPIService.DeleteApplicationGroupsByID", nil},
+	{http.MethodGet, "/sse/config/v1/application-groups/{id}", "ApplicationGroupsAPIService.GetApplicationGroupsByID", nil},
+	{http.MethodPut, "/sse/config/v1/application-groups/{id}", "ApplicationGroupsAPIService.UpdateApplicationGroupsByID", newBody[objects.ApplicationGroups]},
+	{http.MethodGet, "/sse/config/v1/applications", "ApplicationsAPIService.ListApplications", nil},
+	{http.MethodPost, "/sse/config/v1/applications", "ApplicationsAPIService.CreateApplications", newBody[objects.Applications]},
+	{http.MethodDelete, "/sse/config/v1/applications/{id}", "ApplicationsAPIService.DeleteApplicationsByID", nil},
+	{http.MethodGet, "/sse/config/v1/applications/{id}", "ApplicationsAPIService.GetApplicationsByID", nil},
+	{http.MethodPut, "/sse/config/v1/applications/{id}", "ApplicationsAPIService.UpdateApplicationsByID", newBody[objects.Applications]},
+	{http.MethodGet, "/sse/config/v1/authentication-portals", "AuthenticationPortalsAPIService.ListAuthenticationPortals", nil},
+	{http.MethodPost, "/sse/config/v1/authentication-portals", "AuthenticationPortalsAPIService.CreateAuthenticationPortals", newBody[identity_services.AuthenticationPortals]},
+	{http.MethodDelete, "/sse/config/v1/authentication-portals/{id}", "AuthenticationPortalsAPIService.DeleteAuthenticationPortalsByID", nil},
+	{http.MethodGet, "/sse/config/v1/authentication-portals/{id}", "AuthenticationPortalsAPIService.GetAuthenticationPortalsByID", nil},
+	{http.MethodPut, "/sse/config/v1/authentication-portals/{id}", "AuthenticationPortalsAPIService.UpdateAuthenticationPortalsByID", newBody[identity_services.AuthenticationPortals]},
+	{http.MethodGet, "/sse/config/v1/authentication-profiles", "AuthenticationProfilesAPIService.ListAuthenticationProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/authentication-profiles", "AuthenticationProfilesAPIService.CreateAuthenticationProfiles", newBody[identity_services.AuthenticationProfiles]},
+	{http.MethodDelete, "/sse/config/v1/authentication-profiles/{id}", "AuthenticationProfilesAPIService.DeleteAuthenticationProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/authentication-profiles/{id}", "AuthenticationProfilesAPIService.GetAuthenticationProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/authentication-profiles/{id}", "AuthenticationProfilesAPIService.UpdateAuthenticationProfilesByID", newBody[identity_services.AuthenticationProfiles]},
+	{http.MethodGet, "/sse/config/v1/authentication-rules", "AuthenticationRulesAPIService.ListAuthenticationRules", nil},
+	{http.MethodPost, "/sse/config/v1/authentication-rules", "AuthenticationRulesAPIService.CreateAuthenticationRules", newBody[identity_services.AuthenticationRules]},
+	{http.MethodDelete, "/sse/config/v1/authentication-rules/{id}", "AuthenticationRulesAPIService.DeleteAuthenticationRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/authentication-rules/{id}", "AuthenticationRulesAPIService.GetAuthenticationRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/authentication-rules/{id}", "AuthenticationRulesAPIService.UpdateAuthenticationRulesByID", newBody[identity_services.AuthenticationRules]},
+	{http.MethodPost, "/sse/config/v1/authentication-rules/{id}:move", "AuthenticationRulesAPIService.MoveAuthenticationRulesByID", newBody[identity_services.RuleBasedMove]},
+	{http.MethodGet, "/sse/config/v1/authentication-sequences", "AuthenticationSequencesAPIService.ListAuthenticationSequences", nil},
+	{http.MethodPost, "/sse/config/v1/authentication-sequences", "AuthenticationSequencesAPIService.CreateAuthenticationSequences", newBody[identity_services.AuthenticationSequences]},
+	{http.MethodDelete, "/sse/config/v1/authentication-sequences/{id}", "AuthenticationSequencesAPIService.DeleteAuthenticationSequencesByID", nil},
+	{http.MethodGet, "/sse/config/v1/authentication-sequences/{id}", "AuthenticationSequencesAPIService.GetAuthenticationSequencesByID", nil},
+	{http.MethodPut, "/sse/config/v1/authentication-sequences/{id}", "AuthenticationSequencesAPIService.UpdateAuthenticationSequencesByID", newBody[identity_services.AuthenticationSequences]},
+	{http.MethodDelete, "/sse/config/v1/auto-tag-actions", "AutoTagActionsAPIService.DeleteAutoTagActions", nil},
+	{http.MethodGet, "/sse/config/v1/auto-tag-actions", "AutoTagActionsAPIService.ListAutoTagActions", nil},
+	{http.MethodPost, "/sse/config/v1/auto-tag-actions", "AutoTagActionsAPIService.CreateAutoTagActions", newBody[objects.AutoTagActions]},
+	{http.MethodPut, "/sse/config/v1/auto-tag-actions", "AutoTagActionsAPIService.UpdateAutoTagActions", newBody[objects.AutoTagActions]},
+	{http.MethodGet, "/sse/config/v1/auto-vpn-clusters", "AutoVPNClustersAPIService.ListAutoVPNClusters", nil},
+	{http.MethodPost, "/sse/config/v1/auto-vpn-clusters", "AutoVPNClustersAPIService.CreateAutoVPNClusters", newBody[network_services.AutoVpnClusters]},
+	{http.MethodDelete, "/sse/config/v1/auto-vpn-clusters/{id}", "AutoVPNClustersAPIService.DeleteAutoVPNClustersByID", nil},
+	{http.MethodGet, "/sse/config/v1/auto-vpn-clusters/{id}", "AutoVPNClustersAPIService.GetAutoVPNClustersByID", nil},
+	{http.MethodPut, "/sse/config/v1/auto-vpn-clusters/{id}", "AutoVPNClustersAPIService.UpdateAutoVPNClustersByID", newBody[network_services.AutoVpnClusters]},
+	{http.MethodGet, "/sse/config/v1/auto-vpn-monitor", "AutoVPNMonitorAPIService.GetAutoVPNMonitor", nil},
+	{http.MethodPost, "/sse/config/v1/auto-vpn-push", "AutoVPNConfigPushAPIService.CreateAutoVPNPushConfigs", newBody[network_services.AutoVpnPushConfig]},
+	{http.MethodGet, "/sse/config/v1/auto-vpn-settings", "AutoVPNSettingsAPIService.GetAutoVPNSettings", nil},
+	{http.MethodPut, "/sse/config/v1/auto-vpn-settings", "AutoVPNSettingsAPIService.UpdateAutoVPNSettings", newBody[network_services.AutoVpnSettings]},
+	{http.MethodDelete, "/sse/config/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.DeleteBandwidthAllocations", nil},
+	{http.MethodGet, "/sse/config/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.ListBandwidthAllocations", nil},
+	{http.MethodPost, "/sse/config/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.CreateBandwidthAllocations", newBody[deployment_services.BandwidthAllocations]},
+	{http.MethodPut, "/sse/config/v1/bandwidth-allocations", "BandwidthAllocationsAPIService.UpdateBandwidthAllocations", newBody[deployment_services.BandwidthAllocations]},
+	{http.MethodGet, "/sse/config/v1/bgp-address-family-profiles", "BGPAddressFamilyProfilesAPIService.ListBGPAddressFamilyProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/bgp-address-family-profiles", "BGPAddressFamilyProfilesAPIService.CreateBGPAddressFamilyProfiles", newBody[network_services.BgpAddressFamilyProfiles]},
+	{http.MethodDelete, "/sse/config/v1/bgp-address-family-profiles/{id}", "BGPAddressFamilyProfilesAPIService.DeleteBGPAddressFamilyProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/bgp-address-family-profiles/{id}", "BGPAddressFamilyProfilesAPIService.GetBGPAddressFamilyProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/bgp-address-family-profiles/{id}", "BGPAddressFamilyProfilesAPIService.UpdateBGPAddressFamilyProfilesByID", newBody[network_services.BgpAddressFamilyProfiles]},
+	{http.MethodGet, "/sse/config/v1/bgp-auth-profiles", "BGPAuthenticationProfilesAPIService.ListBGPAuthenticationProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/bgp-auth-profiles", "BGPAuthenticationProfilesAPIService.CreateBGPAuthenticationProfiles", newBody[network_services.BgpAuthProfiles]},
+	{http.MethodDelete, "/sse/config/v1/bgp-auth-profiles/{id}", "BGPAuthenticationProfilesAPIService.DeleteBGPAuthenticationProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/bgp-auth-profiles/{id}", "BGPAuthenticationProfilesAPIService.GetBGPAuthenticationProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/bgp-auth-profiles/{id}", "BGPAuthenticationProfilesAPIService.UpdateBGPAuthenticationProfilesByID", newBody[network_services.BgpAuthProfiles]},
+	{http.MethodGet, "/sse/config/v1/bgp-filtering-profiles", "BGPFilteringProfilesAPIService.ListBGPFilteringProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/bgp-filtering-profiles", "BGPFilteringProfilesAPIService.CreateBGPFilteringProfiles", newBody[network_services.BgpFilteringProfiles]},
+	{http.MethodDelete, "/sse/config/v1/bgp-filtering-profiles/{id}", "BGPFilteringProfilesAPIService.DeleteBGPFilteringProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/bgp-filtering-profiles/{id}", "BGPFilteringProfilesAPIService.GetBGPFilteringProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/bgp-filtering-profiles/{id}", "BGPFilteringProfilesAPIService.UpdateBGPFilteringProfilesByID", newBody[network_services.BgpFilteringProfiles]},
+	{http.MethodGet, "/sse/config/v1/bgp-redistribution-profiles", "BGPRedistributionProfilesAPIService.ListBGPRedistributionProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/bgp-redistribution-profiles", "BGPRedistributionProfilesAPIService.CreateBGPRedistributionProfiles", newBody[network_services.BgpRedistributionProfiles]},
+	{http.MethodDelete, "/sse/config/v1/bgp-redistribution-profiles/{id}", "BGPRedistributionProfilesAPIService.DeleteBGPRedistributionProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/bgp-redistribution-profiles/{id}", "BGPRedistributionProfilesAPIService.GetBGPRedistributionProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/bgp-redistribution-profiles/{id}", "BGPRedistributionProfilesAPIService.UpdateBGPRedistributionProfilesByID", newBody[network_services.BgpRedistributionProfiles]},
+	{http.MethodGet, "/sse/config/v1/bgp-route-map-redistributions", "BGPRouteMapRedistributionsAPIService.ListBGPRouteMapRedistributions", nil},
+	{http.MethodPost, "/sse/config/v1/bgp-route-map-redistributions", "BGPRouteMapRedistributionsAPIService.CreateBGPRouteMapRedistributions", newBody[network_services.BgpRouteMapRedistributions]},
+	{http.MethodDelete, "/sse/config/v1/bgp-route-map-redistributions/{id}", "BGPRouteMapRedistributionsAPIService.DeleteBGPRouteMapRedistributionsByID", nil},
+	{http.MethodGet, "/sse/config/v1/bgp-route-map-redistributions/{id}", "BGPRouteMapRedistributionsAPIService.GetBGPRouteMapRedistributionsByID", nil},
+	{http.MethodPut, "/sse/config/v1/bgp-route-map-redistributions/{id}", "BGPRouteMapRedistributionsAPIService.UpdateBGPRouteMapRedistributionsByID", newBody[network_services.BgpRouteMapRedistributions]},
+	{http.MethodGet, "/sse/config/v1/bgp-route-maps", "BGPRouteMapsAPIService.ListBGPRouteMaps", nil},
+	{http.MethodPost, "/sse/config/v1/bgp-route-maps", "BGPRouteMapsAPIService.CreateBGPRouteMaps", newBody[network_services.BgpRouteMaps]},
+	{http.MethodDelete, "/sse/config/v1/bgp-route-maps/{id}", "BGPRouteMapsAPIService.DeleteBGPRouteMapsByID", nil},
+	{http.MethodGet, "/sse/config/v1/bgp-route-maps/{id}", "BGPRouteMapsAPIService.GetBGPRouteMapsByID", nil},
+	{http.MethodPut, "/sse/config/v1/bgp-route-maps/{id}", "BGPRouteMapsAPIService.UpdateBGPRouteMapsByID", newBody[network_services.BgpRouteMaps]},
+	{http.MethodGet, "/sse/config/v1/bgp-routing", "BGPRoutingAPIService.GetBGPRouting", nil},
+	{http.MethodPut, "/sse/config/v1/bgp-routing", "BGPRoutingAPIService.UpdateBGPRouting", newBody[deployment_services.BgpRouting]},
+	{http.MethodGet, "/sse/config/v1/certificate-profiles", "CertificateProfilesAPIService.ListCertificateProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/certificate-profiles", "CertificateProfilesAPIService.CreateCertificateProfiles", newBody[identity_services.CertificateProfiles]},
+	{http.MethodDelete, "/sse/config/v1/certificate-profiles/{id}", "CertificateProfilesAPIService.DeleteCertificateProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/certificate-profiles/{id}", "CertificateProfilesAPIService.GetCertificateProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/certificate-profiles/{id}", "CertificateProfilesAPIService.UpdateCertificateProfilesByID", newBody[identity_services.CertificateProfiles]},
+	{http.MethodGet, "/sse/config/v1/certificates", "CertificatesAPIService.ListCertificates", nil},
+	{http.MethodPost, "/sse/config/v1/certificates", "CertificatesAPIService.CreateCertificates", newBody[identity_services.CertificatesPost]},
+	{http.MethodDelete, "/sse/config/v1/certificates/{id}", "CertificatesAPIService.DeleteCertificatesByID", nil},
+	{http.MethodGet, "/sse/config/v1/certificates/{id}", "CertificatesAPIService.GetCertificatesByID", nil},
+	{http.MethodPost, "/sse/config/v1/certificates/{id}:export", "CertificatesAPIService.ExportCertificateByID", newBody[identity_services.ExportCertificatePayload]},
+	{http.MethodGet, "/sse/config/v1/config-match-list", "ConfigMatchListAPIService.ListConfigMatchList", nil},
+	{http.MethodPost, "/sse/config/v1/config-match-list", "ConfigMatchListAPIService.CreateConfigMatchList", newBody[network_services.ConfigMatchList]},
+	{http.MethodDelete, "/sse/config/v1/config-match-list/{id}", "ConfigMatchListAPIService.DeleteConfigMatchListByID", nil},
+	{http.MethodGet, "/sse/config/v1/config-match-list/{id}", "ConfigMatchListAPIService.GetConfigMatchListByID", nil},
+	{http.MethodPut, "/sse/config/v1/config-match-list/{id}", "ConfigMatchListAPIService.UpdateConfigMatchListByID", newBody[network_services.ConfigMatchList]},
+	{http.MethodGet, "/sse/config/v1/config-versions", "ConfigVersionsAPIService.ListConfigVersions", nil},
+	{http.MethodDelete, "/sse/config/v1/config-versions/candidate", "ConfigVersionsAPIService.DeleteCandidateConfigVersions", nil},
+	{http.MethodPost, "/sse/config/v1/config-versions/candidate:push", "ConfigVersionsAPIService.PushCandidateConfigVersions", newBody[config_operations.PushCandidateConfigVersionsRequest]},
+	{http.MethodGet, "/sse/config/v1/config-versions/running", "ConfigVersionsAPIService.GetRunningConfigVersions", nil},
+	{http.MethodGet, "/sse/config/v1/config-versions/{version}", "ConfigVersionsAPIService.GetConfigVersionsByID", nil},
+	{http.MethodPost, "/sse/config/v1/config-versions:load", "ConfigVersionsAPIService.LoadConfigVersions", newBody[config_operations.LoadConfig]},
+	{http.MethodGet, "/sse/config/v1/data-filtering-profiles", "DataFilteringAPIService.ListDataFilteringProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/data-filtering-profiles", "DataFilteringAPIService.CreateDataFilteringProfiles", newBody[security_services.DataFilteringProfiles]},
+	{http.MethodDelete, "/sse/config/v1/data-filtering-profiles/{id}", "DataFilteringAPIService.DeleteDataFilteringProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/data-filtering-profiles/{id}", "DataFilteringAPIService.GetDataFilteringProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/data-filtering-profiles/{id}", "DataFilteringAPIService.UpdateDataFilteringProfilesByID", newBody[security_services.DataFilteringProfiles]},
+	{http.MethodGet, "/sse/config/v1/data-objects", "DataObjectsAPIService.ListDataObjects", nil},
+	{http.MethodPost, "/sse/config/v1/data-objects", "DataObjectsAPIService.CreateDataObjects", newBody[security_services.DataObjects]},
+	{http.MethodDelete, "/sse/config/v1/data-objects/{id}", "DataObjectsAPIService.DeleteDataObjectsByID", nil},
+	{http.MethodGet, "/sse/config/v1/data-objects/{id}", "DataObjectsAPIService.GetDataObjectsByID", nil},
+	{http.MethodPut, "/sse/config/v1/data-objects/{id}", "DataObjectsAPIService.UpdateDataObjectsByID", newBody[security_services.DataObjects]},
+	{http.MethodGet, "/sse/config/v1/decryption-exclusions", "DecryptionExclusionsAPIService.ListDecryptionExclusions", nil},
+	{http.MethodPost, "/sse/config/v1/decryption-exclusions", "DecryptionExclusionsAPIService.CreateDecryptionExclusions", newBody[security_services.DecryptionExclusions]},
+	{http.MethodDelete, "/sse/config/v1/decryption-exclusions/{id}", "DecryptionExclusionsAPIService.DeleteDecryptionExclusionsByID", nil},
+	{http.MethodGet, "/sse/config/v1/decryption-exclusions/{id}", "DecryptionExclusionsAPIService.GetDecryptionExclusionsByID", nil},
+	{http.MethodPut, "/sse/config/v1/decryption-exclusions/{id}", "DecryptionExclusionsAPIService.UpdateDecryptionExclusionsByID", newBody[security_services.DecryptionExclusions]},
+	{http.MethodGet, "/sse/config/v1/decryption-profiles", "DecryptionProfilesAPIService.ListDecryptionProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/decryption-profiles", "DecryptionProfilesAPIService.CreateDecryptionProfiles", newBody[security_services.DecryptionProfiles]},
+	{http.MethodDelete, "/sse/config/v1/decryption-profiles/{id}", "DecryptionProfilesAPIService.DeleteDecryptionProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/decryption-profiles/{id}", "DecryptionProfilesAPIService.GetDecryptionProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/decryption-profiles/{id}", "DecryptionProfilesAPIService.UpdateDecryptionProfilesByID", newBody[security_services.DecryptionProfiles]},
+	{http.MethodGet, "/sse/config/v1/decryption-rules", "DecryptionRulesAPIService.ListDecryptionRules", nil},
+	{http.MethodPost, "/sse/config/v1/decryption-rules", "DecryptionRulesAPIService.CreateDecryptionRules", newBody[security_services.DecryptionRules]},
+	{http.MethodDelete, "/sse/config/v1/decryption-rules/{id}", "DecryptionRulesAPIService.DeleteDecryptionRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/decryption-rules/{id}", "DecryptionRulesAPIService.GetDecryptionRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/decryption-rules/{id}", "DecryptionRulesAPIService.UpdateDecryptionRulesByID", newBody[security_services.DecryptionRules]},
+	{http.MethodPost, "/sse/config/v1/decryption-rules/{id}:move", "DecryptionRulesAPIService.MoveDecryptionRulesByID", newBody[security_services.RuleBasedMove]},
+	{http.MethodGet, "/sse/config/v1/dhcp-interfaces", "DHCPInterfacesAPIService.ListDHCPInterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/dhcp-interfaces", "DHCPInterfacesAPIService.CreateDHCPInterfaces", newBody[network_services.DhcpInterfaces]},
+	{http.MethodDelete, "/sse/config/v1/dhcp-interfaces/{id}", "DHCPInterfacesAPIService.DeleteDHCPInterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/dhcp-interfaces/{id}", "DHCPInterfacesAPIService.GetDHCPInterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/dhcp-interfaces/{id}", "DHCPInterfacesAPIService.UpdateDHCPInterfacesByID", newBody[network_services.DhcpInterfaces]},
+	{http.MethodGet, "/sse/config/v1/dns-proxies", "DNSProxiesAPIService.ListDNSProxies", nil},
+	{http.MethodPost, "/sse/config/v1/dns-proxies", "DNSProxiesAPIService.CreateDNSProxies", newBody[network_services.DnsProxies]},
+	{http.MethodDelete, "/sse/config/v1/dns-proxies/{id}", "DNSProxiesAPIService.DeleteDNSProxiesByID", nil},
+	{http.MethodGet, "/sse/config/v1/dns-proxies/{id}", "DNSProxiesAPIService.GetDNSProxiesByID", nil},
+	{http.MethodPut, "/sse/config/v1/dns-proxies/{id}", "DNSProxiesAPIService.UpdateDNSProxiesByID", newBody[network_services.DnsProxies]},
+	{http.MethodGet, "/sse/config/v1/dns-security-profiles", "DNSSecurityProfilesAPIService.ListDNSSecurityProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/dns-security-profiles", "DNSSecurityProfilesAPIService.CreateDNSSecurityProfiles", newBody[security_services.DnsSecurityProfiles]},
+	{http.MethodDelete, "/sse/config/v1/dns-security-profiles/{id}", "DNSSecurityProfilesAPIService.DeleteDNSSecurityProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/dns-security-profiles/{id}", "DNSSecurityProfilesAPIService.GetDNSSecurityProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/dns-security-profiles/{id}", "DNSSecurityProfilesAPIService.UpdateDNSSecurityProfilesByID", newBody[security_services.DnsSecurityProfiles]},
+	{http.MethodGet, "/sse/config/v1/dos-protection-profiles", "DoSProtectionProfilesAPIService.ListDoSProtectionProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/dos-protection-profiles", "DoSProtectionProfilesAPIService.CreateDoSProtectionProfiles", newBody[security_services.DosProtectionProfiles]},
+	{http.MethodDelete, "/sse/config/v1/dos-protection-profiles/{id}", "DoSProtectionProfilesAPIService.DeleteDoSProtectionProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/dos-protection-profiles/{id}", "DoSProtectionProfilesAPIService.GetDoSProtectionProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/dos-protection-profiles/{id}", "DoSProtectionProfilesAPIService.UpdateDoSProtectionProfilesByID", newBody[security_services.DosProtectionProfiles]},
+	{http.MethodGet, "/sse/config/v1/dos-protection-rules", "DoSProtectionRulesAPIService.ListDoSProtectionRules", nil},
+	{http.MethodPost, "/sse/config/v1/dos-protection-rules", "DoSProtectionRulesAPIService.CreateDoSProtectionRules", newBody[security_services.DosProtectionRules]},
+	{http.MethodDelete, "/sse/config/v1/dos-protection-rules/{id}", "DoSProtectionRulesAPIService.DeleteDoSProtectionRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/dos-protection-rules/{id}", "DoSProtectionRulesAPIService.GetDoSProtectionRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/dos-protection-rules/{id}", "DoSProtectionRulesAPIService.UpdateDoSProtectionRulesByID", newBody[security_services.DosProtectionRules]},
+	{http.MethodGet, "/sse/config/v1/dynamic-user-groups", "DynamicUserGroupsAPIService.ListDynamicUserGroups", nil},
+	{http.MethodPost, "/sse/config/v1/dynamic-user-groups", "DynamicUserGroupsAPIService.CreateDynamicUserGroups", newBody[objects.DynamicUserGroups]},
+	{http.MethodDelete, "/sse/config/v1/dynamic-user-groups/{id}", "DynamicUserGroupsAPIService.DeleteDynamicUserGroupsByID", nil},
+	{http.MethodGet, "/sse/config/v1/dynamic-user-groups/{id}", "DynamicUserGroupsAPIService.GetDynamicUserGroupsByID", nil},
+	{http.MethodPut, "/sse/config/v1/dynamic-user-groups/{id}", "DynamicUserGroupsAPIService.UpdateDynamicUserGroupsByID", newBody[objects.DynamicUserGroups]},
+	{http.MethodPost, "/sse/config/v1/enable", "ApplicationDefaultsAPIService.CreateApplicationDefaults", nil},
+	{http.MethodGet, "/sse/config/v1/ethernet-interfaces", "EthernetInterfacesAPIService.ListEthernetInterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/ethernet-interfaces", "EthernetInterfacesAPIService.CreateEthernetInterfaces", newBody[network_services.EthernetInterfaces]},
+	{http.MethodDelete, "/sse/config/v1/ethernet-interfaces/{id}", "EthernetInterfacesAPIService.DeleteEthernetInterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/ethernet-interfaces/{id}", "EthernetInterfacesAPIService.GetEthernetInterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/ethernet-interfaces/{id}", "EthernetInterfacesAPIService.UpdateEthernetInterfacesByID", newBody[network_services.EthernetInterfaces]},
+	{http.MethodGet, "/sse/config/v1/external-dynamic-lists", "ExternalDynamicListsAPIService.ListExternalDynamicLists", nil},
+	{http.MethodPost, "/sse/config/v1/external-dynamic-lists", "ExternalDynamicListsAPIService.CreateExternalDynamicLists", newBody[objects.ExternalDynamicLists]},
+	{http.MethodDelete, "/sse/config/v1/external-dynamic-lists/{id}", "ExternalDynamicListsAPIService.DeleteExternalDynamicListsByID", nil},
+	{http.MethodGet, "/sse/config/v1/external-dynamic-lists/{id}", "ExternalDynamicListsAPIService.GetExternalDynamicListsByID", nil},
+	{http.MethodPut, "/sse/config/v1/external-dynamic-lists/{id}", "ExternalDynamicListsAPIService.UpdateExternalDynamicListsByID", newBody[objects.ExternalDynamicLists]},
+	{http.MethodGet, "/sse/config/v1/file-blocking-profiles", "FileBlockingProfilesAPIService.ListFileBlockingProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/file-blocking-profiles", "FileBlockingProfilesAPIService.CreateFileBlockingProfiles", newBody[security_services.FileBlockingProfiles]},
+	{http.MethodDelete, "/sse/config/v1/file-blocking-profiles/{id}", "FileBlockingProfilesAPIService.DeleteFileBlockingProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/file-blocking-profiles/{id}", "FileBlockingProfilesAPIService.GetFileBlockingProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/file-blocking-profiles/{id}", "FileBlockingProfilesAPIService.UpdateFileBlockingProfilesByID", newBody[security_services.FileBlockingProfiles]},
+	{http.MethodGet, "/sse/config/v1/globalprotect-match-list", "GlobalprotectMatchListAPIService.ListGlobalprotectMatchList", nil},
+	{http.MethodPost, "/sse/config/v1/globalprotect-match-list", "GlobalprotectMatchListAPIService.CreateGlobalprotectMatchList", newBody[network_services.GlobalprotectMatchList]},
+	{http.MethodDelete, "/sse/config/v1/globalprotect-match-list/{id}", "GlobalprotectMatchListAPIService.DeleteGlobalprotectMatchListByID", nil},
+	{http.MethodGet, "/sse/config/v1/globalprotect-match-list/{id}", "GlobalprotectMatchListAPIService.GetGlobalprotectMatchListByID", nil},
+	{http.MethodPut, "/sse/config/v1/globalprotect-match-list/{id}", "GlobalprotectMatchListAPIService.UpdateGlobalprotectMatchListByID", newBody[network_services.GlobalprotectMatchList]},
+	{http.MethodGet, "/sse/config/v1/hip-objects", "HIPObjectsAPIService.ListHIPObjects", nil},
+	{http.MethodPost, "/sse/config/v1/hip-objects", "HIPObjectsAPIService.CreateHIPObjects", newBody[objects.HipObjects]},
+	{http.MethodDelete, "/sse/config/v1/hip-objects/{id}", "HIPObjectsAPIService.DeleteHIPObjectsByID", nil},
+	{http.MethodGet, "/sse/config/v1/hip-objects/{id}", "HIPObjectsAPIService.GetHIPObjectsByID", nil},
+	{http.MethodPut, "/sse/config/v1/hip-objects/{id}", "HIPObjectsAPIService.UpdateHIPObjectsByID", newBody[objects.HipObjects]},
+	{http.MethodGet, "/sse/config/v1/hip-profiles", "HIPProfilesAPIService.ListHIPProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/hip-profiles", "HIPProfilesAPIService.CreateHIPProfiles", newBody[objects.HipProfiles]},
+	{http.MethodDelete, "/sse/config/v1/hip-profiles/{id}", "HIPProfilesAPIService.DeleteHIPProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/hip-profiles/{id}", "HIPProfilesAPIService.GetHIPProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/hip-profiles/{id}", "HIPProfilesAPIService.UpdateHIPProfilesByID", newBody[objects.HipProfiles]},
+	{http.MethodGet, "/sse/config/v1/hipmatch-match-list", "HipmatchMatchListAPIService.ListHipmatchMatchList", nil},
+	{http.MethodPost, "/sse/config/v1/hipmatch-match-list", "HipmatchMatchListAPIService.CreateHipmatchMatchList", newBody[network_services.HipmatchMatchList]},
+	{http.MethodDelete, "/sse/config/v1/hipmatch-match-list/{id}", "HipmatchMatchListAPIService.DeleteHipmatchMatchListByID", nil},
+	{http.MethodGet, "/sse/config/v1/hipmatch-match-list/{id}", "HipmatchMatchListAPIService.GetHipmatchMatchListByID", nil},
+	{http.MethodPut, "/sse/config/v1/hipmatch-match-list/{id}", "HipmatchMatchListAPIService.UpdateHipmatchMatchListByID", newBody[network_services.HipmatchMatchList]},
+	{http.MethodGet, "/sse/config/v1/http-header-profiles", "HTTPHeaderProfilesAPIService.ListHTTPHeaderProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/http-header-profiles", "HTTPHeaderProfilesAPIService.CreateHTTPHeaderProfiles", newBody[security_services.HttpHeaderProfiles]},
+	{http.MethodDelete, "/sse/config/v1/http-header-profiles/{id}", "HTTPHeaderProfilesAPIService.DeleteHTTPHeaderProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/http-header-profiles/{id}", "HTTPHeaderProfilesAPIService.GetHTTPHeaderProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/http-header-profiles/{id}", "HTTPHeaderProfilesAPIService.UpdateHTTPHeaderProfilesByID", newBody[security_services.HttpHeaderProfiles]},
+	{http.MethodGet, "/sse/config/v1/http-server-profiles", "HTTPServerProfilesAPIService.ListHTTPServerProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/http-server-profiles", "HTTPServerProfilesAPIService.CreateHTTPServerProfiles", newBody[objects.HttpServerProfiles]},
+	{http.MethodDelete, "/sse/config/v1/http-server-profiles/{id}", "HTTPServerProfilesAPIService.DeleteHTTPServerProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/http-server-profiles/{id}", "HTTPServerProfilesAPIService.GetHTTPServerProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/http-server-profiles/{id}", "HTTPServerProfilesAPIService.UpdateHTTPServerProfilesByID", newBody[objects.HttpServerProfiles]},
+	{http.MethodGet, "/sse/config/v1/ike-crypto-profiles", "IKECryptoProfilesAPIService.ListIKECryptoProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/ike-crypto-profiles", "IKECryptoProfilesAPIService.CreateIKECryptoProfiles", newBody[network_services.IkeCryptoProfiles]},
+	{http.MethodDelete, "/sse/config/v1/ike-crypto-profiles/{id}", "IKECryptoProfilesAPIService.DeleteIKECryptoProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/ike-crypto-profiles/{id}", "IKECryptoProfilesAPIService.GetIKECryptoProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/ike-crypto-profiles/{id}", "IKECryptoProfilesAPIService.UpdateIKECryptoProfilesByID", newBody[network_services.IkeCryptoProfiles]},
+	{http.MethodGet, "/sse/config/v1/ike-gateways", "IKEGatewaysAPIService.ListIKEGateways", nil},
+	{http.MethodPost, "/sse/config/v1/ike-gateways", "IKEGatewaysAPIService.CreateIKEGateways", newBody[network_services.IkeGateways]},
+	{http.MethodDelete, "/sse/config/v1/ike-gateways/{id}", "IKEGatewaysAPIService.DeleteIKEGatewaysByID", nil},
+	{http.MethodGet, "/sse/config/v1/ike-gateways/{id}", "IKEGatewaysAPIService.GetIKEGatewaysByID", nil},
+	{http.MethodPut, "/sse/config/v1/ike-gateways/{id}", "IKEGatewaysAPIService.UpdateIKEGatewaysByID", newBody[network_services.IkeGateways]},
+	{http.MethodGet, "/sse/config/v1/interface-management-profiles", "InterfaceManagementProfilesAPIService.ListInterfaceManagementProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/interface-management-profiles", "InterfaceManagementProfilesAPIService.CreateInterfaceManagementProfiles", newBody[network_services.InterfaceManagementProfiles]},
+	{http.MethodDelete, "/sse/config/v1/interface-management-profiles/{id}", "InterfaceManagementProfilesAPIService.DeleteInterfaceManagementProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/interface-management-profiles/{id}", "InterfaceManagementProfilesAPIService.GetInterfaceManagementProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/interface-management-profiles/{id}", "InterfaceManagementProfilesAPIService.UpdateInterfaceManagementProfilesByID", newBody[network_services.InterfaceManagementProfiles]},
+	{http.MethodGet, "/sse/config/v1/internal-dns-servers", "InternalDNSServersAPIService.ListInternalDNSServers", nil},
+	{http.MethodPost, "/sse/config/v1/internal-dns-servers", "InternalDNSServersAPIService.CreateInternalDNSServers", newBody[deployment_services.InternalDnsServers]},
+	{http.MethodDelete, "/sse/config/v1/internal-dns-servers/{id}", "InternalDNSServersAPIService.DeleteInternalDNSServersByID", nil},
+	{http.MethodGet, "/sse/config/v1/internal-dns-servers/{id}", "InternalDNSServersAPIService.GetInternalDNSServersByID", nil},
+	{http.MethodPut, "/sse/config/v1/internal-dns-servers/{id}", "InternalDNSServersAPIService.UpdateInternalDNSServersByID", newBody[deployment_services.InternalDnsServers]},
+	{http.MethodGet, "/sse/config/v1/ipsec-crypto-profiles", "IPsecCryptoProfilesAPIService.ListIPsecCryptoProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/ipsec-crypto-profiles", "IPsecCryptoProfilesAPIService.CreateIPsecCryptoProfiles", newBody[network_services.IpsecCryptoProfiles]},
+	{http.MethodDelete, "/sse/config/v1/ipsec-crypto-profiles/{id}", "IPsecCryptoProfilesAPIService.DeleteIPsecCryptoProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/ipsec-crypto-profiles/{id}", "IPsecCryptoProfilesAPIService.GetIPsecCryptoProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/ipsec-crypto-profiles/{id}", "IPsecCryptoProfilesAPIService.UpdateIPsecCryptoProfilesByID", newBody[network_services.IpsecCryptoProfiles]},
+	{http.MethodGet, "/sse/config/v1/ipsec-tunnels", "IPsecTunnelsAPIService.ListIPsecTunnels", nil},
+	{http.MethodPost, "/sse/config/v1/ipsec-tunnels", "IPsecTunnelsAPIService.CreateIPsecTunnels", newBody[network_services.IpsecTunnels]},
+	{http.MethodDelete, "/sse/config/v1/ipsec-tunnels/{id}", "IPsecTunnelsAPIService.DeleteIPsecTunnelsByID", nil},
+	{http.MethodGet, "/sse/config/v1/ipsec-tunnels/{id}", "IPsecTunnelsAPIService.GetIPsecTunnelsByID", nil},
+	{http.MethodPut, "/sse/config/v1/ipsec-tunnels/{id}", "IPsecTunnelsAPIService.UpdateIPsecTunnelsByID", newBody[network_services.IpsecTunnels]},
+	{http.MethodGet, "/sse/config/v1/iptag-match-list", "IptagMatchListAPIService.ListIptagMatchList", nil},
+	{http.MethodPost, "/sse/config/v1/iptag-match-list", "IptagMatchListAPIService.CreateIptagMatchList", newBody[network_services.IptagMatchList]},
+	{http.MethodDelete, "/sse/config/v1/iptag-match-list/{id}", "IptagMatchListAPIService.DeleteIptagMatchListByID", nil},
+	{http.MethodGet, "/sse/config/v1/iptag-match-list/{id}", "IptagMatchListAPIService.GetIptagMatchListByID", nil},
+	{http.MethodPut, "/sse/config/v1/iptag-match-list/{id}", "IptagMatchListAPIService.UpdateIptagMatchListByID", newBody[network_services.IptagMatchList]},
+	{http.MethodGet, "/sse/config/v1/jobs", "JobsAPIService.ListJobs", nil},
+	{http.MethodGet, "/sse/config/v1/jobs/{id}", "JobsAPIService.GetJobsByID", nil},
+	{http.MethodGet, "/sse/config/v1/kerberos-server-profiles", "KerberosServerProfilesAPIService.ListKerberosServerProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/kerberos-server-profiles", "KerberosServerProfilesAPIService.CreateKerberosServerProfiles", newBody[identity_services.KerberosServerProfiles]},
+	{http.MethodDelete, "/sse/config/v1/kerberos-server-profiles/{id}", "KerberosServerProfilesAPIService.DeleteKerberosServerProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/kerberos-server-profiles/{id}", "KerberosServerProfilesAPIService.GetKerberosServerProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/kerberos-server-profiles/{id}", "KerberosServerProfilesAPIService.UpdateKerberosServerProfilesByID", newBody[identity_services.KerberosServerProfiles]},
+	{http.MethodGet, "/sse/config/v1/layer2-subinterfaces", "Layer2SubinterfacesAPIService.ListLayer2Subinterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/layer2-subinterfaces", "Layer2SubinterfacesAPIService.CreateLayer2Subinterfaces", newBody[network_services.Layer2Subinterfaces]},
+	{http.MethodDelete, "/sse/config/v1/layer2-subinterfaces/{id}", "Layer2SubinterfacesAPIService.DeleteLayer2SubinterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/layer2-subinterfaces/{id}", "Layer2SubinterfacesAPIService.GetLayer2SubinterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/layer2-subinterfaces/{id}", "Layer2SubinterfacesAPIService.UpdateLayer2SubinterfacesByID", newBody[network_services.Layer2Subinterfaces]},
+	{http.MethodGet, "/sse/config/v1/layer3-subinterfaces", "Layer3SubinterfacesAPIService.ListLayer3Subinterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/layer3-subinterfaces", "Layer3SubinterfacesAPIService.CreateLayer3Subinterfaces", newBody[network_services.Layer3Subinterfaces]},
+	{http.MethodDelete, "/sse/config/v1/layer3-subinterfaces/{id}", "Layer3SubinterfacesAPIService.DeleteLayer3SubinterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/layer3-subinterfaces/{id}", "Layer3SubinterfacesAPIService.GetLayer3SubinterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/layer3-subinterfaces/{id}", "Layer3SubinterfacesAPIService.UpdateLayer3SubinterfacesByID", newBody[network_services.Layer3Subinterfaces]},
+	{http.MethodGet, "/sse/config/v1/ldap-server-profiles", "LDAPServerProfilesAPIService.ListLDAPServerProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/ldap-server-profiles", "LDAPServerProfilesAPIService.CreateLDAPServerProfiles", newBody[identity_services.LdapServerProfiles]},
+	{http.MethodDelete, "/sse/config/v1/ldap-server-profiles/{id}", "LDAPServerProfilesAPIService.DeleteLDAPServerProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/ldap-server-profiles/{id}", "LDAPServerProfilesAPIService.GetLDAPServerProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/ldap-server-profiles/{id}", "LDAPServerProfilesAPIService.UpdateLDAPServerProfiles", newBody[identity_services.LdapServerProfiles]},
+	{http.MethodGet, "/sse/config/v1/link-tags", "LinkTagsAPIService.ListLinkTags", nil},
+	{http.MethodPost, "/sse/config/v1/link-tags", "LinkTagsAPIService.CreateLinkTags", newBody[network_services.LinkTags]},
+	{http.MethodDelete, "/sse/config/v1/link-tags/{id}", "LinkTagsAPIService.DeleteLinkTagsByID", nil},
+	{http.MethodGet, "/sse/config/v1/link-tags/{id}", "LinkTagsAPIService.GetLinkTagsByID", nil},
+	{http.MethodPut, "/sse/config/v1/link-tags/{id}", "LinkTagsAPIService.UpdateLinkTagsByID", newBody[network_services.LinkTags]},
+	{http.MethodGet, "/sse/config/v1/lldp-profiles", "LLDPProfilesAPIService.ListLLDPProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/lldp-profiles", "LLDPProfilesAPIService.CreateLLDPProfiles", newBody[network_services.LldpProfiles]},
+	{http.MethodDelete, "/sse/config/v1/lldp-profiles/{id}", "LLDPProfilesAPIService.DeleteLLDPProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/lldp-profiles/{id}", "LLDPProfilesAPIService.GetLLDPProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/lldp-profiles/{id}", "LLDPProfilesAPIService.UpdateLLDPProfilesByID", newBody[network_services.LldpProfiles]},
+	{http.MethodGet, "/sse/config/v1/local-user-groups", "LocalUserGroupsAPIService.ListLocalUserGroups", nil},
+	{http.MethodPost, "/sse/config/v1/local-user-groups", "LocalUserGroupsAPIService.CreateLocalUserGroups", newBody[identity_services.LocalUserGroups]},
+	{http.MethodDelete, "/sse/config/v1/local-user-groups/{id}", "LocalUserGroupsAPIService.DeleteLocalUserGroupsByID", nil},
+	{http.MethodGet, "/sse/config/v1/local-user-groups/{id}", "LocalUserGroupsAPIService.GetLocalUserGroupsByID", nil},
+	{http.MethodPut, "/sse/config/v1/local-user-groups/{id}", "LocalUserGroupsAPIService.UpdateLocalUserGroupsByID", newBody[identity_services.LocalUserGroups]},
+	{http.MethodGet, "/sse/config/v1/local-users", "LocalUsersAPIService.ListLocalUsers", nil},
+	{http.MethodPost, "/sse/config/v1/local-users", "LocalUsersAPIService.CreateLocalUsers", newBody[identity_services.LocalUsers]},
+	{http.MethodDelete, "/sse/config/v1/local-users/{id}", "LocalUsersAPIService.DeleteLocalUsersByID", nil},
+	{http.MethodGet, "/sse/config/v1/local-users/{id}", "LocalUsersAPIService.GetLocalUsersByID", nil},
+	{http.MethodPut, "/sse/config/v1/local-users/{id}", "LocalUsersAPIService.UpdateLocalUsersByID", newBody[identity_services.LocalUsers]},
+	{http.MethodGet, "/sse/config/v1/locations", "NetworkLocationsAPIService.ListLocations", nil},
+	{http.MethodGet, "/sse/config/v1/log-forwarding-profiles", "LogForwardingProfilesAPIService.ListLogForwardingProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/log-forwarding-profiles", "LogForwardingProfilesAPIService.CreateLogForwardingProfiles", newBody[objects.LogForwardingProfiles]},
+	{http.MethodDelete, "/sse/config/v1/log-forwarding-profiles/{id}", "LogForwardingProfilesAPIService.DeleteLogForwardingProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/log-forwarding-profiles/{id}", "LogForwardingProfilesAPIService.GetLogForwardingProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/log-forwarding-profiles/{id}", "LogForwardingProfilesAPIService.UpdateLogForwardingProfilesByID", newBody[objects.LogForwardingProfiles]},
+	{http.MethodGet, "/sse/config/v1/logical-routers", "LogicalRoutersAPIService.ListLogicalRouters", nil},
+	{http.MethodPost, "/sse/config/v1/logical-routers", "LogicalRoutersAPIService.CreateLogicalRouters", newBody[network_services.LogicalRouters]},
+	{http.MethodDelete, "/sse/config/v1/logical-routers/{id}", "LogicalRoutersAPIService.DeleteLogicalRoutersByID", nil},
+	{http.MethodGet, "/sse/config/v1/logical-routers/{id}", "LogicalRoutersAPIService.GetLogicalRoutersByID", nil},
+	{http.MethodPut, "/sse/config/v1/logical-routers/{id}", "LogicalRoutersAPIService.UpdateLogicalRoutersByID", newBody[network_services.LogicalRouters]},
+	{http.MethodGet, "/sse/config/v1/loopback-interfaces", "LoopbackInterfacesAPIService.ListLoopbackInterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/loopback-interfaces", "LoopbackInterfacesAPIService.CreateLoopbackInterfaces", newBody[network_services.LoopbackInterfaces]},
+	{http.MethodDelete, "/sse/config/v1/loopback-interfaces/{id}", "LoopbackInterfacesAPIService.DeleteLoopbackInterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/loopback-interfaces/{id}", "LoopbackInterfacesAPIService.GetLoopbackInterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/loopback-interfaces/{id}", "LoopbackInterfacesAPIService.UpdateLoopbackInterfacesByID", newBody[network_services.LoopbackInterfaces]},
+	{http.MethodGet, "/sse/config/v1/mfa-servers", "MFAServersAPIService.ListMFAServers", nil},
+	{http.MethodPost, "/sse/config/v1/mfa-servers", "MFAServersAPIService.CreateMFAServers", newBody[identity_services.MfaServers]},
+	{http.MethodDelete, "/sse/config/v1/mfa-servers/{id}", "MFAServersAPIService.DeleteMFAServersByID", nil},
+	{http.MethodGet, "/sse/config/v1/mfa-servers/{id}", "MFAServersAPIService.GetMFAServersByID", nil},
+	{http.MethodPut, "/sse/config/v1/mfa-servers/{id}", "MFAServersAPIService.UpdateMFAServersByID", newBody[identity_services.MfaServers]},
+	{http.MethodGet, "/sse/config/v1/nat-rules", "NATRulesAPIService.ListNatRules", nil},
+	{http.MethodPost, "/sse/config/v1/nat-rules", "NATRulesAPIService.CreateNatRules", newBody[network_services.NatRules]},
+	{http.MethodDelete, "/sse/config/v1/nat-rules/{id}", "NATRulesAPIService.DeleteNatRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/nat-rules/{id}", "NATRulesAPIService.GetNatRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/nat-rules/{id}", "NATRulesAPIService.UpdateNatRulesByID", newBody[network_services.NatRules]},
+	{http.MethodGet, "/sse/config/v1/ocsp-responders", "OCSPRespondersAPIService.ListOCSPResponders", nil},
+	{http.MethodPost, "/sse/config/v1/ocsp-responders", "OCSPRespondersAPIService.CreateOCSPResponders", newBody[identity_services.OcspResponders]},
+	{http.MethodDelete, "/sse/config/v1/ocsp-responders/{id}", "OCSPRespondersAPIService.DeleteOCSPRespondersByID", nil},
+	{http.MethodGet, "/sse/config/v1/ocsp-responders/{id}", "OCSPRespondersAPIService.GetOCSPRespondersByID", nil},
+	{http.MethodPut, "/sse/config/v1/ocsp-responders/{id}", "OCSPRespondersAPIService.UpdateOCSPRespondersByID", newBody[identity_services.OcspResponders]},
+	{http.MethodGet, "/sse/config/v1/ospf-auth-profiles", "OSPFAuthenticationProfilesAPIService.ListOSPFAuthenticationProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/ospf-auth-profiles", "OSPFAuthenticationProfilesAPIService.CreateOSPFAuthenticationProfiles", newBody[network_services.OspfAuthProfiles]},
+	{http.MethodDelete, "/sse/config/v1/ospf-auth-profiles/{id}", "OSPFAuthenticationProfilesAPIService.DeleteOSPFAuthenticationProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/ospf-auth-profiles/{id}", "OSPFAuthenticationProfilesAPIService.GetOSPFAuthenticationProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/ospf-auth-profiles/{id}", "OSPFAuthenticationProfilesAPIService.UpdateOSPFAuthenticationProfilesByID", newBody[network_services.OspfAuthProfiles]},
+	{http.MethodGet, "/sse/config/v1/pbf-rules", "PBFRulesAPIService.ListPBFRules", nil},
+	{http.MethodPost, "/sse/config/v1/pbf-rules", "PBFRulesAPIService.CreatePBFRules", newBody[network_services.PbfRules]},
+	{http.MethodDelete, "/sse/config/v1/pbf-rules/{id}", "PBFRulesAPIService.DeletePBFRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/pbf-rules/{id}", "PBFRulesAPIService.GetPBFRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/pbf-rules/{id}", "PBFRulesAPIService.UpdatePBFRulesByID", newBody[network_services.PbfRules]},
+	{http.MethodGet, "/sse/config/v1/profile-groups", "ProfileGroupsAPIService.ListProfileGroups", nil},
+	{http.MethodPost, "/sse/config/v1/profile-groups", "ProfileGroupsAPIService.CreateProfileGroups", newBody[security_services.ProfileGroups]},
+	{http.MethodDelete, "/sse/config/v1/profile-groups/{id}", "ProfileGroupsAPIService.DeleteProfileGroupsByID", nil},
+	{http.MethodGet, "/sse/config/v1/profile-groups/{id}", "ProfileGroupsAPIService.GetProfileGroupsByID", nil},
+	{http.MethodPut, "/sse/config/v1/profile-groups/{id}", "ProfileGroupsAPIService.UpdateProfileGroupsByID", newBody[security_services.ProfileGroups]},
+	{http.MethodGet, "/sse/config/v1/qos-policy-rules", "QoSRulesAPIService.ListQoSPolicyRules", nil},
+	{http.MethodPost, "/sse/config/v1/qos-policy-rules", "QoSRulesAPIService.CreateQoSPolicyRules", newBody[network_services.QosPolicyRules]},
+	{http.MethodDelete, "/sse/config/v1/qos-policy-rules/{id}", "QoSRulesAPIService.DeleteQoSPolicyRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/qos-policy-rules/{id}", "QoSRulesAPIService.GetQoSPolicyRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/qos-policy-rules/{id}", "QoSRulesAPIService.UpdateQoSPolicyRulesByID", newBody[network_services.QosPolicyRules]},
+	{http.MethodPost, "/sse/config/v1/qos-policy-rules/{id}:move", "QoSRulesAPIService.MoveQoSPolicyRulesByID", newBody[network_services.RuleBasedMove]},
+	{http.MethodGet, "/sse/config/v1/qos-profiles", "QoSProfilesAPIService.ListQoSProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/qos-profiles", "QoSProfilesAPIService.CreateQoSProfiles", newBody[network_services.QosProfiles]},
+	{http.MethodDelete, "/sse/config/v1/qos-profiles/{id}", "QoSProfilesAPIService.DeleteQoSProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/qos-profiles/{id}", "QoSProfilesAPIService.GetQoSProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/qos-profiles/{id}", "QoSProfilesAPIService.UpdateQoSProfilesByID", newBody[network_services.QosProfiles]},
+	{http.MethodDelete, "/sse/config/v1/quarantined-devices", "QuarantinedDevicesAPIService.DeleteQuarantinedDevices", nil},
+	{http.MethodGet, "/sse/config/v1/quarantined-devices", "QuarantinedDevicesAPIService.ListQuarantinedDevices", nil},
+	{http.MethodPost, "/sse/config/v1/quarantined-devices", "QuarantinedDevicesAPIService.CreateQuarantinedDevices", newBody[objects.QuarantinedDevices]},
+	{http.MethodGet, "/sse/config/v1/radius-server-profiles", "RADIUSServerProfilesAPIService.ListRADIUSServerProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/radius-server-profiles", "RADIUSServerProfilesAPIService.CreateRADIUSServerProfiles", newBody[identity_services.RadiusServerProfiles]},
+	{http.MethodDelete, "/sse/config/v1/radius-server-profiles/{id}", "RADIUSServerProfilesAPIService.DeleteRADIUSServerProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/radius-server-profiles/{id}", "RADIUSServerProfilesAPIService.GetRADIUSServerProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/radius-server-profiles/{id}", "RADIUSServerProfilesAPIService.UpdateRADIUSServerProfilesByID", newBody[identity_services.RadiusServerProfiles]},
+	{http.MethodGet, "/sse/config/v1/regions", "RegionsAPIService.ListRegions", nil},
+	{http.MethodPost, "/sse/config/v1/regions", "RegionsAPIService.CreateRegions", newBody[objects.Regions]},
+	{http.MethodDelete, "/sse/config/v1/regions/{id}", "RegionsAPIService.DeleteRegionsByID", nil},
+	{http.MethodGet, "/sse/config/v1/regions/{id}", "RegionsAPIService.GetRegionsByID", nil},
+	{http.MethodPut, "/sse/config/v1/regions/{id}", "RegionsAPIService.UpdateRegionsByID", newBody[objects.Regions]},
+	{http.MethodGet, "/sse/config/v1/remote-networks", "RemoteNetworksAPIService.ListRemoteNetworks", nil},
+	{http.MethodPost, "/sse/config/v1/remote-networks", "RemoteNetworksAPIService.CreateRemoteNetworks", newBody[deployment_services.RemoteNetworks]},
+	{http.MethodGet, "/sse/config/v1/remote-networks-license-info", "RemoteNetworksLicenseAPIService.GetRemoteNetworksLicenseInfo", nil},
+	{http.MethodDelete, "/sse/config/v1/remote-networks/{id}", "RemoteNetworksAPIService.DeleteRemoteNetworksByID", nil},
+	{http.MethodGet, "/sse/config/v1/remote-networks/{id}", "RemoteNetworksAPIService.GetRemoteNetworksByID", nil},
+	{http.MethodPut, "/sse/config/v1/remote-networks/{id}", "RemoteNetworksAPIService.UpdateRemoteNetworksByID", newBody[deployment_services.RemoteNetworks]},
+	{http.MethodGet, "/sse/config/v1/route-access-lists", "RouteAccessListsAPIService.ListRouteAccessLists", nil},
+	{http.MethodPost, "/sse/config/v1/route-access-lists", "RouteAccessListsAPIService.CreateRouteAccessLists", newBody[network_services.RouteAccessLists]},
+	{http.MethodDelete, "/sse/config/v1/route-access-lists/{id}", "RouteAccessListsAPIService.DeleteRouteAccessListsByID", nil},
+	{http.MethodGet, "/sse/config/v1/route-access-lists/{id}", "RouteAccessListsAPIService.GetRouteAccessListsByID", nil},
+	{http.MethodPut, "/sse/config/v1/route-access-lists/{id}", "RouteAccessListsAPIService.UpdateRouteAccessListsByID", newBody[network_services.RouteAccessLists]},
+	{http.MethodGet, "/sse/config/v1/route-community-lists", "RouteCommunityListsAPIService.ListRouteCommunityLists", nil},
+	{http.MethodPost, "/sse/config/v1/route-community-lists", "RouteCommunityListsAPIService.CreateRouteCommunityLists", newBody[network_services.RouteCommunityLists]},
+	{http.MethodDelete, "/sse/config/v1/route-community-lists/{id}", "RouteCommunityListsAPIService.DeleteRouteCommunityListsByID", nil},
+	{http.MethodGet, "/sse/config/v1/route-community-lists/{id}", "RouteCommunityListsAPIService.GetRouteCommunityListsByID", nil},
+	{http.MethodPut, "/sse/config/v1/route-community-lists/{id}", "RouteCommunityListsAPIService.UpdateRouteCommunityListsByID", newBody[network_services.RouteCommunityLists]},
+	{http.MethodGet, "/sse/config/v1/route-path-access-lists", "RoutePathAccessListsAPIService.ListRoutePathAccessLists", nil},
+	{http.MethodPost, "/sse/config/v1/route-path-access-lists", "RoutePathAccessListsAPIService.CreateRoutePathAccessLists", newBody[network_services.RoutePathAccessLists]},
+	{http.MethodDelete, "/sse/config/v1/route-path-access-lists/{id}", "RoutePathAccessListsAPIService.DeleteRoutePathAccessListsByID", nil},
+	{http.MethodGet, "/sse/config/v1/route-path-access-lists/{id}", "RoutePathAccessListsAPIService.GetRoutePathAccessListsByID", nil},
+	{http.MethodPut, "/sse/config/v1/route-path-access-lists/{id}", "RoutePathAccessListsAPIService.UpdateRoutePathAccessListsByID", newBody[network_services.RoutePathAccessLists]},
+	{http.MethodGet, "/sse/config/v1/route-prefix-lists", "RoutePrefixListsAPIService.ListRoutePrefixLists", nil},
+	{http.MethodPost, "/sse/config/v1/route-prefix-lists", "RoutePrefixListsAPIService.CreateRoutePrefixLists", newBody[network_services.RoutePrefixLists]},
+	{http.MethodDelete, "/sse/config/v1/route-prefix-lists/{id}", "RoutePrefixListsAPIService.DeleteRoutePrefixListsByID", nil},
+	{http.MethodGet, "/sse/config/v1/route-prefix-lists/{id}", "RoutePrefixListsAPIService.GetRoutePrefixListsByID", nil},
+	{http.MethodPut, "/sse/config/v1/route-prefix-lists/{id}", "RoutePrefixListsAPIService.UpdateRoutePrefixListsByID", newBody[network_services.RoutePrefixLists]},
+	{http.MethodGet, "/sse/config/v1/saas-tenant-restrictions", "SaasTenantRestrictionsAPIService.GetSaasTenantRestrictions", nil},
+	{http.MethodPut, "/sse/config/v1/saas-tenant-restrictions", "SaasTenantRestrictionsAPIService.UpdateSaasTenantRestrictions", newBody[security_services.SaasTenantRestrictions]},
+	{http.MethodGet, "/sse/config/v1/saml-server-profiles", "SAMLServerProfilesAPIService.ListSAMLServerProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/saml-server-profiles", "SAMLServerProfilesAPIService.CreateSAMLServerProfiles", newBody[identity_services.SamlServerProfiles]},
+	{http.MethodDelete, "/sse/config/v1/saml-server-profiles/{id}", "SAMLServerProfilesAPIService.DeleteSAMLServerProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/saml-server-profiles/{id}", "SAMLServerProfilesAPIService.GetSAMLServerProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/saml-server-profiles/{id}", "SAMLServerProfilesAPIService.UpdateSAMLServerProfilesByID", newBody[identity_services.SamlServerProfiles]},
+	{http.MethodGet, "/sse/config/v1/scep-profiles", "SCEPProfilesAPIService.ListSCEPProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/scep-profiles", "SCEPProfilesAPIService.CreateSCEPProfiles", newBody[identity_services.ScepProfiles]},
+	{http.MethodDelete, "/sse/config/v1/scep-profiles/{id}", "SCEPProfilesAPIService.DeleteSCEPProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/scep-profiles/{id}", "SCEPProfilesAPIService.GetSCEPProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/scep-profiles/{id}", "SCEPProfilesAPIService.UpdateSCEPProfilesByID", newBody[identity_services.ScepProfiles]},
+	{http.MethodGet, "/sse/config/v1/schedules", "SchedulesAPIService.ListSchedules", nil},
+	{http.MethodPost, "/sse/config/v1/schedules", "SchedulesAPIService.CreateSchedules", newBody[objects.Schedules]},
+	{http.MethodDelete, "/sse/config/v1/schedules/{id}", "SchedulesAPIService.DeleteSchedulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/schedules/{id}", "SchedulesAPIService.GetSchedulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/schedules/{id}", "SchedulesAPIService.UpdateSchedulesByID", newBody[objects.Schedules]},
+	{http.MethodGet, "/sse/config/v1/sdwan-error-correction-profiles", "SDWANErrorCorrectionProfilesAPIService.ListSDWANErrorCorrectionProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/sdwan-error-correction-profiles", "SDWANErrorCorrectionProfilesAPIService.CreateSDWANErrorCorrectionProfiles", newBody[network_services.SdwanErrorCorrectionProfiles]},
+	{http.MethodDelete, "/sse/config/v1/sdwan-error-correction-profiles/{id}", "SDWANErrorCorrectionProfilesAPIService.DeleteSDWANErrorCorrectionProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/sdwan-error-correction-profiles/{id}", "SDWANErrorCorrectionProfilesAPIService.GetSDWANErrorCorrectionProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/sdwan-error-correction-profiles/{id}", "SDWANErrorCorrectionProfilesAPIService.UpdateSDWANErrorCorrectionProfilesByID", newBody[network_services.SdwanErrorCorrectionProfiles]},
+	{http.MethodGet, "/sse/config/v1/sdwan-path-quality-profiles", "SDWANPathQualityProfilesAPIService.ListSDWANPathQualityProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/sdwan-path-quality-profiles", "SDWANPathQualityProfilesAPIService.CreateSDWANPathQualityProfiles", newBody[network_services.SdwanPathQualityProfiles]},
+	{http.MethodDelete, "/sse/config/v1/sdwan-path-quality-profiles/{id}", "SDWANPathQualityProfilesAPIService.DeleteSDWANPathQualityProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/sdwan-path-quality-profiles/{id}", "SDWANPathQualityProfilesAPIService.GetSDWANPathQualityProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/sdwan-path-quality-profiles/{id}", "SDWANPathQualityProfilesAPIService.UpdateSDWANPathQualityProfilesByID", newBody[network_services.SdwanPathQualityProfiles]},
+	{http.MethodGet, "/sse/config/v1/sdwan-rules", "SDWANRulesAPIService.ListSDWANRules", nil},
+	{http.MethodPost, "/sse/config/v1/sdwan-rules", "SDWANRulesAPIService.CreateSDWANRules", newBody[network_services.SdwanRules]},
+	{http.MethodDelete, "/sse/config/v1/sdwan-rules/{id}", "SDWANRulesAPIService.DeleteSDWANRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/sdwan-rules/{id}", "SDWANRulesAPIService.GetSDWANRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/sdwan-rules/{id}", "SDWANRulesAPIService.UpdateSDWANRulesByID", newBody[network_services.SdwanRules]},
+	{http.MethodGet, "/sse/config/v1/sdwan-saas-quality-profiles", "SDWANSaaSQualityProfilesAPIService.ListSDWANSaaSQualityProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/sdwan-saas-quality-profiles", "SDWANSaaSQualityProfilesAPIService.CreateSDWANSaaSQualityProfiles", newBody[network_services.SdwanSaasQualityProfiles]},
+	{http.MethodDelete, "/sse/config/v1/sdwan-saas-quality-profiles/{id}", "SDWANSaaSQualityProfilesAPIService.DeleteSDWANSaaSQualityProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/sdwan-saas-quality-profiles/{id}", "SDWANSaaSQualityProfilesAPIService.GetSDWANSaaSQualityProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/sdwan-saas-quality-profiles/{id}", "SDWANSaaSQualityProfilesAPIService.UpdateSDWANSaaSQualityProfilesByID", newBody[network_services.SdwanSaasQualityProfiles]},
+	{http.MethodGet, "/sse/config/v1/sdwan-traffic-distribution-profiles", "SDWANTrafficDistributionProfilesAPIService.ListSDWANTrafficDistributionProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/sdwan-traffic-distribution-profiles", "SDWANTrafficDistributionProfilesAPIService.CreateSDWANTrafficDistributionProfiles", newBody[network_services.SdwanTrafficDistributionProfiles]},
+	{http.MethodDelete, "/sse/config/v1/sdwan-traffic-distribution-profiles/{id}", "SDWANTrafficDistributionProfilesAPIService.DeleteSDWANTrafficDistributionProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/sdwan-traffic-distribution-profiles/{id}", "SDWANTrafficDistributionProfilesAPIService.GetSDWANTrafficDistributionProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/sdwan-traffic-distribution-profiles/{id}", "SDWANTrafficDistributionProfilesAPIService.UpdateSDWANTrafficDistributionProfilesByID", newBody[network_services.SdwanTrafficDistributionProfiles]},
+	{http.MethodGet, "/sse/config/v1/security-rules", "SecurityRulesAPIService.ListRules", nil},
+	{http.MethodPost, "/sse/config/v1/security-rules", "SecurityRulesAPIService.CreateSecurityRules", newBody[security_services.SecurityRules]},
+	{http.MethodDelete, "/sse/config/v1/security-rules/{id}", "SecurityRulesAPIService.DeleteSecurityRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/security-rules/{id}", "SecurityRulesAPIService.GetSecurityRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/security-rules/{id}", "SecurityRulesAPIService.UpdateSecurityRulesByID", newBody[security_services.SecurityRules]},
+	{http.MethodPost, "/sse/config/v1/security-rules/{id}:move", "SecurityRulesAPIService.MoveSecurityRulesByID", newBody[security_services.RuleBasedMove]},
+	{http.MethodGet, "/sse/config/v1/service-connection-groups", "ServiceConnectionGroupsAPIService.ListServiceConnectionGroups", nil},
+	{http.MethodPost, "/sse/config/v1/service-connection-groups", "ServiceConnectionGroupsAPIService.CreateServiceConnectionGroups", newBody[deployment_services.ServiceConnectionGroups]},
+	{http.MethodDelete, "/sse/config/v1/service-connection-groups/{id}", "ServiceConnectionGroupsAPIService.DeleteServiceConnectionGroupsByID", nil},
+	{http.MethodGet, "/sse/config/v1/service-connection-groups/{id}", "ServiceConnectionGroupsAPIService.GetServiceConnectionGroupsByID", nil},
+	{http.MethodPut, "/sse/config/v1/service-connection-groups/{id}", "ServiceConnectionGroupsAPIService.UpdateServiceConnectionGroupsByID", newBody[deployment_services.ServiceConnectionGroups]},
+	{http.MethodGet, "/sse/config/v1/service-connections", "ServiceConnectionsAPIService.ListServiceConnections", nil},
+	{http.MethodPost, "/sse/config/v1/service-connections", "ServiceConnectionsAPIService.CreateServiceConnections", newBody[deployment_services.ServiceConnections]},
+	{http.MethodDelete, "/sse/config/v1/service-connections/{id}", "ServiceConnectionsAPIService.DeleteServiceConnectionsByID", nil},
+	{http.MethodGet, "/sse/config/v1/service-connections/{id}", "ServiceConnectionsAPIService.GetServiceConnectionsByID", nil},
+	{http.MethodPut, "/sse/config/v1/service-connections/{id}", "ServiceConnectionsAPIService.UpdateServiceConnectionsByID", newBody[deployment_services.ServiceConnections]},
+	{http.MethodGet, "/sse/config/v1/service-groups", "ServiceGroupsAPIService.ListServiceGroups", nil},
+	{http.MethodPost, "/sse/config/v1/service-groups", "ServiceGroupsAPIService.CreateServiceGroups", newBody[objects.ServiceGroups]},
+	{http.MethodDelete, "/sse/config/v1/service-groups/{id}", "ServiceGroupsAPIService.DeleteServiceGroupsByID", nil},
+	{http.MethodGet, "/sse/config/v1/service-groups/{id}", "ServiceGroupsAPIService.GetServiceGroupsByID", nil},
+	{http.MethodPut, "/sse/config/v1/service-groups/{id}", "ServiceGroupsAPIService.UpdateServiceGroupsByID", newBody[objects.ServiceGroups]},
+	{http.MethodGet, "/sse/config/v1/services", "ServicesAPIService.ListServices", nil},
+	{http.MethodPost, "/sse/config/v1/services", "ServicesAPIService.CreateServices", newBody[objects.Services]},
+	{http.MethodDelete, "/sse/config/v1/services/{id}", "ServicesAPIService.DeleteServicesByID", nil},
+	{http.MethodGet, "/sse/config/v1/services/{id}", "ServicesAPIService.GetServicesByID", nil},
+	{http.MethodPut, "/sse/config/v1/services/{id}", "ServicesAPIService.UpdateServicesByID", newBody[objects.Services]},
+	{http.MethodGet, "/sse/config/v1/shared-infrastructure-settings", "SharedInfrastructureSettingsAPIService.GetSharedInfrastructureSettings", nil},
+	{http.MethodPut, "/sse/config/v1/shared-infrastructure-settings", "SharedInfrastructureSettingsAPIService.UpdateSharedInfrastructureSettings", newBody[deployment_services.EditSharedInfrastructureSettings]},
+	{http.MethodGet, "/sse/config/v1/sites", "SitesAPIService.ListSites", nil},
+	{http.MethodPost, "/sse/config/v1/sites", "SitesAPIService.CreateSites", newBody[deployment_services.Sites]},
+	{http.MethodDelete, "/sse/config/v1/sites/{id}", "SitesAPIService.DeleteSitesByID", nil},
+	{http.MethodGet, "/sse/config/v1/sites/{id}", "SitesAPIService.GetSitesByID", nil},
+	{http.MethodPut, "/sse/config/v1/sites/{id}", "SitesAPIService.UpdateSitesByID", newBody[deployment_services.Sites]},
+	{http.MethodDelete, "/sse/config/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.DeleteSslDecryptionSettings", nil},
+	{http.MethodGet, "/sse/config/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.GetSslDecryptionSettings", nil},
+	{http.MethodPost, "/sse/config/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.PostSslDecryptionSettings", newBody[security_services.SslDecryptionSettings]},
+	{http.MethodPut, "/sse/config/v1/ssl-decryption-settings", "SslDecryptionSettingsAPIService.PutSslDecryptionSettings", newBody[security_services.SslDecryptionSettingsGetPut]},
+	{http.MethodGet, "/sse/config/v1/syslog-server-profiles", "SyslogServerProfilesAPIService.ListSyslogServerProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/syslog-server-profiles", "SyslogServerProfilesAPIService.CreateSyslogServerProfiles", newBody[objects.SyslogServerProfiles]},
+	{http.MethodDelete, "/sse/config/v1/syslog-server-profiles/{id}", "SyslogServerProfilesAPIService.DeleteSyslogServerProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/syslog-server-profiles/{id}", "SyslogServerProfilesAPIService.GetSyslogServerProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/syslog-server-profiles/{id}", "SyslogServerProfilesAPIService.UpdateSyslogServerProfilesByID", newBody[objects.SyslogServerProfiles]},
+	{http.MethodGet, "/sse/config/v1/system-match-list", "SystemMatchListAPIService.ListSystemMatchList", nil},
+	{http.MethodPost, "/sse/config/v1/system-match-list", "SystemMatchListAPIService.CreateSystemMatchList", newBody[network_services.SystemMatchList]},
+	{http.MethodDelete, "/sse/config/v1/system-match-list/{id}", "SystemMatchListAPIService.DeleteSystemMatchListByID", nil},
+	{http.MethodGet, "/sse/config/v1/system-match-list/{id}", "SystemMatchListAPIService.GetSystemMatchListByID", nil},
+	{http.MethodPut, "/sse/config/v1/system-match-list/{id}", "SystemMatchListAPIService.UpdateSystemMatchListByID", newBody[network_services.SystemMatchList]},
+	{http.MethodGet, "/sse/config/v1/tacacs-server-profiles", "TACACSServerProfilesAPIService.ListTACACSServerProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/tacacs-server-profiles", "TACACSServerProfilesAPIService.CreateTACACSServerProfiles", newBody[identity_services.TacacsServerProfiles]},
+	{http.MethodDelete, "/sse/config/v1/tacacs-server-profiles/{id}", "TACACSServerProfilesAPIService.DeleteTACACSServerProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/tacacs-server-profiles/{id}", "TACACSServerProfilesAPIService.GetTACACSServerProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/tacacs-server-profiles/{id}", "TACACSServerProfilesAPIService.UpdateTACACSServerProfilesByID", newBody[identity_services.TacacsServerProfiles]},
+	{http.MethodGet, "/sse/config/v1/tags", "TagsAPIService.ListTags", nil},
+	{http.MethodPost, "/sse/config/v1/tags", "TagsAPIService.CreateTags", newBody[objects.Tags]},
+	{http.MethodDelete, "/sse/config/v1/tags/{id}", "TagsAPIService.DeleteTagsByID", nil},
+	{http.MethodGet, "/sse/config/v1/tags/{id}", "TagsAPIService.GetTagsByID", nil},
+	{http.MethodPut, "/sse/config/v1/tags/{id}", "TagsAPIService.UpdateTagsByID", newBody[objects.Tags]},
+	{http.MethodGet, "/sse/config/v1/tls-service-profiles", "TLSServiceProfilesAPIService.ListTLSServiceProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/tls-service-profiles", "TLSServiceProfilesAPIService.CreateTLSServiceProfiles", newBody[identity_services.TlsServiceProfiles]},
+	{http.MethodDelete, "/sse/config/v1/tls-service-profiles/{id}", "TLSServiceProfilesAPIService.DeleteTLSServiceProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/tls-service-profiles/{id}", "TLSServiceProfilesAPIService.GetTLSServiceProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/tls-service-profiles/{id}", "TLSServiceProfilesAPIService.UpdateTLSServiceProfilesByID", newBody[identity_services.TlsServiceProfiles]},
+	{http.MethodGet, "/sse/config/v1/traffic-steering-rules", "TrafficSteeringRulesAPIService.ListTrafficSteeringRules", nil},
+	{http.MethodPost, "/sse/config/v1/traffic-steering-rules", "TrafficSteeringRulesAPIService.CreateTrafficSteeringRules", newBody[deployment_services.TrafficSteeringRules]},
+	{http.MethodDelete, "/sse/config/v1/traffic-steering-rules/{id}", "TrafficSteeringRulesAPIService.DeleteTrafficSteeringRulesByID", nil},
+	{http.MethodGet, "/sse/config/v1/traffic-steering-rules/{id}", "TrafficSteeringRulesAPIService.GetTrafficSteeringRulesByID", nil},
+	{http.MethodPut, "/sse/config/v1/traffic-steering-rules/{id}", "TrafficSteeringRulesAPIService.UpdateTrafficSteeringRulesByID", newBody[deployment_services.TrafficSteeringRules]},
+	{http.MethodGet, "/sse/config/v1/trusted-certificate-authorities", "TrustedCertificateAuthoritiesAPIService.ListTrustedCertificateAuthorities", nil},
+	{http.MethodGet, "/sse/config/v1/tunnel-interfaces", "TunnelInterfacesAPIService.ListTunnelInterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/tunnel-interfaces", "TunnelInterfacesAPIService.CreateTunnelInterfaces", newBody[network_services.TunnelInterfaces]},
+	{http.MethodDelete, "/sse/config/v1/tunnel-interfaces/{id}", "TunnelInterfacesAPIService.DeleteTunnelInterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/tunnel-interfaces/{id}", "TunnelInterfacesAPIService.GetTunnelInterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/tunnel-interfaces/{id}", "TunnelInterfacesAPIService.UpdateTunnelInterfacesByID", newBody[network_services.TunnelInterfaces]},
+	{http.MethodGet, "/sse/config/v1/url-access-profiles", "URLAccessProfilesAPIService.ListURLAccessProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/url-access-profiles", "URLAccessProfilesAPIService.CreateURLAccessProfiles", newBody[security_services.UrlAccessProfiles]},
+	{http.MethodDelete, "/sse/config/v1/url-access-profiles/{id}", "URLAccessProfilesAPIService.DeleteURLAccessProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/url-access-profiles/{id}", "URLAccessProfilesAPIService.GetURLAccessProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/url-access-profiles/{id}", "URLAccessProfilesAPIService.UpdateURLAccessProfilesByID", newBody[security_services.UrlAccessProfiles]},
+	{http.MethodGet, "/sse/config/v1/url-categories", "URLCategoriesAPIService.ListURLCategories", nil},
+	{http.MethodPost, "/sse/config/v1/url-categories", "URLCategoriesAPIService.CreateURLCategories", newBody[security_services.UrlCategories]},
+	{http.MethodDelete, "/sse/config/v1/url-categories/{id}", "URLCategoriesAPIService.DeleteURLCategoriesByID", nil},
+	{http.MethodGet, "/sse/config/v1/url-categories/{id}", "URLCategoriesAPIService.GetURLCategoriesByID", nil},
+	{http.MethodPut, "/sse/config/v1/url-categories/{id}", "URLCategoriesAPIService.UpdateURLCategoriesByID", newBody[security_services.UrlCategories]},
+	{http.MethodGet, "/sse/config/v1/url-filtering-categories", "URLFilteringCategoriesAPIService.ListURLFilteringCategories", nil},
+	{http.MethodGet, "/sse/config/v1/userid-match-list", "UseridMatchListAPIService.ListUseridMatchList", nil},
+	{http.MethodPost, "/sse/config/v1/userid-match-list", "UseridMatchListAPIService.CreateUseridMatchList", newBody[network_services.UseridMatchList]},
+	{http.MethodDelete, "/sse/config/v1/userid-match-list/{id}", "UseridMatchListAPIService.DeleteUseridMatchListByID", nil},
+	{http.MethodGet, "/sse/config/v1/userid-match-list/{id}", "UseridMatchListAPIService.GetUseridMatchListByID", nil},
+	{http.MethodPut, "/sse/config/v1/userid-match-list/{id}", "UseridMatchListAPIService.UpdateUseridMatchListByID", newBody[network_services.UseridMatchList]},
+	{http.MethodGet, "/sse/config/v1/vlan-interfaces", "VLANInterfacesAPIService.ListVLANInterfaces", nil},
+	{http.MethodPost, "/sse/config/v1/vlan-interfaces", "VLANInterfacesAPIService.CreateVLANInterfaces", newBody[network_services.VlanInterfaces]},
+	{http.MethodDelete, "/sse/config/v1/vlan-interfaces/{id}", "VLANInterfacesAPIService.DeleteVLANInterfacesByID", nil},
+	{http.MethodGet, "/sse/config/v1/vlan-interfaces/{id}", "VLANInterfacesAPIService.GetVLANInterfacesByID", nil},
+	{http.MethodPut, "/sse/config/v1/vlan-interfaces/{id}", "VLANInterfacesAPIService.UpdateVLANlInterfacesByID", newBody[network_services.VlanInterfaces]},
+	{http.MethodGet, "/sse/config/v1/vulnerability-protection-profiles", "VulnerabilityProtectionProfilesAPIService.ListVulnerabilityProtectionProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/vulnerability-protection-profiles", "VulnerabilityProtectionProfilesAPIService.CreateVulnerabilityProtectionProfiles", newBody[security_services.VulnerabilityProtectionProfiles]},
+	{http.MethodDelete, "/sse/config/v1/vulnerability-protection-profiles/{id}", "VulnerabilityProtectionProfilesAPIService.DeleteVulnerabilityProtectionProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/vulnerability-protection-profiles/{id}", "VulnerabilityProtectionProfilesAPIService.GetVulnerabilityProtectionProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/vulnerability-protection-profiles/{id}", "VulnerabilityProtectionProfilesAPIService.UpdateVulnerabilityProtectionProfilesByID", newBody[security_services.VulnerabilityProtectionProfiles]},
+	{http.MethodGet, "/sse/config/v1/vulnerability-protection-signatures", "VulnerabilityProtectionSignaturesAPIService.ListVulnerabilityProtectionSignatures", nil},
+	{http.MethodPost, "/sse/config/v1/vulnerability-protection-signatures", "VulnerabilityProtectionSignaturesAPIService.CreateVulnerabilityProtectionSignatures", newBody[security_services.VulnerabilityProtectionSignatures]},
+	{http.MethodDelete, "/sse/config/v1/vulnerability-protection-signatures/{id}", "VulnerabilityProtectionSignaturesAPIService.DeleteVulnerabilityProtectionSignaturesByID", nil},
+	{http.MethodGet, "/sse/config/v1/vulnerability-protection-signatures/{id}", "VulnerabilityProtectionSignaturesAPIService.GetVulnerabilityProtectionSignaturesByID", nil},
+	{http.MethodPut, "/sse/config/v1/vulnerability-protection-signatures/{id}", "VulnerabilityProtectionSignaturesAPIService.UpdateVulnerabilityProtectionSignaturesByID", newBody[security_services.VulnerabilityProtectionSignatures]},
+	{http.MethodGet, "/sse/config/v1/wildfire-anti-virus-profiles", "WildFireAntiVirusProfilesAPIService.ListWildFireAntiVirusProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/wildfire-anti-virus-profiles", "WildFireAntiVirusProfilesAPIService.CreateWildFireAntiVirusProfiles", newBody[security_services.WildfireAntiVirusProfiles]},
+	{http.MethodDelete, "/sse/config/v1/wildfire-anti-virus-profiles/{id}", "WildFireAntiVirusProfilesAPIService.DeleteWildFireAntiVirusProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/wildfire-anti-virus-profiles/{id}", "WildFireAntiVirusProfilesAPIService.GetWildFireAntiVirusProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/wildfire-anti-virus-profiles/{id}", "WildFireAntiVirusProfilesAPIService.UpdateWildFireAntiVirusProfilesByID", newBody[security_services.WildfireAntiVirusProfiles]},
+	{http.MethodGet, "/sse/config/v1/zone-protection-profiles", "ZoneProtectionProfilesAPIService.ListZoneProtectionProfiles", nil},
+	{http.MethodPost, "/sse/config/v1/zone-protection-profiles", "ZoneProtectionProfilesAPIService.CreateZoneProtectionProfiles", newBody[network_services.ZoneProtectionProfiles]},
+	{http.MethodDelete, "/sse/config/v1/zone-protection-profiles/{id}", "ZoneProtectionProfilesAPIService.DeleteZoneProtectionProfilesByID", nil},
+	{http.MethodGet, "/sse/config/v1/zone-protection-profiles/{id}", "ZoneProtectionProfilesAPIService.GetZoneProtectionProfilesByID", nil},
+	{http.MethodPut, "/sse/config/v1/zone-protection-profiles/{id}", "ZoneProtectionProfilesAPIService.UpdateZoneProtectionProfilesByID", newBody[network_services.ZoneProtectionProfiles]},
+	{http.MethodGet, "/sse/config/v1/zones", "SecurityZonesAPIService.ListZones", nil},
+	{http.MethodPost, "/sse/config/v1/zones", "SecurityZonesAPIService.CreateZones", newBody[network_services.Zones]},
+	{http.MethodDelete, "/sse/config/v1/zones/{id}", "SecurityZonesAPIService.DeleteZonesByID", nil},
+	{http.MethodGet, "/sse/config/v1/zones/{id}", "SecurityZonesAPIService.GetZonesByID", nil},
+	{http.MethodPut, "/sse/config/v1/zones/{id}", "SecurityZonesAPIService.UpdateZonesByID", newBody[network_services.Zones]},
+}
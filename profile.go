@@ -0,0 +1,110 @@
+package scm
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultProfile is the name of the settings at the top level of the JSON
+// config file.
+const DefaultProfile = "default"
+
+// secretKeys are the keys giving the client secret, of which a profile
+// inherits none if it sets one.
+var secretKeys = []string{"client_secret", "client_secret_file", "client_secret_command"}
+
+// loadProfile returns the settings of the named profile of the JSON config
+// file content b, and the name of the profile.  If name is empty, it is the
+// profile named by the "profile" key of the file, or the default one.
+func loadProfile(b []byte, name string) (*Client, string, error) {
+	var file struct {
+		Profile  string                                `json:"profile"`
+		Profiles map[string]map[string]json.RawMessage `json:"profiles"`
+	}
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(b, &file); err != nil {
+		return nil, "", err
+	}
+	if err := json.Unmarshal(b, &top); err != nil {
+		return nil, "", err
+	}
+	delete(top, "profile")
+	delete(top, "profiles")
+
+	if name == "" {
+		name = file.Profile
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	settings, err := resolveProfile(top, file.Profiles, name, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	merged, err := json.Marshal(settings)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ans := &Client{}
+	if err = json.Unmarshal(merged, ans); err != nil {
+		return nil, "", fmt.Errorf("profile %q: %w", name, err)
+	}
+	return ans, name, nil
+}
+
+// resolveProfile returns the settings of the named profile merged with the
+// ones it inherits, path being the profiles inheriting from it.
+func resolveProfile(top map[string]json.RawMessage, profiles map[string]map[string]json.RawMessage, name string, path []string) (map[string]json.RawMessage, error) {
+	if slices.Contains(path, name) {
+		return nil, fmt.Errorf("profile inheritance cycle: %s -> %s", strings.Join(path, " -> "), name)
+	}
+
+	p, ok := profiles[name]
+	if !ok {
+		if name == DefaultProfile {
+			return top, nil
+		}
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+
+	parent := DefaultProfile
+	if raw, ok := p["inherits"]; ok {
+		if err := json.Unmarshal(raw, &parent); err != nil {
+			return nil, fmt.Errorf("profile %q: inherits: %w", name, err)
+		}
+	}
+	var ans map[string]json.RawMessage
+	if name == DefaultProfile && parent == DefaultProfile {
+		// A "default" entry in profiles overrides the top level.
+		ans = top
+	} else {
+		var err error
+		if ans, err = resolveProfile(top, profiles, parent, append(path, name)); err != nil {
+			return nil, err
+		}
+	}
+
+	merged := make(map[string]json.RawMessage, len(ans)+len(p))
+	for k, v := range ans {
+		merged[k] = v
+	}
+	for _, k := range secretKeys {
+		if _, ok := p[k]; ok {
+			// The client secret is overridden as a whole.
+			for _, k := range secretKeys {
+				delete(merged, k)
+			}
+			break
+		}
+	}
+	for k, v := range p {
+		if k != "inherits" {
+			merged[k] = v
+		}
+	}
+	return merged, nil
+}
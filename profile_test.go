@@ -0,0 +1,102 @@
+package scm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const profilesConfig = `{
+	"client_id": "client-id",
+	"client_secret": "client-secret",
+	"scope": "tsg_id:1111111111",
+	"headers": {"X-Team": "netops"},
+	"profiles": {
+		"prod": {"scope": "tsg_id:2222222222", "logging": "basic"},
+		"prod-eu": {"inherits": "prod", "host": "api.eu.example.com"},
+		"loop-a": {"inherits": "loop-b"},
+		"loop-b": {"inherits": "loop-a"}
+	}
+}`
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "scm-config.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestSetup_Profiles(t *testing.T) {
+	authFile := writeConfig(t, profilesConfig)
+
+	c := &Client{AuthFile: authFile, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, DefaultProfile, c.Profile)
+	assert.Equal(t, "tsg_id:1111111111", c.Scope)
+	assert.Equal(t, "api.strata.paloaltonetworks.com", c.Host)
+
+	c = &Client{AuthFile: authFile, Profile: "prod-eu", SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "prod-eu", c.Profile)
+	assert.Equal(t, "client-id", c.ClientId)
+	assert.Equal(t, "tsg_id:2222222222", c.Scope)
+	assert.Equal(t, "basic", c.Logging)
+	assert.Equal(t, "api.eu.example.com", c.Host)
+	assert.Equal(t, map[string]string{"X-Team": "netops"}, c.Headers)
+
+	// Explicit settings still come first.
+	c = &Client{AuthFile: authFile, Profile: "prod", Scope: "tsg_id:3333333333", SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "tsg_id:3333333333", c.Scope)
+}
+
+func TestSetup_ProfileSelection(t *testing.T) {
+	authFile := writeConfig(t, `{
+		"client_id": "client-id",
+		"client_secret": "client-secret",
+		"scope": "tsg_id:1111111111",
+		"profile": "prod",
+		"profiles": {
+			"prod": {"scope": "tsg_id:2222222222"},
+			"staging": {"scope": "tsg_id:3333333333"},
+			"default": {"host": "api.default.example.com"}
+		}
+	}`)
+
+	c := &Client{AuthFile: authFile, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "prod", c.Profile)
+	assert.Equal(t, "tsg_id:2222222222", c.Scope)
+
+	t.Setenv("SCM_PROFILE", "staging")
+	c = &Client{AuthFile: authFile, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "prod", c.Profile, "the environment is only checked with CheckEnvironment")
+
+	c = &Client{AuthFile: authFile, CheckEnvironment: true, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "staging", c.Profile)
+	assert.Equal(t, "tsg_id:3333333333", c.Scope)
+
+	c = &Client{AuthFile: authFile, Profile: DefaultProfile, CheckEnvironment: true, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "tsg_id:1111111111", c.Scope)
+	assert.Equal(t, "api.default.example.com", c.Host)
+}
+
+func TestSetup_ProfileErrors(t *testing.T) {
+	authFile := writeConfig(t, profilesConfig)
+
+	c := &Client{AuthFile: authFile, Profile: "dev"}
+	assert.EqualError(t, c.Setup(), `profile "dev" not found`)
+
+	c = &Client{AuthFile: authFile, Profile: "loop-a"}
+	assert.EqualError(t, c.Setup(), "profile inheritance cycle: loop-a -> loop-b -> loop-a")
+
+	c = &Client{ClientId: "client-id", ClientSecret: "client-secret", Scope: "tsg_id:1111111111", Profile: "prod"}
+	assert.EqualError(t, c.Setup(), `Profile "prod" requires an AuthFile`)
+}
@@ -0,0 +1,255 @@
+package scm
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitRule is a token bucket: requests are let through at
+// RequestsPerSecond on average, with bursts of up to Burst requests.
+//
+// A zero RequestsPerSecond means no limit.
+type RateLimitRule struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	Burst             int     `json:"burst,omitempty"`
+}
+
+/*
+RateLimit configures the client-side rate limiting of API requests.
+
+Requests are sorted into families by the API they target (the part after
+"/config/" in the URL path, such as "objects" or "security") and whether they
+read ("read": GET, HEAD, OPTIONS) or write ("write": any other method).  The
+rule applied to a request is the first one found in Families for, in order:
+
+1. "<api>:<read|write>", such as "objects:write"
+2. "<api>", such as "objects"
+3. "read" or "write"
+
+Otherwise Default applies.  Each of these keys gets its own token bucket, so
+for example all writes share the "write" bucket.
+
+Each client has buckets of its own, unless given the RateLimiter of another
+client to share its buckets:
+
+	other := &scm.Client{AuthFile: "scm-config.json", RateLimiter: client.RateLimiter}
+
+	{
+	  "rate_limit": {
+	    "default": {"requests_per_second": 10, "burst": 20},
+	    "families": {
+	      "write": {"requests_per_second": 2, "burst": 5}
+	    }
+	  }
+	}
+*/
+type RateLimit struct {
+	Default  RateLimitRule            `json:"default"`
+	Families map[string]RateLimitRule `json:"families,omitempty"`
+}
+
+// RateLimitStats are the statistics of one rate limiter bucket.
+type RateLimitStats struct {
+	// Requests is the number of requests that went through the bucket.
+	Requests int64
+
+	// Delayed is the number of requests that had to wait.
+	Delayed int64
+
+	// WaitTime is the total time requests spent waiting.
+	WaitTime time.Duration
+}
+
+// defaultFamily is the bucket name used for requests matching no family.
+const defaultFamily = "default"
+
+// RateLimiter throttles requests according to a RateLimit.
+type RateLimiter struct {
+	config RateLimit
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewRateLimiter returns a RateLimiter enforcing config.
+func NewRateLimiter(config RateLimit) *RateLimiter {
+	return &RateLimiter{
+		config:  config,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Wait blocks until req may be sent, or until ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, req *http.Request) error {
+	b := l.bucket(requestFamily(req.Method, req.URL.Path))
+	return b.wait(ctx)
+}
+
+// Stats returns the statistics of each bucket used so far, by family.
+func (l *RateLimiter) Stats() map[string]RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ans := make(map[string]RateLimitStats, len(l.buckets))
+	for name, b := range l.buckets {
+		ans[name] = b.snapshot()
+	}
+	return ans
+}
+
+// bucket returns the token bucket for a request of the given API and class.
+func (l *RateLimiter) bucket(api, class string) *tokenBucket {
+	name, rule := defaultFamily, l.config.Default
+	for _, key := range []string{api + ":" + class, api, class} {
+		if r, ok := l.config.Families[key]; ok {
+			name, rule = key, r
+			break
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[name]
+	if !ok {
+		b = newTokenBucket(rule)
+		l.buckets[name] = b
+	}
+	return b
+}
+
+// requestFamily returns the API and the class ("read" or "write") of a request.
+func requestFamily(method, path string) (string, string) {
+	class := "write"
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		class = "read"
+	}
+
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) > 1 && parts[0] == "config" {
+		return parts[1], class
+	}
+	return parts[0], class
+}
+
+// tokenBucket is the implementation of a RateLimitRule.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	stats  RateLimitStats
+}
+
+// newTokenBucket returns a full token bucket for rule.
+func newTokenBucket(rule RateLimitRule) *tokenBucket {
+	burst := float64(rule.Burst)
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(rule.RequestsPerSecond))
+	}
+
+	return &tokenBucket{
+		rate:   rule.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// wait takes a token from the bucket, waiting for one if needed.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	d := b.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	deadline := time.Now().Add(d)
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel(time.Until(deadline))
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns how long to wait before it is usable.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.stats.Requests++
+	if b.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	d := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.stats.Delayed++
+	b.stats.WaitTime += d
+	return d
+}
+
+// cancel gives back a token reserved by a request that stopped waiting d
+// before its turn.
+func (b *tokenBucket) cancel(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+	b.stats.WaitTime -= d
+}
+
+// snapshot returns the bucket statistics.
+func (b *tokenBucket) snapshot() RateLimitStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.stats
+}
+
+// RateLimitTransport is a RoundTripper waiting for the RateLimiter before
+// sending each request.
+type RateLimitTransport struct {
+	Wrapped http.RoundTripper
+	Limiter *RateLimiter
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Wrapped == nil {
+		t.Wrapped = http.DefaultTransport
+	}
+
+	if err := t.Limiter.Wait(req.Context(), req); err != nil {
+		return nil, err
+	}
+	return t.Wrapped.RoundTrip(req)
+}
+
+// RateLimitStats returns the statistics of the client's rate limiter by
+// family, or nil if it has none.
+//
+// The statistics include the requests of the other clients sharing the
+// RateLimiter.
+func (c *Client) RateLimitStats() map[string]RateLimitStats {
+	if c.RateLimiter == nil {
+		return nil
+	}
+	return c.RateLimiter.Stats()
+}
@@ -0,0 +1,142 @@
+package scm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestFamily(t *testing.T) {
+	api, class := requestFamily(http.MethodGet, "/config/objects/v1/addresses")
+	assert.Equal(t, "objects", api)
+	assert.Equal(t, "read", class)
+
+	api, class = requestFamily(http.MethodPost, "/sse/config/v1/remote-networks")
+	assert.Equal(t, "sse", api)
+	assert.Equal(t, "write", class)
+}
+
+func TestRateLimiter_Families(t *testing.T) {
+	l := NewRateLimiter(RateLimit{
+		Families: map[string]RateLimitRule{
+			"write":          {RequestsPerSecond: 1},
+			"objects:write":  {RequestsPerSecond: 2},
+			"security":       {RequestsPerSecond: 3},
+			"security:write": {RequestsPerSecond: 4},
+		},
+	})
+
+	for _, tc := range []struct {
+		method, path, family string
+	}{
+		{http.MethodGet, "/config/objects/v1/addresses", defaultFamily},
+		{http.MethodPost, "/config/objects/v1/addresses", "objects:write"},
+		{http.MethodGet, "/config/security/v1/security-rules", "security"},
+		{http.MethodPut, "/config/security/v1/security-rules", "security:write"},
+		{http.MethodDelete, "/config/network/v1/zones", "write"},
+	} {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		require.NoError(t, l.Wait(context.Background(), req))
+		assert.Contains(t, l.Stats(), tc.family, "%s %s", tc.method, tc.path)
+	}
+}
+
+func TestRateLimiter_Throttles(t *testing.T) {
+	l := NewRateLimiter(RateLimit{Default: RateLimitRule{RequestsPerSecond: 20, Burst: 2}})
+	req := httptest.NewRequest(http.MethodGet, "/config/objects/v1/addresses", nil)
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		require.NoError(t, l.Wait(context.Background(), req))
+	}
+	elapsed := time.Since(start)
+
+	// Two requests go through at once, the four others 50ms apart.
+	assert.GreaterOrEqual(t, elapsed, 190*time.Millisecond)
+	stats := l.Stats()[defaultFamily]
+	assert.Equal(t, int64(6), stats.Requests)
+	assert.Equal(t, int64(4), stats.Delayed)
+	assert.GreaterOrEqual(t, stats.WaitTime, 190*time.Millisecond)
+}
+
+func TestRateLimiter_ContextCanceled(t *testing.T) {
+	l := NewRateLimiter(RateLimit{Default: RateLimitRule{RequestsPerSecond: 0.1, Burst: 1}})
+	req := httptest.NewRequest(http.MethodGet, "/config/objects/v1/addresses", nil)
+	require.NoError(t, l.Wait(context.Background(), req))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx, req), context.DeadlineExceeded)
+}
+
+func TestRateLimit_FromConfigFile(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[],"limit":200,"offset":0,"total":0}`))
+	}))
+	defer server.Close()
+
+	authFile := filepath.Join(t.TempDir(), "scm-config.json")
+	require.NoError(t, os.WriteFile(authFile, []byte(`{
+		"client_id": "client-id",
+		"client_secret": "client-secret",
+		"scope": "tsg_id:1234567890",
+		"rate_limit": {"families": {"objects:read": {"requests_per_second": 1000, "burst": 1000}}}
+	}`), 0600))
+
+	c := &Client{
+		AuthFile:             authFile,
+		Host:                 strings.TrimPrefix(server.URL, "http://"),
+		Protocol:             "http",
+		SkipLoggingTransport: true,
+		Jwt:                  "test-jwt",
+		JwtExpiresAt:         time.Now().Add(time.Hour),
+	}
+	require.NoError(t, c.Setup())
+	require.NotNil(t, c.RateLimit)
+
+	_, err := c.Do(context.Background(), http.MethodGet, "/config/objects/v1/addresses", nil, nil, nil)
+	require.NoError(t, err)
+	_, _, err = GetObjectsAPIClient(c).AddressesAPI.ListAddresses(context.Background()).Execute()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, int64(2), c.RateLimitStats()["objects:read"].Requests)
+
+	// Another client of the same tenant has its own limiter, unless given
+	// this one.
+	other := &Client{AuthFile: authFile}
+	require.NoError(t, other.Setup())
+	assert.NotSame(t, c.RateLimiter, other.RateLimiter)
+	assert.Empty(t, other.RateLimitStats())
+
+	shared := &Client{AuthFile: authFile, RateLimiter: c.RateLimiter}
+	require.NoError(t, shared.Setup())
+	assert.Same(t, c.RateLimiter, shared.RateLimiter)
+	assert.Equal(t, int64(2), shared.RateLimitStats()["objects:read"].Requests)
+}
+
+func TestRateLimit_FromEnvironment(t *testing.T) {
+	t.Setenv("SCM_RATE_LIMIT", `{"default": {"requests_per_second": 5}}`)
+
+	c := &Client{
+		ClientId:         "client-id",
+		ClientSecret:     "client-secret",
+		Scope:            "tsg_id:2222222222",
+		CheckEnvironment: true,
+	}
+	require.NoError(t, c.Setup())
+	require.NotNil(t, c.RateLimit)
+	assert.Equal(t, 5.0, c.RateLimit.Default.RequestsPerSecond)
+	assert.Equal(t, "2222222222", c.tenant())
+}
@@ -0,0 +1,74 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	"github.com/paloaltonetworks/scm-go/resource"
+)
+
+// ApplyOptions are the options of Apply.
+type ApplyOptions struct {
+	// DryRun returns the steps that would be applied without applying them.
+	DryRun bool
+
+	// Progress, if set, is called with each step before it is applied.
+	Progress func(Step)
+}
+
+// Apply applies the changes of plan in order, and returns the steps
+// applied.  It stops at the first step failing, returning a *StepError.
+//
+// The plan should be applied soon after being computed: steps are not
+// recomputed, so changes made to the scope in between may make them fail.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan, opts ApplyOptions) ([]Step, error) {
+	var applied []Step
+	for _, s := range plan.Changes() {
+		if opts.Progress != nil {
+			opts.Progress(s)
+		}
+		if !opts.DryRun {
+			if err := r.apply(ctx, plan.Scope, s); err != nil {
+				return applied, &StepError{Step: s, Err: err}
+			}
+		}
+		applied = append(applied, s)
+	}
+	return applied, nil
+}
+
+// apply applies step s to scope.
+func (r *Reconciler) apply(ctx context.Context, scope api.Scope, s Step) error {
+	client := r.sdk.Client()
+
+	var id string
+	if s.Action != Create {
+		if id, _ = s.Current["id"].(string); id == "" {
+			return fmt.Errorf("current object has no id")
+		}
+	}
+
+	var err error
+	switch s.Action {
+	case Create:
+		_, err = resource.Create(ctx, client, scope, s.Kind, s.Desired)
+	case Update:
+		_, err = resource.Update(ctx, client, scope, s.Kind, id, s.Desired)
+	case Delete:
+		err = resource.Delete(ctx, client, s.Kind, id)
+	}
+	return err
+}
+
+// StepError is returned by Apply when a step fails.
+type StepError struct {
+	Step Step
+	Err  error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Step, e.Err)
+}
+
+func (e *StepError) Unwrap() error { return e.Err }
@@ -0,0 +1,325 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	"github.com/paloaltonetworks/scm-go/resource"
+)
+
+// Action is what a Step does to an object.
+type Action string
+
+// Actions of a step.
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+	NoOp   Action = "no-op"
+)
+
+// FieldDiff is a field whose current value differs from the desired one.
+// Current is nil if the field is not set on the current object.
+type FieldDiff struct {
+	Field   string
+	Current interface{}
+	Desired interface{}
+}
+
+// Step is the action to take on an object.
+type Step struct {
+	Action Action
+	Kind   *resource.Kind
+	Name   string
+
+	// Diff holds the fields to change, for updates.
+	Diff []FieldDiff
+
+	// Current is the object in the scope, nil for creations, and Desired
+	// the desired object, nil for deletions.
+	Current map[string]interface{}
+	Desired map[string]interface{}
+}
+
+func (s Step) String() string {
+	if s.Action != Update {
+		return fmt.Sprintf("%s %s %q", s.Action, s.Kind, s.Name)
+	}
+
+	fields := make([]string, 0, len(s.Diff))
+	for _, d := range s.Diff {
+		fields = append(fields, d.Field)
+	}
+	return fmt.Sprintf("%s %s %q (%s)", s.Action, s.Kind, s.Name, strings.Join(fields, ", "))
+}
+
+// Plan is the list of steps bringing a scope to the desired state.
+type Plan struct {
+	Scope api.Scope
+
+	// Steps are ordered so that objects are created or updated after the
+	// objects they reference, and deleted before them.  Creations and
+	// updates come before deletions, so references to deleted objects are
+	// removed first.
+	Steps []Step
+}
+
+// Changes returns the steps that are not no-ops.
+func (p *Plan) Changes() []Step {
+	var ans []Step
+	for _, s := range p.Steps {
+		if s.Action != NoOp {
+			ans = append(ans, s)
+		}
+	}
+	return ans
+}
+
+// String returns the changes of the plan, one per line.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, s := range p.Changes() {
+		b.WriteString(s.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// PlanOptions are the options of a plan.
+type PlanOptions struct {
+	// Kinds are the kinds managed by the plan: the objects of these kinds
+	// in the scope that are not desired are deleted.  It defaults to the
+	// kinds of the desired objects.
+	Kinds []*resource.Kind
+
+	// IgnoreFields are fields not compared, besides the id and container
+	// fields.
+	IgnoreFields []string
+}
+
+// ignoredFields are the fields never compared: they are set by the server or
+// given by the scope.
+var ignoredFields = []string{"id", "folder", "snippet", "device"}
+
+// Plan computes the steps bringing the objects of scope to the desired state.
+//
+// Only the fields of the desired objects are compared, so fields defaulted
+// by the server do not cause updates.  Updates replace the whole object
+// though, so a field set on the current object and absent from the desired
+// one is cleared by any update of it.
+func (r *Reconciler) Plan(ctx context.Context, scope api.Scope, desired []Object, opts PlanOptions) (*Plan, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+
+	ignored := append(slices.Clone(ignoredFields), opts.IgnoreFields...)
+
+	kinds := slices.Clone(opts.Kinds)
+	want := make(map[key]map[string]interface{}, len(desired))
+	for _, o := range desired {
+		if o.Kind == nil {
+			return nil, fmt.Errorf("object %q has no kind", o.Name())
+		}
+		if o.Name() == "" {
+			return nil, fmt.Errorf("%s: object has no name", o.Kind)
+		}
+		k := key{o.Kind.Name, o.Name()}
+		if _, ok := want[k]; ok {
+			return nil, fmt.Errorf("%s %q: duplicate object", o.Kind, o.Name())
+		}
+		data, err := toMap(o.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", o.Kind, o.Name(), err)
+		}
+		if err := checkScope(scope, data); err != nil {
+			return nil, fmt.Errorf("%s %q: %w", o.Kind, o.Name(), err)
+		}
+		want[k] = data
+		if !slices.Contains(kinds, o.Kind) {
+			kinds = append(kinds, o.Kind)
+		}
+	}
+
+	var steps []Step
+	for _, kind := range kinds {
+		current := make(map[string]map[string]interface{})
+		for obj, err := range resource.List(ctx, r.sdk.Client(), scope, kind) {
+			if err != nil {
+				return nil, fmt.Errorf("listing %s: %w", kind, err)
+			}
+			if name, _ := obj["name"].(string); name != "" && scope.Contains(obj) {
+				current[name] = obj
+			}
+		}
+
+		for k, d := range want {
+			if k.kind != kind.Name {
+				continue
+			}
+			s := Step{Action: Create, Kind: kind, Name: k.name, Desired: d}
+			if cur, ok := current[k.name]; ok {
+				s.Current = cur
+				s.Diff = diff(cur, d, ignored)
+				s.Action = Update
+				if len(s.Diff) == 0 {
+					s.Action = NoOp
+				}
+			}
+			steps = append(steps, s)
+		}
+		for name, cur := range current {
+			if _, ok := want[key{kind.Name, name}]; !ok {
+				steps = append(steps, Step{Action: Delete, Kind: kind, Name: name, Current: cur})
+			}
+		}
+	}
+
+	ordered, err := order(steps)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{Scope: scope, Steps: ordered}, nil
+}
+
+// checkScope returns an error if obj is in another container than scope.
+func checkScope(scope api.Scope, obj map[string]interface{}) error {
+	param, value := scope.Param()
+	for _, p := range []string{"folder", "snippet", "device"} {
+		v, _ := obj[p].(string)
+		if v != "" && (p != param || v != value) {
+			return fmt.Errorf("object is in %s %s, not in %s", p, v, scope)
+		}
+	}
+	return nil
+}
+
+// diff returns the fields of desired whose value differs in current, sorted
+// by name.
+func diff(current, desired map[string]interface{}, ignored []string) []FieldDiff {
+	var ans []FieldDiff
+	for field, want := range desired {
+		if slices.Contains(ignored, field) {
+			continue
+		}
+		if have := current[field]; !reflect.DeepEqual(have, want) {
+			ans = append(ans, FieldDiff{Field: field, Current: have, Desired: want})
+		}
+	}
+	slices.SortFunc(ans, func(a, b FieldDiff) int { return strings.Compare(a.Field, b.Field) })
+	return ans
+}
+
+// key identifies an object by kind and name.
+type key struct {
+	kind, name string
+}
+
+// order sorts steps so that objects are created or updated after the ones
+// they reference, then deleted before the ones they reference.  Objects not
+// depending on each other are sorted by kind, as ordered by resource.All,
+// then by name.
+func order(steps []Step) ([]Step, error) {
+	rank := make(map[string]int)
+	for i, k := range resource.All() {
+		rank[k.Name] = i
+	}
+	slices.SortFunc(steps, func(a, b Step) int {
+		ra, oka := rank[a.Kind.Name]
+		rb, okb := rank[b.Kind.Name]
+		switch {
+		case oka != okb:
+			if oka {
+				return -1
+			}
+			return 1
+		case ra != rb:
+			return ra - rb
+		case a.Kind.Name != b.Kind.Name:
+			return strings.Compare(a.Kind.Name, b.Kind.Name)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var upserts, deletes []Step
+	for _, s := range steps {
+		if s.Action == Delete {
+			deletes = append(deletes, s)
+		} else {
+			upserts = append(upserts, s)
+		}
+	}
+
+	ans, err := topoSort(upserts, func(s Step) map[string]interface{} { return s.Desired })
+	if err != nil {
+		return nil, err
+	}
+	deletes, err = topoSort(deletes, func(s Step) map[string]interface{} { return s.Current })
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(deletes)
+	return append(ans, deletes...), nil
+}
+
+// topoSort returns steps sorted so that each step comes after the steps of
+// the objects it references, as given by obj.  The order of steps is kept
+// otherwise.
+func topoSort(steps []Step, obj func(Step) map[string]interface{}) ([]Step, error) {
+	index := make(map[key]int, len(steps))
+	for i, s := range steps {
+		index[key{s.Kind.Name, s.Name}] = i
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(steps))
+	ans := make([]Step, 0, len(steps))
+	var path []string
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		s := steps[i]
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("reference cycle: %s -> %s %q", strings.Join(path, " -> "), s.Kind, s.Name)
+		}
+		state[i] = visiting
+		path = append(path, fmt.Sprintf("%s %q", s.Kind, s.Name))
+
+		for _, ref := range s.Kind.References(obj(s)) {
+			for _, kind := range ref.Kinds {
+				j, ok := index[key{kind, ref.Name}]
+				if !ok {
+					continue
+				}
+				if j != i {
+					if err := visit(j); err != nil {
+						return err
+					}
+				}
+				break
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[i] = visited
+		ans = append(ans, s)
+		return nil
+	}
+
+	for i := range steps {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return ans, nil
+}
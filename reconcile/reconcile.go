@@ -0,0 +1,90 @@
+/*
+Package reconcile brings the objects of a folder, snippet or device to a
+desired state: it compares the desired objects to the ones in the scope,
+computes the plan of creations, updates and deletions needed, and applies it
+in an order that respects the references between objects.
+
+	r := reconcile.New(scm.NewSDK(client))
+
+	web, err := reconcile.NewObject(resource.Addresses, objects.Addresses{
+		Name:      "web",
+		IpNetmask: objects.PtrString("10.0.0.1/32"),
+	})
+	if err != nil {
+		return err
+	}
+	plan, err := r.Plan(ctx, api.Folder("Texas"), []reconcile.Object{web}, reconcile.PlanOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Print(plan)
+
+	if _, err := r.Apply(ctx, plan, reconcile.ApplyOptions{}); err != nil {
+		return err
+	}
+
+Objects are handled in their JSON form, so any object type described by a
+resource.Kind can be reconciled, whether built from a generated model or
+decoded from YAML or JSON files.
+*/
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/resource"
+)
+
+// Reconciler plans and applies changes to the objects of a scope.
+type Reconciler struct {
+	sdk *scm.SDK
+}
+
+// New returns a Reconciler using the client of sdk.
+func New(sdk *scm.SDK) *Reconciler {
+	return &Reconciler{sdk: sdk}
+}
+
+// Object is a desired object.
+type Object struct {
+	Kind *resource.Kind
+
+	// Data is the object in its JSON form.  It must have a name, and its
+	// id and container fields are ignored.
+	Data map[string]interface{}
+}
+
+// NewObject returns the object of the given kind whose JSON form is the one
+// of v, typically a generated model such as objects.Addresses.
+func NewObject(kind *resource.Kind, v interface{}) (Object, error) {
+	data, err := toMap(v)
+	if err != nil {
+		return Object{}, fmt.Errorf("%s: %w", kind, err)
+	}
+	return Object{Kind: kind, Data: data}, nil
+}
+
+// Name returns the name of the object.
+func (o Object) Name() string {
+	name, _ := o.Data["name"].(string)
+	return name
+}
+
+// toMap returns the JSON form of v, which also normalizes numbers and
+// nested values to the types returned by the API.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var ans map[string]interface{}
+	if err := json.Unmarshal(b, &ans); err != nil {
+		return nil, err
+	}
+	if ans == nil {
+		return nil, fmt.Errorf("object is not a JSON object")
+	}
+	return ans, nil
+}
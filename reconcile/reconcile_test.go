@@ -0,0 +1,199 @@
+package reconcile_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/api"
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/reconcile"
+	"github.com/paloaltonetworks/scm-go/resource"
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+var texas = api.Folder("Texas")
+
+func newClient(t *testing.T, srv *scmtest.Server) *scm.Client {
+	t.Helper()
+
+	c := &scm.Client{AuthFile: srv.ConfigFile(t), SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	return c
+}
+
+func newReconciler(t *testing.T, srv *scmtest.Server) *reconcile.Reconciler {
+	t.Helper()
+	return reconcile.New(scm.NewSDK(newClient(t, srv)))
+}
+
+// newServer returns a server with objects in folder Texas, and an address
+// inherited from folder Shared.
+func newServer(t *testing.T) *scmtest.Server {
+	t.Helper()
+
+	srv := scmtest.NewServer()
+	t.Cleanup(srv.Close)
+
+	srv.Add(resource.Tags.Path, map[string]interface{}{"name": "prod", "folder": "Texas", "color": "Red"})
+	srv.Add(resource.Addresses.Path, map[string]interface{}{"name": "web", "folder": "Texas", "ip_netmask": "10.0.0.1/32", "tag": []interface{}{"prod"}})
+	srv.Add(resource.Addresses.Path, map[string]interface{}{"name": "old", "folder": "Texas", "fqdn": "old.example.com"})
+	srv.Add(resource.Addresses.Path, map[string]interface{}{"name": "dns", "folder": "Shared", "ip_netmask": "8.8.8.8/32"})
+	srv.Add(resource.AddressGroups.Path, map[string]interface{}{"name": "servers", "folder": "Texas", "static": []interface{}{"web", "old"}})
+	return srv
+}
+
+func desired(t *testing.T) []reconcile.Object {
+	t.Helper()
+
+	web, err := reconcile.NewObject(resource.Addresses, objects.Addresses{
+		Name:      "web",
+		IpNetmask: objects.PtrString("10.0.0.2/32"),
+		Tag:       []string{"prod"},
+	})
+	require.NoError(t, err)
+
+	return []reconcile.Object{
+		{Kind: resource.SecurityRules, Data: map[string]interface{}{
+			"name": "allow-web", "action": "allow", "source": []string{"all"}, "destination": []string{"any"},
+		}},
+		{Kind: resource.AddressGroups, Data: map[string]interface{}{"name": "all", "static": []string{"servers", "dns"}}},
+		{Kind: resource.AddressGroups, Data: map[string]interface{}{"name": "servers", "static": []string{"web", "db"}}},
+		web,
+		{Kind: resource.Addresses, Data: map[string]interface{}{"name": "db", "ip_netmask": "10.0.0.3/32"}},
+		{Kind: resource.Tags, Data: map[string]interface{}{"name": "prod", "color": "Red", "folder": "Texas"}},
+	}
+}
+
+func changes(p *reconcile.Plan) []string {
+	var ans []string
+	for _, s := range p.Changes() {
+		ans = append(ans, s.String())
+	}
+	return ans
+}
+
+func TestPlan(t *testing.T) {
+	srv := newServer(t)
+	r := newReconciler(t, srv)
+
+	p, err := r.Plan(context.Background(), texas, desired(t), reconcile.PlanOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`create addresses "db"`,
+		`update addresses "web" (ip_netmask)`,
+		`update address-groups "servers" (static)`,
+		`create address-groups "all"`,
+		`create security-rules "allow-web"`,
+		`delete addresses "old"`,
+	}, changes(p))
+
+	require.Len(t, p.Steps, 7)
+	assert.Equal(t, reconcile.NoOp, p.Steps[0].Action)
+	assert.Equal(t, "prod", p.Steps[0].Name)
+
+	web := p.Steps[2]
+	assert.Equal(t, []reconcile.FieldDiff{{Field: "ip_netmask", Current: "10.0.0.1/32", Desired: "10.0.0.2/32"}}, web.Diff)
+}
+
+func TestApply(t *testing.T) {
+	srv := newServer(t)
+	r := newReconciler(t, srv)
+	ctx := context.Background()
+
+	p, err := r.Plan(ctx, texas, desired(t), reconcile.PlanOptions{})
+	require.NoError(t, err)
+
+	var progress []string
+	applied, err := r.Apply(ctx, p, reconcile.ApplyOptions{
+		Progress: func(s reconcile.Step) { progress = append(progress, s.Name) },
+	})
+	require.NoError(t, err)
+	assert.Len(t, applied, 6)
+	assert.Equal(t, []string{"db", "web", "servers", "all", "allow-web", "old"}, progress)
+
+	var names []string
+	for _, obj := range srv.Objects(resource.Addresses.Path) {
+		names = append(names, obj["name"].(string))
+	}
+	assert.ElementsMatch(t, []string{"web", "dns", "db"}, names)
+	rules := srv.Objects(resource.SecurityRules.Path)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "Texas", rules[0]["folder"])
+
+	p, err = r.Plan(ctx, texas, desired(t), reconcile.PlanOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, p.Changes())
+}
+
+func TestApply_DryRun(t *testing.T) {
+	srv := newServer(t)
+	r := newReconciler(t, srv)
+	ctx := context.Background()
+
+	p, err := r.Plan(ctx, texas, desired(t), reconcile.PlanOptions{})
+	require.NoError(t, err)
+
+	applied, err := r.Apply(ctx, p, reconcile.ApplyOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Len(t, applied, 6)
+	assert.Len(t, srv.Objects(resource.Addresses.Path), 3)
+	assert.Empty(t, srv.Objects(resource.SecurityRules.Path))
+}
+
+func TestApply_StepError(t *testing.T) {
+	srv := newServer(t)
+	r := newReconciler(t, srv)
+	ctx := context.Background()
+
+	p, err := r.Plan(ctx, texas, desired(t)[3:4], reconcile.PlanOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`update addresses "web" (ip_netmask)`, `delete addresses "old"`}, changes(p))
+
+	// The object is deleted, along with the group referencing it, after the
+	// plan is computed.
+	client := newClient(t, srv)
+	group := srv.Objects(resource.AddressGroups.Path)[0]["id"].(string)
+	require.NoError(t, resource.Delete(ctx, client, resource.AddressGroups, group))
+	id := p.Changes()[0].Current["id"].(string)
+	require.NoError(t, resource.Delete(ctx, client, resource.Addresses, id))
+
+	applied, err := r.Apply(ctx, p, reconcile.ApplyOptions{})
+	assert.Empty(t, applied)
+	var se *reconcile.StepError
+	require.True(t, errors.As(err, &se), "%v", err)
+	assert.Equal(t, "web", se.Step.Name)
+	assert.ErrorIs(t, err, api.ObjectNotFoundError)
+	assert.True(t, scmErrors.IsObjectNotPresent(err), "%v", err)
+}
+
+func TestPlan_Errors(t *testing.T) {
+	srv := newServer(t)
+	r := newReconciler(t, srv)
+	ctx := context.Background()
+
+	group := func(name string, static ...string) reconcile.Object {
+		return reconcile.Object{Kind: resource.AddressGroups, Data: map[string]interface{}{"name": name, "static": static}}
+	}
+
+	_, err := r.Plan(ctx, api.Scope{}, nil, reconcile.PlanOptions{})
+	assert.ErrorContains(t, err, "exactly one of folder, snippet or device")
+
+	_, err = r.Plan(ctx, texas, []reconcile.Object{group("a"), group("a")}, reconcile.PlanOptions{})
+	assert.ErrorContains(t, err, "duplicate object")
+
+	other := group("a")
+	other.Data["folder"] = "Shared"
+	_, err = r.Plan(ctx, texas, []reconcile.Object{other}, reconcile.PlanOptions{})
+	assert.ErrorContains(t, err, "not in folder Texas")
+
+	_, err = r.Plan(ctx, texas, []reconcile.Object{group("a", "b"), group("b", "c"), group("c", "a")}, reconcile.PlanOptions{})
+	assert.ErrorContains(t, err, `reference cycle: address-groups "a" -> address-groups "b" -> address-groups "c" -> address-groups "a"`)
+}
@@ -0,0 +1,337 @@
+/*
+Package refgraph analyzes the references between the objects of a folder,
+snippet or device, such as the addresses in the static members of an address
+group or in the sources of a security rule, and deletes objects in an order
+that never leaves a dangling reference.
+
+	g, err := refgraph.Build(ctx, client, api.Folder("Texas"))
+	if err != nil {
+		return err
+	}
+
+	web := g.Node(resource.Addresses, "web")
+	for _, e := range g.Referrers(web) {
+		fmt.Println(e) // address-groups "servers" references addresses "web" through static
+	}
+
+	// Delete the address along with everything referencing it, referrers
+	// first.
+	deleted, err := g.Delete(ctx, g.WithReferrers(web)...)
+
+The references followed are the ones described by package resource.  Only
+the objects of the scope itself are in the graph: objects of child folders,
+or of kinds the graph was not built with, may still reference an object, in
+which case the API refuses to delete it with a *errors.ReferenceNotZeroError.
+*/
+package refgraph
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	"github.com/paloaltonetworks/scm-go/resource"
+)
+
+// Node is an object of the graph.
+type Node struct {
+	Kind   *resource.Kind
+	Name   string
+	Object map[string]interface{}
+}
+
+// ID returns the ID of the object.
+func (n *Node) ID() string {
+	id, _ := n.Object["id"].(string)
+	return id
+}
+
+func (n *Node) String() string {
+	return fmt.Sprintf("%s %q", n.Kind, n.Name)
+}
+
+// Edge is a reference from an object to another, through one of its fields.
+type Edge struct {
+	From  *Node
+	To    *Node
+	Field string
+}
+
+func (e Edge) String() string {
+	return fmt.Sprintf("%s references %s through %s", e.From, e.To, e.Field)
+}
+
+// Graph is the graph of the references between the objects of a scope.
+type Graph struct {
+	Scope api.Scope
+
+	client resource.Client
+	nodes  []*Node
+	byKey  map[key]*Node
+	out    map[*Node][]Edge
+	in     map[*Node][]Edge
+}
+
+// key identifies a node by kind and name.
+type key struct {
+	kind, name string
+}
+
+// Build lists the objects of the given kinds in scope, all known kinds if
+// none are given, and returns the graph of their references.
+func Build(ctx context.Context, client resource.Client, scope api.Scope, kinds ...*resource.Kind) (*Graph, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	if len(kinds) == 0 {
+		kinds = resource.All()
+	}
+
+	g := &Graph{
+		Scope:  scope,
+		client: client,
+		byKey:  make(map[key]*Node),
+		out:    make(map[*Node][]Edge),
+		in:     make(map[*Node][]Edge),
+	}
+	for _, kind := range kinds {
+		for obj, err := range resource.List(ctx, client, scope, kind) {
+			if err != nil {
+				return nil, fmt.Errorf("listing %s: %w", kind, err)
+			}
+			name, _ := obj["name"].(string)
+			if name == "" || !scope.Contains(obj) {
+				continue
+			}
+			n := &Node{Kind: kind, Name: name, Object: obj}
+			g.nodes = append(g.nodes, n)
+			g.byKey[key{kind.Name, name}] = n
+		}
+	}
+	sortNodes(g.nodes)
+
+	for _, n := range g.nodes {
+		for _, ref := range n.Kind.References(n.Object) {
+			to := g.resolve(ref)
+			if to == nil || to == n {
+				continue
+			}
+			e := Edge{From: n, To: to, Field: ref.Field}
+			if !slices.Contains(g.out[n], e) {
+				g.out[n] = append(g.out[n], e)
+				g.in[to] = append(g.in[to], e)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// resolve returns the node referenced by ref, or nil.
+func (g *Graph) resolve(ref resource.Reference) *Node {
+	for _, kind := range ref.Kinds {
+		if n := g.byKey[key{kind, ref.Name}]; n != nil {
+			return n
+		}
+	}
+	return nil
+}
+
+// sortNodes sorts nodes by kind, as ordered by resource.All, then by name.
+func sortNodes(nodes []*Node) {
+	rank := make(map[string]int)
+	for i, k := range resource.All() {
+		rank[k.Name] = i
+	}
+	slices.SortFunc(nodes, func(a, b *Node) int {
+		ra, oka := rank[a.Kind.Name]
+		rb, okb := rank[b.Kind.Name]
+		switch {
+		case oka != okb:
+			if oka {
+				return -1
+			}
+			return 1
+		case ra != rb:
+			return ra - rb
+		case a.Kind.Name != b.Kind.Name:
+			return strings.Compare(a.Kind.Name, b.Kind.Name)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// Nodes returns the objects of the graph, sorted by kind then name.
+func (g *Graph) Nodes() []*Node {
+	return slices.Clone(g.nodes)
+}
+
+// Node returns the object of kind with the given name, or nil.
+func (g *Graph) Node(kind *resource.Kind, name string) *Node {
+	return g.byKey[key{kind.Name, name}]
+}
+
+// References returns the references of n to other objects.
+func (g *Graph) References(n *Node) []Edge {
+	return slices.Clone(g.out[n])
+}
+
+// Referrers returns the references of other objects to n.
+func (g *Graph) Referrers(n *Node) []Edge {
+	return slices.Clone(g.in[n])
+}
+
+// WithReferrers returns nodes along with all the objects referencing them,
+// directly or not, in the order of Nodes.
+func (g *Graph) WithReferrers(nodes ...*Node) []*Node {
+	set := make(map[*Node]bool)
+	var visit func(n *Node)
+	visit = func(n *Node) {
+		if set[n] {
+			return
+		}
+		set[n] = true
+		for _, e := range g.in[n] {
+			visit(e.From)
+		}
+	}
+	for _, n := range nodes {
+		visit(n)
+	}
+
+	var ans []*Node
+	for _, n := range g.nodes {
+		if set[n] {
+			ans = append(ans, n)
+		}
+	}
+	return ans
+}
+
+// BlockedError is returned when objects to delete are referenced by objects
+// not being deleted.
+type BlockedError struct {
+	// Refs are the references blocking the deletion.
+	Refs []Edge
+}
+
+func (e *BlockedError) Error() string {
+	refs := make([]string, 0, len(e.Refs))
+	for _, r := range e.Refs {
+		refs = append(refs, r.String())
+	}
+	return "deletion blocked: " + strings.Join(refs, "; ")
+}
+
+// DeletionOrder returns nodes in the order they can be deleted in, every
+// object coming before the objects it references.
+//
+// It returns a *BlockedError if any of the nodes is referenced by an object
+// not in nodes; use WithReferrers to delete those as well.
+func (g *Graph) DeletionOrder(nodes ...*Node) ([]*Node, error) {
+	set := make(map[*Node]bool, len(nodes))
+	for _, n := range nodes {
+		if g.byKey[key{n.Kind.Name, n.Name}] != n {
+			return nil, fmt.Errorf("%s is not in the graph", n)
+		}
+		set[n] = true
+	}
+
+	blocked := &BlockedError{}
+	for _, n := range g.nodes {
+		if !set[n] {
+			continue
+		}
+		for _, e := range g.in[n] {
+			if !set[e.From] {
+				blocked.Refs = append(blocked.Refs, e)
+			}
+		}
+	}
+	if len(blocked.Refs) != 0 {
+		return nil, blocked
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[*Node]int, len(set))
+	ans := make([]*Node, 0, len(set))
+
+	var visit func(n *Node) error
+	visit = func(n *Node) error {
+		switch state[n] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("reference cycle through %s", n)
+		}
+		state[n] = visiting
+		for _, e := range g.in[n] {
+			if err := visit(e.From); err != nil {
+				return err
+			}
+		}
+		state[n] = visited
+		ans = append(ans, n)
+		return nil
+	}
+
+	for _, n := range g.nodes {
+		if set[n] {
+			if err := visit(n); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ans, nil
+}
+
+// DeleteError is returned by Delete when deleting an object fails.
+type DeleteError struct {
+	Node *Node
+	Err  error
+}
+
+func (e *DeleteError) Error() string {
+	return fmt.Sprintf("deleting %s: %v", e.Node, e.Err)
+}
+
+func (e *DeleteError) Unwrap() error { return e.Err }
+
+// Delete deletes nodes in the order given by DeletionOrder and removes them
+// from the graph.  Nothing is deleted if the deletion is blocked.
+//
+// It returns the nodes deleted, and stops at the first failure with a
+// *DeleteError.
+func (g *Graph) Delete(ctx context.Context, nodes ...*Node) ([]*Node, error) {
+	order, err := g.DeletionOrder(nodes...)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []*Node
+	for _, n := range order {
+		if err := resource.Delete(ctx, g.client, n.Kind, n.ID()); err != nil {
+			return deleted, &DeleteError{Node: n, Err: err}
+		}
+		g.remove(n)
+		deleted = append(deleted, n)
+	}
+	return deleted, nil
+}
+
+// remove removes n, which no other node references, from the graph.
+func (g *Graph) remove(n *Node) {
+	for _, e := range g.out[n] {
+		g.in[e.To] = slices.DeleteFunc(g.in[e.To], func(x Edge) bool { return x.From == n })
+	}
+	delete(g.out, n)
+	delete(g.in, n)
+	delete(g.byKey, key{n.Kind.Name, n.Name})
+	g.nodes = slices.DeleteFunc(g.nodes, func(x *Node) bool { return x == n })
+}
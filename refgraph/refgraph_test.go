@@ -0,0 +1,178 @@
+package refgraph_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/api"
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+	"github.com/paloaltonetworks/scm-go/refgraph"
+	"github.com/paloaltonetworks/scm-go/resource"
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func newClient(t *testing.T, srv *scmtest.Server) *scm.Client {
+	t.Helper()
+
+	c := &scm.Client{AuthFile: srv.ConfigFile(t), SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	return c
+}
+
+func newServer(t *testing.T) *scmtest.Server {
+	t.Helper()
+
+	srv := scmtest.NewServer()
+	t.Cleanup(srv.Close)
+
+	add := func(kind *resource.Kind, obj map[string]interface{}) {
+		obj["folder"] = "Texas"
+		srv.Add(kind.Path, obj)
+	}
+	add(resource.Tags, map[string]interface{}{"name": "prod"})
+	add(resource.Addresses, map[string]interface{}{"name": "web", "ip_netmask": "10.0.0.1/32", "tag": []interface{}{"prod"}})
+	add(resource.Addresses, map[string]interface{}{"name": "db", "ip_netmask": "10.0.0.2/32"})
+	add(resource.AddressGroups, map[string]interface{}{"name": "servers", "static": []interface{}{"web", "db"}})
+	add(resource.AddressGroups, map[string]interface{}{"name": "all", "static": []interface{}{"servers", "any"}})
+	add(resource.SecurityRules, map[string]interface{}{
+		"name": "allow", "source": []interface{}{"all"}, "destination": []interface{}{"db"}, "tag": []interface{}{"prod"},
+	})
+	add(resource.NatRules, map[string]interface{}{
+		"name": "snat",
+		"source_translation": map[string]interface{}{
+			"dynamic_ip_and_port": map[string]interface{}{"translated_address": []interface{}{"web"}},
+		},
+	})
+
+	// Objects of other folders are not in the graph.
+	srv.Add(resource.Addresses.Path, map[string]interface{}{"name": "web", "folder": "Shared"})
+	return srv
+}
+
+func names(nodes []*refgraph.Node) []string {
+	var ans []string
+	for _, n := range nodes {
+		ans = append(ans, n.Name)
+	}
+	return ans
+}
+
+func edges(edges []refgraph.Edge) []string {
+	var ans []string
+	for _, e := range edges {
+		ans = append(ans, e.String())
+	}
+	return ans
+}
+
+func TestBuild(t *testing.T) {
+	srv := newServer(t)
+	g, err := refgraph.Build(context.Background(), newClient(t, srv), api.Folder("Texas"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"prod", "db", "web", "all", "servers", "allow", "snat"}, names(g.Nodes()))
+	assert.Nil(t, g.Node(resource.Addresses, "dns"))
+
+	web := g.Node(resource.Addresses, "web")
+	require.NotNil(t, web)
+	assert.Equal(t, "Texas", web.Object["folder"])
+	assert.NotEmpty(t, web.ID())
+
+	assert.Equal(t, []string{
+		`address-groups "servers" references addresses "web" through static`,
+		`nat-rules "snat" references addresses "web" through source_translation.dynamic_ip_and_port.translated_address`,
+	}, edges(g.Referrers(web)))
+	assert.Equal(t, []string{
+		`security-rules "allow" references address-groups "all" through source`,
+		`security-rules "allow" references addresses "db" through destination`,
+		`security-rules "allow" references tags "prod" through tag`,
+	}, edges(g.References(g.Node(resource.SecurityRules, "allow"))))
+}
+
+func TestDeletionOrder_Blocked(t *testing.T) {
+	srv := newServer(t)
+	g, err := refgraph.Build(context.Background(), newClient(t, srv), api.Folder("Texas"))
+	require.NoError(t, err)
+
+	_, err = g.DeletionOrder(g.Node(resource.Addresses, "web"), g.Node(resource.AddressGroups, "servers"))
+	var be *refgraph.BlockedError
+	require.True(t, errors.As(err, &be), "%v", err)
+	assert.Equal(t, []string{
+		`nat-rules "snat" references addresses "web" through source_translation.dynamic_ip_and_port.translated_address`,
+		`address-groups "all" references address-groups "servers" through static`,
+	}, edges(be.Refs))
+
+	// Nothing is deleted.
+	_, err = g.Delete(context.Background(), g.Node(resource.Addresses, "web"))
+	require.True(t, errors.As(err, &be), "%v", err)
+	assert.Len(t, srv.Objects(resource.Addresses.Path), 3)
+}
+
+func TestDelete(t *testing.T) {
+	srv := newServer(t)
+	g, err := refgraph.Build(context.Background(), newClient(t, srv), api.Folder("Texas"))
+	require.NoError(t, err)
+
+	web := g.Node(resource.Addresses, "web")
+	nodes := g.WithReferrers(web)
+	assert.Equal(t, []string{"web", "all", "servers", "allow", "snat"}, names(nodes))
+
+	deleted, err := g.Delete(context.Background(), nodes...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"allow", "all", "servers", "snat", "web"}, names(deleted))
+
+	assert.Equal(t, []string{"prod", "db"}, names(g.Nodes()))
+	assert.Empty(t, g.Referrers(g.Node(resource.Addresses, "db")))
+	assert.Len(t, srv.Objects(resource.Addresses.Path), 2)
+	assert.Empty(t, srv.Objects(resource.AddressGroups.Path))
+	assert.Empty(t, srv.Objects(resource.SecurityRules.Path))
+}
+
+func TestDelete_ReferenceNotZero(t *testing.T) {
+	srv := newServer(t)
+
+	// The groups referencing the address are not in the graph.
+	g, err := refgraph.Build(context.Background(), newClient(t, srv), api.Folder("Texas"), resource.Addresses)
+	require.NoError(t, err)
+
+	deleted, err := g.Delete(context.Background(), g.Node(resource.Addresses, "web"))
+	assert.Empty(t, deleted)
+	var de *refgraph.DeleteError
+	require.True(t, errors.As(err, &de), "%v", err)
+	assert.Equal(t, "web", de.Node.Name)
+	assert.True(t, scmErrors.IsReferenceNotZero(err), "%v", err)
+}
+
+func TestDelete_PostRule(t *testing.T) {
+	srv := newServer(t)
+	c := newClient(t, srv)
+	ctx := context.Background()
+
+	// Only a post rule references the address.
+	srv.Add(resource.Addresses.Path, map[string]interface{}{"name": "dns", "ip_netmask": "10.0.0.53/32", "folder": "Texas"})
+	_, err := c.Do(ctx, http.MethodPost, resource.SecurityRules.Path, url.Values{"position": {"post"}}, map[string]interface{}{
+		"name": "deny-dns", "folder": "Texas", "destination": []interface{}{"dns"},
+	}, nil)
+	require.NoError(t, err)
+
+	g, err := refgraph.Build(ctx, c, api.Folder("Texas"))
+	require.NoError(t, err)
+	dns := g.Node(resource.Addresses, "dns")
+	require.NotNil(t, dns)
+	assert.Equal(t, []string{
+		`security-rules "deny-dns" references addresses "dns" through destination`,
+	}, edges(g.Referrers(dns)))
+
+	deleted, err := g.Delete(ctx, g.WithReferrers(dns)...)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deny-dns", "dns"}, names(deleted))
+	assert.Len(t, srv.Objects(resource.SecurityRules.Path), 1)
+}
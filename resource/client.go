@@ -0,0 +1,152 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+)
+
+// Client is the API client used to operate on objects, such as a *scm.Client.
+type Client interface {
+	Do(ctx context.Context, method string, path string, queryParams url.Values, input, output interface{}, retry ...error) ([]byte, error)
+}
+
+// List iterates over the objects of kind listed in scope, including the ones
+// inherited by it (see api.Scope.Contains).  The objects of a kind with
+// positions are listed from each of them in turn.  Listing fails if scope is
+// the zero Scope.
+func List(ctx context.Context, client Client, scope api.Scope, kind *Kind) iter.Seq2[map[string]interface{}, error] {
+	if err := scope.Validate(); err != nil {
+		return func(yield func(map[string]interface{}, error) bool) {
+			yield(nil, err)
+		}
+	}
+	if len(kind.Positions) == 0 {
+		return list(ctx, client, scope, kind, kind.query())
+	}
+	return func(yield func(map[string]interface{}, error) bool) {
+		for _, position := range kind.Positions {
+			q := kind.query()
+			q.Set("position", position)
+			for obj, err := range list(ctx, client, scope, kind, q) {
+				if !yield(obj, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// list iterates over the objects of kind listed in scope with the query
+// parameters q.
+func list(ctx context.Context, client Client, scope api.Scope, kind *Kind, q url.Values) iter.Seq2[map[string]interface{}, error] {
+	return api.Paginate(ctx, func(ctx context.Context, offset, limit int32) ([]map[string]interface{}, int32, error) {
+		q := maps.Clone(q)
+		param, value := scope.Param()
+		q.Set(param, value)
+		q.Set("offset", strconv.Itoa(int(offset)))
+		q.Set("limit", strconv.Itoa(int(limit)))
+
+		var page struct {
+			Data  []map[string]interface{} `json:"data"`
+			Total int32                    `json:"total"`
+		}
+		body, err := client.Do(ctx, http.MethodGet, kind.Path, q, nil, &page)
+		if err != nil {
+			return nil, 0, typedError(body, err)
+		}
+		return page.Data, page.Total, nil
+	})
+}
+
+// Create creates obj, an object of kind, in scope and returns it.  Objects
+// of a kind with positions are created in the first one.
+func Create(ctx context.Context, client Client, scope api.Scope, kind *Kind, obj map[string]interface{}) (map[string]interface{}, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	var ans map[string]interface{}
+	q := kind.query()
+	if len(kind.Positions) != 0 {
+		q.Set("position", kind.Positions[0])
+	}
+	body, err := client.Do(ctx, http.MethodPost, kind.Path, q, body(scope, obj), &ans)
+	if err != nil {
+		return nil, typedError(body, err)
+	}
+	return ans, nil
+}
+
+// Update replaces the object of kind with the given ID by obj, in scope, and
+// returns it.
+func Update(ctx context.Context, client Client, scope api.Scope, kind *Kind, id string, obj map[string]interface{}) (map[string]interface{}, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	var ans map[string]interface{}
+	body, err := client.Do(ctx, http.MethodPut, kind.Path+"/"+url.PathEscape(id), nil, body(scope, obj), &ans)
+	if err != nil {
+		return nil, typedError(body, err)
+	}
+	return ans, nil
+}
+
+// Delete deletes the object of kind with the given ID.  Deleting an object
+// still referenced fails with a *errors.ReferenceNotZeroError.
+func Delete(ctx context.Context, client Client, kind *Kind, id string) error {
+	body, err := client.Do(ctx, http.MethodDelete, kind.Path+"/"+url.PathEscape(id), nil, nil, nil)
+	if err != nil {
+		return typedError(body, err)
+	}
+	return nil
+}
+
+// query returns a copy of the query parameters of k.
+func (k *Kind) query() url.Values {
+	q := make(url.Values, len(k.Query)+1)
+	for name, v := range k.Query {
+		q[name] = slices.Clone(v)
+	}
+	return q
+}
+
+// body returns the request body creating or updating obj in scope: obj
+// without its id, and in the container of scope.
+func body(scope api.Scope, obj map[string]interface{}) map[string]interface{} {
+	ans := make(map[string]interface{}, len(obj)+1)
+	for k, v := range obj {
+		switch k {
+		case "id", "folder", "snippet", "device":
+		default:
+			ans[k] = v
+		}
+	}
+	param, value := scope.Param()
+	ans[param] = value
+	return ans
+}
+
+// typedError returns the typed SCM error of a failed call to Client.Do, body
+// being the response body.
+func typedError(body []byte, err error) error {
+	status := 0
+	var resp api.Response
+	switch {
+	case errors.As(err, &resp):
+		status = resp.StatusCode
+	case errors.Is(err, api.ObjectNotFoundError):
+		status = http.StatusNotFound
+	}
+	if se := scmErrors.FromResponse(status, nil, body, err); se != nil {
+		return se
+	}
+	return err
+}
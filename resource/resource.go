@@ -0,0 +1,292 @@
+/*
+Package resource describes the configuration object types of the SCM API: the
+API path of each, the extra query parameters it needs, and which of its fields
+reference other objects by name.
+
+Objects are handled in their JSON form, as returned by the API, so the same
+description serves every object type regardless of its generated model.  List,
+Create, Update and Delete operate on the objects of any kind, and References
+returns the names an object references:
+
+	texas := api.Folder("Texas")
+	for group, err := range resource.List(ctx, client, texas, resource.AddressGroups) {
+		if err != nil {
+			return err
+		}
+		for _, ref := range resource.AddressGroups.References(group) {
+			fmt.Printf("%s references %s through %s\n", group["name"], ref.Name, ref.Field)
+		}
+	}
+*/
+package resource
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Kind is a type of configuration object.
+type Kind struct {
+	// Name is the name of the kind, the last element of its API path, such
+	// as "addresses".
+	Name string
+
+	// Path is the API path of the kind, such as
+	// "/config/objects/v1/addresses".
+	Path string
+
+	// Query holds the query parameters needed to list and create objects
+	// of the kind besides their scope and position.
+	Query url.Values
+
+	// Positions are the rulebase positions of the objects of the kind, such
+	// as "pre" and "post" for security rules, or nil if the kind has none.
+	// Objects are listed from every position, and created in the first
+	// one.
+	Positions []string
+
+	// Refs are the fields of the objects of the kind that reference other
+	// objects by name.
+	Refs []Ref
+}
+
+func (k *Kind) String() string { return k.Name }
+
+// Ref is a field referencing other objects by name.
+type Ref struct {
+	// Field is the JSON name of the field, nested fields being separated
+	// by dots such as "profile_setting.group".  The field holds a name or a
+	// list of names.
+	Field string
+
+	// Kinds are the names of the kinds the field may reference, in the
+	// order names are looked up in.  Names not found in any of these kinds,
+	// such as "any" or predefined objects, are not references.
+	Kinds []string
+}
+
+// Reference is a name found in a reference field of an object.
+type Reference struct {
+	Field string
+	Name  string
+	Kinds []string
+}
+
+// References returns the names referenced by the fields of obj, in the order
+// of Refs.
+func (k *Kind) References(obj map[string]interface{}) []Reference {
+	var ans []Reference
+	for _, ref := range k.Refs {
+		for _, name := range lookup(obj, ref.Field) {
+			ans = append(ans, Reference{Field: ref.Field, Name: name, Kinds: ref.Kinds})
+		}
+	}
+	return ans
+}
+
+// lookup returns the names held by the given field of obj.
+func lookup(obj map[string]interface{}, field string) []string {
+	var v interface{} = obj
+	for _, key := range strings.Split(field, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+
+	switch v := v.(type) {
+	case string:
+		if v != "" {
+			return []string{v}
+		}
+	case []string:
+		return v
+	case []interface{}:
+		ans := make([]string, 0, len(v))
+		for _, x := range v {
+			if s, ok := x.(string); ok && s != "" {
+				ans = append(ans, s)
+			}
+		}
+		return ans
+	}
+	return nil
+}
+
+// rulePositions are the positions of the rules, before and after the rules
+// of the device or folder.
+var rulePositions = []string{"pre", "post"}
+
+// Kinds of objects.
+var (
+	Tags = &Kind{
+		Name: "tags",
+		Path: "/config/objects/v1/tags",
+	}
+
+	Addresses = &Kind{
+		Name: "addresses",
+		Path: "/config/objects/v1/addresses",
+		Refs: []Ref{
+			{Field: "tag", Kinds: []string{"tags"}},
+		},
+	}
+
+	AddressGroups = &Kind{
+		Name: "address-groups",
+		Path: "/config/objects/v1/address-groups",
+		Refs: []Ref{
+			{Field: "static", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "tag", Kinds: []string{"tags"}},
+		},
+	}
+
+	Services = &Kind{
+		Name: "services",
+		Path: "/config/objects/v1/services",
+		Refs: []Ref{
+			{Field: "tag", Kinds: []string{"tags"}},
+		},
+	}
+
+	ServiceGroups = &Kind{
+		Name: "service-groups",
+		Path: "/config/objects/v1/service-groups",
+		Refs: []Ref{
+			{Field: "members", Kinds: []string{"services", "service-groups"}},
+			{Field: "tag", Kinds: []string{"tags"}},
+		},
+	}
+
+	ApplicationFilters = &Kind{
+		Name: "application-filters",
+		Path: "/config/objects/v1/application-filters",
+	}
+
+	ApplicationGroups = &Kind{
+		Name: "application-groups",
+		Path: "/config/objects/v1/application-groups",
+		Refs: []Ref{
+			{Field: "members", Kinds: []string{"application-groups", "application-filters"}},
+		},
+	}
+
+	AntiSpywareProfiles = &Kind{
+		Name: "anti-spyware-profiles",
+		Path: "/config/security/v1/anti-spyware-profiles",
+	}
+
+	DataFilteringProfiles = &Kind{
+		Name: "data-filtering-profiles",
+		Path: "/config/security/v1/data-filtering-profiles",
+	}
+
+	DNSSecurityProfiles = &Kind{
+		Name: "dns-security-profiles",
+		Path: "/config/security/v1/dns-security-profiles",
+	}
+
+	FileBlockingProfiles = &Kind{
+		Name: "file-blocking-profiles",
+		Path: "/config/security/v1/file-blocking-profiles",
+	}
+
+	URLAccessProfiles = &Kind{
+		Name: "url-access-profiles",
+		Path: "/config/security/v1/url-access-profiles",
+	}
+
+	VulnerabilityProtectionProfiles = &Kind{
+		Name: "vulnerability-protection-profiles",
+		Path: "/config/security/v1/vulnerability-protection-profiles",
+	}
+
+	WildfireAntiVirusProfiles = &Kind{
+		Name: "wildfire-anti-virus-profiles",
+		Path: "/config/security/v1/wildfire-anti-virus-profiles",
+	}
+
+	ProfileGroups = &Kind{
+		Name: "profile-groups",
+		Path: "/config/security/v1/profile-groups",
+		Refs: []Ref{
+			{Field: "spyware", Kinds: []string{"anti-spyware-profiles"}},
+			{Field: "data_filtering", Kinds: []string{"data-filtering-profiles"}},
+			{Field: "dns_security", Kinds: []string{"dns-security-profiles"}},
+			{Field: "file_blocking", Kinds: []string{"file-blocking-profiles"}},
+			{Field: "url_filtering", Kinds: []string{"url-access-profiles"}},
+			{Field: "vulnerability", Kinds: []string{"vulnerability-protection-profiles"}},
+			{Field: "virus_and_wildfire_analysis", Kinds: []string{"wildfire-anti-virus-profiles"}},
+		},
+	}
+
+	SecurityRules = &Kind{
+		Name:      "security-rules",
+		Path:      "/config/security/v1/security-rules",
+		Positions: rulePositions,
+		Refs: []Ref{
+			{Field: "source", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "destination", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "service", Kinds: []string{"services", "service-groups"}},
+			{Field: "application", Kinds: []string{"application-groups", "application-filters"}},
+			{Field: "tag", Kinds: []string{"tags"}},
+			{Field: "profile_setting.group", Kinds: []string{"profile-groups"}},
+		},
+	}
+
+	NatRules = &Kind{
+		Name:      "nat-rules",
+		Path:      "/config/network/v1/nat-rules",
+		Positions: rulePositions,
+		Refs: []Ref{
+			{Field: "source", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "destination", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "service", Kinds: []string{"services", "service-groups"}},
+			{Field: "tag", Kinds: []string{"tags"}},
+			{Field: "source_translation.dynamic_ip_and_port.translated_address", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "source_translation.dynamic_ip.translated_address", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "source_translation.dynamic_ip.fallback.translated_address", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "source_translation.static_ip.translated_address", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "destination_translation.translated_address", Kinds: []string{"addresses", "address-groups"}},
+			{Field: "dynamic_destination_translation.translated_address", Kinds: []string{"addresses", "address-groups"}},
+		},
+	}
+)
+
+// kinds are the known kinds, referenced kinds first.
+var kinds = []*Kind{
+	Tags,
+	Addresses,
+	AddressGroups,
+	Services,
+	ServiceGroups,
+	ApplicationFilters,
+	ApplicationGroups,
+	AntiSpywareProfiles,
+	DataFilteringProfiles,
+	DNSSecurityProfiles,
+	FileBlockingProfiles,
+	URLAccessProfiles,
+	VulnerabilityProtectionProfiles,
+	WildfireAntiVirusProfiles,
+	ProfileGroups,
+	SecurityRules,
+	NatRules,
+}
+
+// All returns the known kinds, ordered so that kinds come after the kinds
+// they reference.
+func All() []*Kind {
+	return append([]*Kind(nil), kinds...)
+}
+
+// Lookup returns the known kind with the given name, or nil.
+func Lookup(name string) *Kind {
+	for _, k := range kinds {
+		if k.Name == name {
+			return k
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	"github.com/paloaltonetworks/scm-go/resource"
+)
+
+func TestKind_References(t *testing.T) {
+	rule := map[string]interface{}{
+		"name":        "allow-web",
+		"source":      []interface{}{"web", "db"},
+		"destination": []interface{}{"any"},
+		"tag":         []string{"prod"},
+		"service":     "ssh",
+	}
+
+	assert.Equal(t, []resource.Reference{
+		{Field: "source", Name: "web", Kinds: []string{"addresses", "address-groups"}},
+		{Field: "source", Name: "db", Kinds: []string{"addresses", "address-groups"}},
+		{Field: "destination", Name: "any", Kinds: []string{"addresses", "address-groups"}},
+		{Field: "service", Name: "ssh", Kinds: []string{"services", "service-groups"}},
+		{Field: "tag", Name: "prod", Kinds: []string{"tags"}},
+	}, resource.SecurityRules.References(rule))
+
+	nested := &resource.Kind{Name: "nested", Refs: []resource.Ref{{Field: "a.b", Kinds: []string{"tags"}}}}
+	assert.Equal(t, []resource.Reference{{Field: "a.b", Name: "x", Kinds: []string{"tags"}}},
+		nested.References(map[string]interface{}{"a": map[string]interface{}{"b": "x"}}))
+	assert.Empty(t, nested.References(map[string]interface{}{"a": "b"}))
+}
+
+func TestAll(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, k := range resource.All() {
+		assert.Same(t, k, resource.Lookup(k.Name))
+		for _, ref := range k.Refs {
+			for _, name := range ref.Kinds {
+				assert.True(t, seen[name] || name == k.Name, "%s references %s, which comes after it", k, name)
+			}
+		}
+		seen[k.Name] = true
+	}
+	assert.Nil(t, resource.Lookup("unknown"))
+}
+
+func TestZeroScope(t *testing.T) {
+	ctx := context.Background()
+	const want = "scope must have exactly one of folder, snippet or device, got 0"
+
+	// The client is never called.
+	for _, err := range resource.List(ctx, nil, api.Scope{}, resource.Addresses) {
+		assert.EqualError(t, err, want)
+	}
+	_, err := resource.Create(ctx, nil, api.Folder(""), resource.Addresses, map[string]interface{}{"name": "web"})
+	assert.EqualError(t, err, want)
+	_, err = resource.Update(ctx, nil, api.Scope{}, resource.Addresses, "1", map[string]interface{}{"name": "web"})
+	assert.EqualError(t, err, want)
+}
@@ -0,0 +1,259 @@
+package scm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	retry "github.com/sethvargo/go-retry"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+/*
+RetryPolicy controls how failed API requests are retried.
+
+A request is retried if it failed with a network error or one of Statuses,
+as long as its method is idempotent.  Requests failing with one of
+AnyMethodStatuses (by default only 429, for which the server has not
+processed the request) are retried whatever their method.  POST is not
+idempotent: unlike the 429s, the 5xx responses to POST requests are not
+retried, as the server may have created the object.  Add http.MethodPost to
+IdempotentMethods to retry them too.
+
+Waits between attempts grow exponentially from MinBackoff up to MaxBackoff,
+with up to Jitter added at random.  If the server asks for a longer wait with
+a Retry-After header, that is honored, unless it is longer than
+MaxRetryAfter, in which case the response is returned as is.
+
+Zero fields take the value from DefaultRetryPolicy.  To disable retries, set
+MaxAttempts to 1.
+*/
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	Jitter        time.Duration
+	MaxRetryAfter time.Duration
+
+	Statuses          []int
+	AnyMethodStatuses []int
+	IdempotentMethods []string
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:   5,
+		MinBackoff:    1 * time.Second,
+		MaxBackoff:    30 * time.Second,
+		Jitter:        500 * time.Millisecond,
+		MaxRetryAfter: 2 * time.Minute,
+		Statuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		AnyMethodStatuses: []int{http.StatusTooManyRequests},
+		IdempotentMethods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+			http.MethodDelete,
+		},
+	}
+}
+
+// withDefaults returns the policy with zero fields set from DefaultRetryPolicy.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	d := DefaultRetryPolicy()
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = d.MaxAttempts
+	}
+	if p.MinBackoff <= 0 {
+		p.MinBackoff = d.MinBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = d.MaxBackoff
+	}
+	if p.Jitter <= 0 {
+		p.Jitter = d.Jitter
+	}
+	if p.MaxRetryAfter <= 0 {
+		p.MaxRetryAfter = d.MaxRetryAfter
+	}
+	if p.Statuses == nil {
+		p.Statuses = d.Statuses
+	}
+	if p.AnyMethodStatuses == nil {
+		p.AnyMethodStatuses = d.AnyMethodStatuses
+	}
+	if p.IdempotentMethods == nil {
+		p.IdempotentMethods = d.IdempotentMethods
+	}
+	return p
+}
+
+// idempotent returns whether requests with the given method may be retried.
+func (p RetryPolicy) idempotent(method string) bool {
+	return slices.Contains(p.IdempotentMethods, strings.ToUpper(method))
+}
+
+// retryStatus returns whether a response with the given status is retried.
+func (p RetryPolicy) retryStatus(method string, status int) bool {
+	if slices.Contains(p.AnyMethodStatuses, status) {
+		return true
+	}
+	return p.idempotent(method) && slices.Contains(p.Statuses, status)
+}
+
+// backoff returns the go-retry backoff for the policy.  The wait before the
+// next attempt is at least the value held by retryAfter.
+func (p RetryPolicy) backoff(retryAfter *atomic.Int64) retry.Backoff {
+	b := retry.NewExponential(p.MinBackoff)
+	b = retry.WithCappedDuration(p.MaxBackoff, b)
+	b = retry.WithJitter(p.Jitter, b)
+	b = retry.WithMaxRetries(uint64(p.MaxAttempts-1), b)
+
+	return retry.BackoffFunc(func() (time.Duration, bool) {
+		next, stop := b.Next()
+		if stop {
+			return 0, true
+		}
+		if d := time.Duration(retryAfter.Swap(0)); d > next {
+			next = d
+		}
+		return next, false
+	})
+}
+
+// errRetryStatus marks an attempt that got a response with a retryable status.
+var errRetryStatus = errors.New("retryable response status")
+
+// RetryTransport is a RoundTripper retrying failed requests according to a
+// RetryPolicy.
+type RetryTransport struct {
+	Wrapped http.RoundTripper
+	Policy  RetryPolicy
+}
+
+// RoundTrip implements http.RoundTripper interface
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Wrapped == nil {
+		t.Wrapped = http.DefaultTransport
+	}
+	p := t.Policy.withDefaults()
+	if p.MaxAttempts == 1 {
+		return t.Wrapped.RoundTrip(req)
+	}
+
+	// The body has to be sent again on each attempt.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	var resp *http.Response
+	var retryAfter atomic.Int64
+	var lastErr error
+	attempt := 0
+	err := retry.Do(req.Context(), p.backoff(&retryAfter), func(ctx context.Context) error {
+		r := req
+		if attempt > 0 {
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			api.TraceRetry(ctx, attempt+1, status, lastErr)
+			discard(resp)
+			resp = nil
+
+			r = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return err
+				}
+				r.Body = body
+			}
+		}
+		attempt++
+
+		var err error
+		resp, err = t.Wrapped.RoundTrip(r)
+		lastErr = err
+		if err != nil {
+			if p.idempotent(req.Method) && ctx.Err() == nil {
+				return retry.RetryableError(err)
+			}
+			return err
+		}
+
+		if !p.retryStatus(req.Method, resp.StatusCode) {
+			return nil
+		}
+		d := parseRetryAfter(resp.Header)
+		if d > p.MaxRetryAfter {
+			return nil
+		}
+		retryAfter.Store(int64(d))
+		return retry.RetryableError(errRetryStatus)
+	})
+
+	switch {
+	case err == nil, errors.Is(err, errRetryStatus):
+		// Out of attempts: hand back the last response.
+		return resp, nil
+	default:
+		discard(resp)
+		return nil, err
+	}
+}
+
+// discard drains and closes the body of resp, if any, so that the underlying
+// connection can be reused.
+func discard(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+}
+
+// parseRetryAfter returns the wait requested by the Retry-After header, which
+// holds either a number of seconds or an HTTP date.
+func parseRetryAfter(header http.Header) time.Duration {
+	v := strings.TrimSpace(header.Get("Retry-After"))
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
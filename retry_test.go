@@ -0,0 +1,240 @@
+package scm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetryPolicy keeps the tests quick.
+var fastRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+	Jitter:      time.Millisecond,
+}
+
+// statusServer answers with the given statuses in turn, then with 200.
+func statusServer(t *testing.T, calls *int32, bodies *[]string, statuses ...int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(calls, 1))
+		if bodies != nil {
+			b, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(b))
+		}
+		if n <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRetryTransport_RetriesStatuses(t *testing.T) {
+	var calls int32
+	var bodies []string
+	server := statusServer(t, &calls, &bodies, http.StatusServiceUnavailable, http.StatusBadGateway)
+
+	client := &http.Client{Transport: &RetryTransport{Policy: fastRetryPolicy}}
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"x"}`))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls)
+	assert.Equal(t, []string{`{"name":"x"}`, `{"name":"x"}`, `{"name":"x"}`}, bodies)
+}
+
+func TestRetryTransport_ReturnsLastResponse(t *testing.T) {
+	var calls int32
+	server := statusServer(t, &calls, nil, 500, 500, 500, 500)
+
+	client := &http.Client{Transport: &RetryTransport{Policy: fastRetryPolicy}}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestRetryTransport_Idempotency(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		calls  int32
+	}{
+		{http.StatusServiceUnavailable, 1},
+		{http.StatusTooManyRequests, 2},
+	} {
+		var calls int32
+		server := statusServer(t, &calls, nil, tc.status)
+
+		client := &http.Client{Transport: &RetryTransport{Policy: fastRetryPolicy}}
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, tc.calls, calls, "status %d", tc.status)
+	}
+}
+
+func TestRetryTransport_RetryAfter(t *testing.T) {
+	var calls int32
+	var first time.Time
+	var waited time.Duration
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		waited = time.Since(first)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &RetryTransport{Policy: fastRetryPolicy}}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, waited, time.Second)
+}
+
+func TestRetryTransport_RetryAfterTooLong(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &RetryTransport{Policy: fastRetryPolicy}}
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetryTransport_ContextCanceled(t *testing.T) {
+	var calls int32
+	server := statusServer(t, &calls, nil, 503, 503, 503)
+
+	policy := fastRetryPolicy
+	policy.MinBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	client := &http.Client{Transport: &RetryTransport{Policy: policy}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestRetryPolicy_AppliesToDoAndAPIClients(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[],"limit":200,"offset":0,"total":0}`))
+	}))
+	defer server.Close()
+
+	c := &Client{
+		ClientId:             "client-id",
+		ClientSecret:         "client-secret",
+		Scope:                "tsg_id:1234567890",
+		Host:                 strings.TrimPrefix(server.URL, "http://"),
+		Protocol:             "http",
+		SkipLoggingTransport: true,
+		RetryPolicy:          fastRetryPolicy,
+		Jwt:                  "test-jwt",
+		JwtExpiresAt:         time.Now().Add(time.Hour),
+	}
+	require.NoError(t, c.Setup())
+
+	_, err := c.Do(context.Background(), http.MethodGet, "/config/objects/v1/addresses", nil, nil, nil)
+	require.NoError(t, err)
+	_, _, err = GetObjectsAPIClient(c).AddressesAPI.ListAddresses(context.Background()).Execute()
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(4), calls)
+}
+
+func TestRetryPolicy_Post(t *testing.T) {
+	for _, tc := range []struct {
+		status     int
+		idempotent []string
+		calls      int32
+	}{
+		// The server did not process the request.
+		{http.StatusTooManyRequests, nil, 2},
+		// The server may have processed the request.
+		{http.StatusServiceUnavailable, nil, 1},
+		{http.StatusServiceUnavailable, []string{http.MethodPost}, 2},
+	} {
+		var calls int32
+		server := statusServer(t, &calls, nil, tc.status)
+
+		policy := fastRetryPolicy
+		policy.IdempotentMethods = tc.idempotent
+		c := &Client{
+			ClientId:             "client-id",
+			ClientSecret:         "client-secret",
+			Scope:                "tsg_id:1234567890",
+			Host:                 strings.TrimPrefix(server.URL, "http://"),
+			Protocol:             "http",
+			SkipLoggingTransport: true,
+			RetryPolicy:          policy,
+			Jwt:                  "test-jwt",
+			JwtExpiresAt:         time.Now().Add(time.Hour),
+		}
+		require.NoError(t, c.Setup())
+
+		_, err := c.Do(context.Background(), http.MethodPost, "/config/objects/v1/addresses", nil, map[string]string{"name": "x"}, nil)
+		if tc.calls == 2 {
+			assert.NoError(t, err, "status %d", tc.status)
+		} else {
+			assert.Error(t, err, "status %d", tc.status)
+		}
+		assert.Equal(t, tc.calls, calls, "status %d", tc.status)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	h := http.Header{}
+	assert.Equal(t, time.Duration(0), parseRetryAfter(h))
+
+	h.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, parseRetryAfter(h))
+
+	h.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.InDelta(t, float64(time.Minute), float64(parseRetryAfter(h)), float64(2*time.Second))
+
+	h.Set("Retry-After", "soon")
+	assert.Equal(t, time.Duration(0), parseRetryAfter(h))
+}
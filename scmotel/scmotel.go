@@ -0,0 +1,266 @@
+/*
+Package scmotel instruments SCM API calls with OpenTelemetry.
+
+Each API request gets a client span, named after the generated operation
+(such as "AddressesAPIService.CreateAddresses") or after the HTTP method for
+requests made with Client.Do().  Spans record the response status, the SCM
+request ID and the number of retries, with an event for each retry and for
+each JWT refresh the request had to wait on.  Request latency, errors,
+retries and JWT refreshes are also recorded as metrics.
+
+Plug it into the client's middleware, so that both Do() and the API clients
+are instrumented:
+
+	client := &scm.Client{
+		AuthFile:   "scm-config.json",
+		Middleware: []scm.Middleware{scmotel.Middleware()},
+	}
+
+The global tracer and meter providers and text map propagator are used,
+unless given as options.
+*/
+package scmotel
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// ScopeName is the instrumentation scope of the spans and metrics.
+const ScopeName = "github.com/paloaltonetworks/scm-go/scmotel"
+
+// Attribute keys specific to SCM.
+const (
+	OperationKey  = attribute.Key("scm.operation")
+	RequestIDKey  = attribute.Key("scm.request_id")
+	RetryCountKey = attribute.Key("scm.retry_count")
+	AttemptKey    = attribute.Key("scm.attempt")
+)
+
+// Event names.
+const (
+	RetryEvent        = "scm.retry"
+	TokenRefreshEvent = "scm.token_refresh"
+)
+
+// maxErrorBody is the number of bytes of an error response searched for the
+// request ID when it is not in the headers.
+const maxErrorBody = 64 * 1024
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the provider of the tracer creating the spans.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) { c.tracerProvider = tp }
+}
+
+// WithMeterProvider sets the provider of the meter recording the metrics.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) { c.meterProvider = mp }
+}
+
+// WithPropagators sets the propagators injecting the span context into
+// outgoing requests.
+func WithPropagators(p propagation.TextMapPropagator) Option {
+	return func(c *config) { c.propagators = p }
+}
+
+// Middleware returns a middleware instrumenting the requests going through it.
+func Middleware(opts ...Option) scm.Middleware {
+	i := newInstrumentation(opts)
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &transport{next: next, inst: i}
+	}
+}
+
+// NewTransport returns next, instrumented.
+func NewTransport(next http.RoundTripper, opts ...Option) http.RoundTripper {
+	return Middleware(opts...)(next)
+}
+
+// instrumentation holds the tracer and metric instruments shared by the
+// transports of a middleware.
+type instrumentation struct {
+	tracer      trace.Tracer
+	propagators propagation.TextMapPropagator
+
+	duration       metric.Float64Histogram
+	requests       metric.Int64Counter
+	errors         metric.Int64Counter
+	retries        metric.Int64Counter
+	tokenRefreshes metric.Int64Counter
+}
+
+func newInstrumentation(opts []Option) *instrumentation {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagators:    otel.GetTextMapPropagator(),
+	}
+	for _, o := range opts {
+		o(&c)
+	}
+
+	meter := c.meterProvider.Meter(ScopeName, metric.WithSchemaURL(semconv.SchemaURL))
+	i := &instrumentation{
+		tracer:      c.tracerProvider.Tracer(ScopeName, trace.WithSchemaURL(semconv.SchemaURL)),
+		propagators: c.propagators,
+	}
+
+	// Instrument creation only fails on invalid names, and returns a no-op
+	// instrument in that case.
+	i.duration, _ = meter.Float64Histogram("scm.client.request.duration",
+		metric.WithDescription("Duration of SCM API requests, retries included."),
+		metric.WithUnit("s"))
+	i.requests, _ = meter.Int64Counter("scm.client.requests",
+		metric.WithDescription("Number of SCM API requests."),
+		metric.WithUnit("{request}"))
+	i.errors, _ = meter.Int64Counter("scm.client.errors",
+		metric.WithDescription("Number of SCM API requests that failed or got an error status."),
+		metric.WithUnit("{request}"))
+	i.retries, _ = meter.Int64Counter("scm.client.retries",
+		metric.WithDescription("Number of times SCM API requests were sent again."),
+		metric.WithUnit("{retry}"))
+	i.tokenRefreshes, _ = meter.Int64Counter("scm.client.token_refreshes",
+		metric.WithDescription("Number of JWT refreshes SCM API requests waited on."),
+		metric.WithUnit("{refresh}"))
+
+	return i
+}
+
+type transport struct {
+	next http.RoundTripper
+	inst *instrumentation
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	i := t.inst
+	start := time.Now()
+
+	op := api.Operation(req.Context())
+	name := op
+	if name == "" {
+		name = req.Method
+	}
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.ServerAddress(req.URL.Hostname()),
+	}
+	if op != "" {
+		attrs = append(attrs, OperationKey.String(op))
+	}
+	metricAttrs := attrs
+
+	ctx, span := i.tracer.Start(req.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(semconv.URLFull(api.RedactURL(req.URL))),
+	)
+	defer span.End()
+
+	retries := 0
+	ctx = api.WithClientTrace(ctx, &api.ClientTrace{
+		Retry: func(attempt, statusCode int, err error) {
+			retries++
+			ev := []attribute.KeyValue{AttemptKey.Int(attempt)}
+			if statusCode != 0 {
+				ev = append(ev, semconv.HTTPResponseStatusCode(statusCode))
+			}
+			if err != nil {
+				ev = append(ev, semconv.ErrorType(err))
+			}
+			span.AddEvent(RetryEvent, trace.WithAttributes(ev...))
+			i.retries.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+		},
+		TokenRefresh: func(err error) {
+			var ev []attribute.KeyValue
+			if err != nil {
+				ev = append(ev, semconv.ErrorType(err))
+			}
+			span.AddEvent(TokenRefreshEvent, trace.WithAttributes(ev...))
+			i.tokenRefreshes.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+		},
+	})
+
+	req = req.Clone(ctx)
+	i.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.next.RoundTrip(req)
+
+	span.SetAttributes(RetryCountKey.Int(retries))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		metricAttrs = append(metricAttrs, semconv.ErrorType(err))
+	} else {
+		status := resp.StatusCode
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		metricAttrs = append(metricAttrs, semconv.HTTPResponseStatusCode(status))
+		if id := requestID(resp); id != "" {
+			span.SetAttributes(RequestIDKey.String(id))
+		}
+		if status >= 400 {
+			span.SetStatus(codes.Error, http.StatusText(status))
+			metricAttrs = append(metricAttrs, semconv.ErrorTypeKey.String(strconv.Itoa(status)))
+		}
+	}
+
+	set := metric.WithAttributes(metricAttrs...)
+	i.duration.Record(ctx, time.Since(start).Seconds(), set)
+	i.requests.Add(ctx, 1, set)
+	if err != nil || resp.StatusCode >= 400 {
+		i.errors.Add(ctx, 1, set)
+	}
+
+	return resp, err
+}
+
+// requestID returns the SCM request ID of resp, from its X-Request-ID header
+// or else from the `_request_id` of its error body.
+func requestID(resp *http.Response) string {
+	if id := resp.Header.Get("X-Request-ID"); id != "" {
+		return id
+	}
+	if resp.StatusCode < 400 || resp.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if err != nil {
+		return ""
+	}
+
+	var e struct {
+		RequestID string `json:"_request_id"`
+	}
+	if json.Unmarshal(body, &e) != nil {
+		return ""
+	}
+	return e.RequestID
+}
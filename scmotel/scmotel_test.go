@@ -0,0 +1,175 @@
+package scmotel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"golang.org/x/oauth2"
+
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/scmotel"
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+type telemetry struct {
+	spans  *tracetest.SpanRecorder
+	reader *sdkmetric.ManualReader
+}
+
+func newTelemetry() (*telemetry, scm.Middleware) {
+	t := &telemetry{
+		spans:  tracetest.NewSpanRecorder(),
+		reader: sdkmetric.NewManualReader(),
+	}
+	mw := scmotel.Middleware(
+		scmotel.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(t.spans))),
+		scmotel.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(t.reader))),
+	)
+	return t, mw
+}
+
+// sum returns the total of the counter called name.
+func (tel *telemetry) sum(t *testing.T, name string) int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, tel.reader.Collect(context.Background(), &rm))
+	var ans int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				ans += dp.Value
+			}
+		}
+	}
+	return ans
+}
+
+func attr(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func events(span sdktrace.ReadOnlySpan, name string) int {
+	n := 0
+	for _, ev := range span.Events() {
+		if ev.Name == name {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMiddleware_APIClient(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+	tel, mw := newTelemetry()
+
+	c := &scm.Client{
+		AuthFile:             srv.ConfigFile(t),
+		SkipLoggingTransport: true,
+		Middleware:           []scm.Middleware{mw},
+	}
+	require.NoError(t, c.Setup())
+	ctx := context.Background()
+	require.NoError(t, c.RefreshJwt(ctx))
+
+	// Expire the JWT, so that the first request has to wait on a refresh.
+	c.JwtExpiresAt = time.Now()
+
+	client := scm.GetObjectsAPIClient(c).AddressesAPI
+	addr := objects.NewAddresses("", "web")
+	addr.SetFolder("Shared")
+	addr.SetIpNetmask("10.0.0.1/32")
+	_, _, err := client.CreateAddresses(ctx).Addresses(*addr).Execute()
+	require.NoError(t, err)
+
+	_, _, err = client.GetAddressesByID(ctx, "00000000-0000-0000-0000-000000000000").Execute()
+	require.Error(t, err)
+
+	spans := tel.spans.Ended()
+	require.Len(t, spans, 2)
+
+	create := spans[0]
+	assert.Equal(t, "AddressesAPIService.CreateAddresses", create.Name())
+	assert.Equal(t, "AddressesAPIService.CreateAddresses", attr(create, scmotel.OperationKey).AsString())
+	assert.Equal(t, int64(http.StatusCreated), attr(create, "http.response.status_code").AsInt64())
+	assert.NotEmpty(t, attr(create, scmotel.RequestIDKey).AsString())
+	assert.Equal(t, 1, events(create, scmotel.TokenRefreshEvent), "the first request waits on the JWT refresh")
+	assert.Equal(t, codes.Unset, create.Status().Code)
+
+	get := spans[1]
+	assert.Equal(t, "AddressesAPIService.GetAddressesByID", get.Name())
+	assert.Equal(t, int64(http.StatusNotFound), attr(get, "http.response.status_code").AsInt64())
+	assert.NotEmpty(t, attr(get, scmotel.RequestIDKey).AsString())
+	assert.Equal(t, 0, events(get, scmotel.TokenRefreshEvent))
+	assert.Equal(t, codes.Error, get.Status().Code)
+
+	assert.Equal(t, int64(2), tel.sum(t, "scm.client.requests"))
+	assert.Equal(t, int64(1), tel.sum(t, "scm.client.errors"))
+	assert.Equal(t, int64(1), tel.sum(t, "scm.client.token_refreshes"))
+}
+
+func TestMiddleware_DoRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "Bearer jwt", r.Header.Get("Authorization"))
+		w.Header().Set("X-Request-ID", "req-1")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer srv.Close()
+	tel, mw := newTelemetry()
+
+	c := &scm.Client{
+		Host:                 strings.TrimPrefix(srv.URL, "http://"),
+		Protocol:             "http",
+		TokenSource:          oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "jwt"}),
+		SkipLoggingTransport: true,
+		RetryPolicy:          scm.RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		Middleware:           []scm.Middleware{mw},
+	}
+	require.NoError(t, c.Setup())
+	require.NoError(t, c.RefreshJwt(context.Background()))
+
+	_, err := c.Do(context.Background(), http.MethodGet, "/config/objects/v1/addresses", nil, nil, nil)
+	require.NoError(t, err)
+
+	spans := tel.spans.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "AddressesAPIService.ListAddresses", span.Name())
+	assert.Equal(t, int64(2), attr(span, scmotel.RetryCountKey).AsInt64())
+	assert.Equal(t, 2, events(span, scmotel.RetryEvent))
+	assert.Equal(t, "req-1", attr(span, scmotel.RequestIDKey).AsString())
+	assert.Equal(t, int64(http.StatusOK), attr(span, "http.response.status_code").AsInt64())
+
+	assert.Equal(t, int64(1), tel.sum(t, "scm.client.requests"))
+	assert.Equal(t, int64(2), tel.sum(t, "scm.client.retries"))
+	assert.Equal(t, int64(0), tel.sum(t, "scm.client.errors"))
+}
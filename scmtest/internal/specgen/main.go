@@ -0,0 +1,172 @@
+// Command specgen builds the resource table of the scmtest package from the
+// OpenAPI specs bundled with the generated API clients.
+//
+// Usage:
+//
+//	go run ./internal/specgen -specs ../generated -out resources_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type spec struct {
+	Servers []struct {
+		URL string `yaml:"url"`
+	} `yaml:"servers"`
+	Paths      map[string]map[string]operation `yaml:"paths"`
+	Components struct {
+		Parameters map[string]parameter `yaml:"parameters"`
+	} `yaml:"components"`
+}
+
+type operation struct {
+	Parameters []parameter `yaml:"parameters"`
+	Responses  map[string]struct {
+		Content map[string]struct {
+			Schema schema `yaml:"schema"`
+		} `yaml:"content"`
+	} `yaml:"responses"`
+}
+
+type parameter struct {
+	Ref  string `yaml:"$ref"`
+	Name string `yaml:"name"`
+	In   string `yaml:"in"`
+}
+
+type schema struct {
+	Ref  string `yaml:"$ref"`
+	Type string `yaml:"type"`
+}
+
+type resource struct {
+	Service string
+	Base    string
+	Path    string
+	List    string
+	Create  bool
+	Get     bool
+	Update  bool
+	Delete  bool
+	Scoped  bool
+}
+
+func main() {
+	specs := flag.String("specs", "../generated", "directory holding the generated API clients")
+	out := flag.String("out", "resources_gen.go", "output file")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*specs, "*", "api", "openapi.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	slices.Sort(files)
+
+	var resources []resource
+	for _, file := range files {
+		r, err := load(file)
+		if err != nil {
+			log.Fatalf("%s: %s", file, err)
+		}
+		resources = append(resources, r...)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by specgen from generated/*/api/openapi.yaml. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package scmtest\n\n")
+	fmt.Fprintf(&b, "var resources = []resource{\n")
+	for _, r := range resources {
+		fmt.Fprintf(&b, "\t{Service: %q, Base: %q, Path: %q, List: %s, Create: %t, Get: %t, Update: %t, Delete: %t, Scoped: %t},\n",
+			r.Service, r.Base, r.Path, r.List, r.Create, r.Get, r.Update, r.Delete, r.Scoped)
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load returns the CRUD resources of the spec in file: every collection path
+// that also has an item path ending in "/{id}".
+func load(file string) ([]resource, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var s spec
+	if err = yaml.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	if len(s.Servers) == 0 {
+		return nil, fmt.Errorf("no servers")
+	}
+	u, err := url.Parse(s.Servers[0].URL)
+	if err != nil {
+		return nil, err
+	}
+
+	service := filepath.Base(filepath.Dir(filepath.Dir(file)))
+	var ans []resource
+	for path, ops := range s.Paths {
+		item, ok := s.Paths[path+"/{id}"]
+		if !ok || strings.Contains(path, "{") {
+			continue
+		}
+
+		r := resource{
+			Service: service,
+			Base:    u.Path,
+			Path:    path,
+			List:    "listNone",
+		}
+		if list, ok := ops["get"]; ok {
+			r.List = listStyle(list)
+			for _, p := range list.Parameters {
+				if p.Ref != "" {
+					p = s.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+				}
+				if p.In == "query" && (p.Name == "folder" || p.Name == "snippet" || p.Name == "device") {
+					r.Scoped = true
+				}
+			}
+		}
+		_, r.Create = ops["post"]
+		_, r.Get = item["get"]
+		_, r.Update = item["put"]
+		_, r.Delete = item["delete"]
+		ans = append(ans, r)
+	}
+
+	slices.SortFunc(ans, func(a, b resource) int { return strings.Compare(a.Path, b.Path) })
+	return ans, nil
+}
+
+// listStyle returns how the list operation returns its results.
+func listStyle(op operation) string {
+	for _, c := range op.Responses["200"].Content {
+		switch {
+		case strings.HasSuffix(c.Schema.Ref, "ListResponse"):
+			return "listPaged"
+		case c.Schema.Type == "array":
+			return "listArray"
+		}
+	}
+	return "listNone"
+}
@@ -0,0 +1,133 @@
+// Code generated by specgen from generated/*/api/openapi.yaml. DO NOT EDIT.
+
+package scmtest
+
+var resources = []resource{
+	{Service: "config_operations", Base: "/config/operations/v1", Path: "/jobs", List: listPaged, Create: false, Get: true, Update: false, Delete: false, Scoped: false},
+	{Service: "config_setup", Base: "/config/setup/v1", Path: "/folders", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: false},
+	{Service: "config_setup", Base: "/config/setup/v1", Path: "/labels", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: false},
+	{Service: "config_setup", Base: "/config/setup/v1", Path: "/snippet-audit-logs", List: listNone, Create: true, Get: true, Update: false, Delete: false, Scoped: false},
+	{Service: "config_setup", Base: "/config/setup/v1", Path: "/snippet-categories", List: listPaged, Create: false, Get: true, Update: false, Delete: true, Scoped: false},
+	{Service: "config_setup", Base: "/config/setup/v1", Path: "/snippets", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: false},
+	{Service: "config_setup", Base: "/config/setup/v1", Path: "/subscribed-tenants", List: listNone, Create: true, Get: true, Update: false, Delete: false, Scoped: false},
+	{Service: "config_setup", Base: "/config/setup/v1", Path: "/variables", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "deployment_services", Base: "/config/deployment/v1", Path: "/internal-dns-servers", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: false},
+	{Service: "deployment_services", Base: "/config/deployment/v1", Path: "/remote-networks", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "deployment_services", Base: "/config/deployment/v1", Path: "/service-connection-groups", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "deployment_services", Base: "/config/deployment/v1", Path: "/service-connections", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "deployment_services", Base: "/config/deployment/v1", Path: "/sites", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "deployment_services", Base: "/config/deployment/v1", Path: "/traffic-steering-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/authentication-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/content-id-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/device-redistribution-collector", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/general-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/management-interface", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/motd-banner-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/service-route", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/service-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/session-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/session-timeouts", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/tcp-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/update-schedule", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "device_settings", Base: "/config/device/v1", Path: "/vpn-settings", List: listArray, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/authentication-portals", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/authentication-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/authentication-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/authentication-sequences", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/certificate-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/certificates", List: listPaged, Create: true, Get: true, Update: false, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/kerberos-server-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/ldap-server-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/local-user-groups", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/local-users", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/mfa-servers", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/ocsp-responders", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/radius-server-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/saml-server-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/scep-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/tacacs-server-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "identity_services", Base: "/config/identity/v1", Path: "/tls-service-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/aggregate-interfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/auto-vpn-clusters", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: false},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/bgp-address-family-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/bgp-auth-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/bgp-filtering-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/bgp-redistribution-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/bgp-route-map-redistributions", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/bgp-route-maps", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/config-match-list", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/dhcp-interfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/dns-proxies", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/ethernet-interfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/globalprotect-match-list", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/hipmatch-match-list", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/ike-crypto-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/ike-gateways", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/interface-management-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/ipsec-crypto-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/ipsec-tunnels", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/iptag-match-list", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/layer2-subinterfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/layer3-subinterfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/link-tags", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/lldp-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/logical-routers", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/loopback-interfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/nat-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/ospf-auth-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/pbf-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/qos-policy-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/qos-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/route-access-lists", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/route-community-lists", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/route-path-access-lists", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/route-prefix-lists", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/sdwan-error-correction-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/sdwan-path-quality-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/sdwan-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/sdwan-saas-quality-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/sdwan-traffic-distribution-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/system-match-list", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/tunnel-interfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/userid-match-list", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/vlan-interfaces", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/zone-protection-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "network_services", Base: "/config/network/v1", Path: "/zones", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/address-groups", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/addresses", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/application-filters", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/application-groups", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/applications", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/dynamic-user-groups", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/external-dynamic-lists", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/hip-objects", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/hip-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/http-server-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/log-forwarding-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/regions", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/schedules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/service-groups", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/services", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/syslog-server-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "objects", Base: "/config/objects/v1", Path: "/tags", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/anti-spyware-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/anti-spyware-signatures", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/app-override-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/data-filtering-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/data-objects", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/decryption-exclusions", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/decryption-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/decryption-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/dns-security-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/dos-protection-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/dos-protection-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/file-blocking-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/http-header-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/profile-groups", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/security-rules", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/url-access-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/url-categories", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/vulnerability-protection-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/vulnerability-protection-signatures", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+	{Service: "security_services", Base: "/config/security/v1", Path: "/wildfire-anti-virus-profiles", List: listPaged, Create: true, Get: true, Update: true, Delete: true, Scoped: true},
+}
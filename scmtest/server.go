@@ -0,0 +1,625 @@
+// Package scmtest provides an in-memory SCM API server for offline tests.
+/*
+The server implements create, read, update, delete and list for every
+configuration resource described by the OpenAPI specs of the generated API
+clients (generated/<package>/api/openapi.yaml), along with a fake OAuth2 token
+endpoint:
+
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	client := &scm.Client{
+		Host:         srv.Host(),
+		Protocol:     "http",
+		AuthUrl:      srv.AuthURL(),
+		ClientId:     scmtest.ClientID,
+		ClientSecret: scmtest.ClientSecret,
+		Scope:        scmtest.Scope,
+	}
+
+or, in a test, with the same settings read from a config file:
+
+	client := &scm.Client{AuthFile: srv.ConfigFile(t)}
+
+Objects are kept per resource.  Like the real API, the server enforces
+folder/snippet/device scoping, rejects duplicate names in the same container
+with 409, refuses to delete objects still referenced by other objects of their
+container with 409 (following the references described by package resource),
+answers unknown IDs with 404, paginates lists with offset and limit, lists
+the objects created with a position query parameter (such as security rules)
+under that position only, objects without one being "pre" rules, and reports
+failures with the `_errors` envelope.  Every API request must carry a
+token issued by the token endpoint (or by Token), an unsigned JWT for the
+requested scope.
+*/
+package scmtest
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	scmresource "github.com/paloaltonetworks/scm-go/resource"
+)
+
+//go:generate go run ./internal/specgen -specs ../generated -out resources_gen.go
+
+// The credentials accepted by the token endpoint.
+const (
+	ClientID     = "scmtest-client-id"
+	ClientSecret = "scmtest-client-secret"
+	Scope        = "tsg_id:1234567890"
+)
+
+// AuthPath is the path of the fake OAuth2 token endpoint.
+const AuthPath = "/auth/v1/oauth2/access_token"
+
+// TokenLifetime is the lifetime of the tokens issued, in seconds.
+const TokenLifetime = 899
+
+// listStyle is how a resource's list operation returns its results.
+type listStyle int
+
+const (
+	// listNone means the resource cannot be listed.
+	listNone listStyle = iota
+	// listPaged returns a {data, offset, limit, total} envelope.
+	listPaged
+	// listArray returns a plain array.
+	listArray
+)
+
+// resource is a CRUD resource of the SCM API.
+type resource struct {
+	Service string
+	Base    string
+	Path    string
+	List    listStyle
+	Create  bool
+	Get     bool
+	Update  bool
+	Delete  bool
+	Scoped  bool
+}
+
+// scopeParams are the parameters naming the container of a scoped object.
+var scopeParams = []string{"folder", "snippet", "device"}
+
+// Server is an in-memory SCM API server.
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	resources     map[string]*resource
+	objects       map[string][]map[string]interface{}
+	positions     map[string]string
+	tokens        map[string]bool
+	tokenRequests int
+}
+
+// NewServer starts and returns a new Server.  The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		resources: make(map[string]*resource, len(resources)),
+		objects:   make(map[string][]map[string]interface{}),
+		positions: make(map[string]string),
+		tokens:    make(map[string]bool),
+	}
+	for i := range resources {
+		r := &resources[i]
+		s.resources[r.Base+r.Path] = r
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Host returns the host (and port) to configure as the client's Host.
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// AuthURL returns the URL of the token endpoint.
+func (s *Server) AuthURL() string {
+	return s.URL + AuthPath
+}
+
+// Token issues a new token for Scope, as the token endpoint would.
+func (s *Server) Token() string {
+	return s.issue(Scope)
+}
+
+// issue issues a new token for scope: an unsigned JWT carrying the claims of
+// the real ones.
+func (s *Server) issue(scope string) string {
+	now := time.Now()
+	claims := map[string]interface{}{
+		"sub":   ClientID,
+		"scope": scope,
+		"iat":   now.Unix(),
+		"exp":   now.Add(TokenLifetime * time.Second).Unix(),
+		"jti":   newID(),
+	}
+	for _, f := range strings.Fields(scope) {
+		if id, ok := strings.CutPrefix(f, "tsg_id:"); ok {
+			claims["tsg_id"] = id
+		}
+	}
+	payload, _ := json.Marshal(claims)
+	enc := base64.RawURLEncoding
+	token := enc.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + enc.EncodeToString(payload) + "."
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[token] = true
+	return token
+}
+
+// TokenRequests returns how many times the token endpoint was called.
+func (s *Server) TokenRequests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tokenRequests
+}
+
+// WriteConfig writes an scm-config.json file pointing at the server.
+func (s *Server) WriteConfig(path string) error {
+	b, err := json.MarshalIndent(map[string]interface{}{
+		"auth_url":      s.AuthURL(),
+		"host":          s.Host(),
+		"protocol":      "http",
+		"client_id":     ClientID,
+		"client_secret": ClientSecret,
+		"scope":         Scope,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0600)
+}
+
+// ConfigFile writes an scm-config.json file pointing at the server to a
+// temporary directory of t, and returns its path.
+func (s *Server) ConfigFile(t testing.TB) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "scm-config.json")
+	if err := s.WriteConfig(path); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// Add stores obj in the collection at path (such as
+// "/config/objects/v1/addresses") without any validation, and returns its ID.
+func (s *Server) Add(path string, obj map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj = clone(obj)
+	if _, ok := obj["id"].(string); !ok {
+		obj["id"] = newID()
+	}
+	s.objects[path] = append(s.objects[path], obj)
+	return obj["id"].(string)
+}
+
+// Objects returns a copy of the objects of the collection at path.
+func (s *Server) Objects(path string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ans := make([]map[string]interface{}, 0, len(s.objects[path]))
+	for _, obj := range s.objects[path] {
+		ans = append(ans, clone(obj))
+	}
+	return ans
+}
+
+// serveHTTP routes requests to the token endpoint and to the resources.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == AuthPath {
+		s.serveToken(w, r)
+		return
+	}
+
+	if !s.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "E016", "Not Authenticated", "missing or invalid bearer token")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if res, ok := s.resources[r.URL.Path]; ok {
+		switch {
+		case r.Method == http.MethodGet && res.List != listNone:
+			s.list(w, r, res)
+		case r.Method == http.MethodPost && res.Create:
+			s.create(w, r, res)
+		default:
+			methodNotSupported(w, r)
+		}
+		return
+	}
+
+	dir, id := path.Split(r.URL.Path)
+	if res, ok := s.resources[strings.TrimSuffix(dir, "/")]; ok && id != "" {
+		switch {
+		case r.Method == http.MethodGet && res.Get:
+			s.get(w, res, id)
+		case r.Method == http.MethodPut && res.Update:
+			s.update(w, r, res, id)
+		case r.Method == http.MethodDelete && res.Delete:
+			s.delete(w, res, id)
+		default:
+			methodNotSupported(w, r)
+		}
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "E005", "Object Not Present", fmt.Sprintf("no resource at %s", r.URL.Path))
+}
+
+// serveToken implements the client credentials grant of the token endpoint.
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.tokenRequests++
+	s.mu.Unlock()
+
+	authError := func(status int, code, desc string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error":             code,
+			"error_description": desc,
+		})
+	}
+
+	if r.Method != http.MethodPost {
+		authError(http.StatusMethodNotAllowed, "invalid_request", "POST required")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		authError(http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" {
+		authError(http.StatusBadRequest, "unsupported_grant_type", "only client_credentials is supported")
+		return
+	}
+
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		id, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if id != ClientID || secret != ClientSecret {
+		authError(http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": s.issue(r.PostForm.Get("scope")),
+		"scope":        r.PostForm.Get("scope"),
+		"token_type":   "Bearer",
+		"expires_in":   TokenLifetime,
+	})
+}
+
+// authenticated returns whether r carries a token issued by the server.
+func (s *Server) authenticated(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tokens[token]
+}
+
+// list implements the list operation of res.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, res *resource) {
+	q := r.URL.Query()
+
+	scope, value := "", ""
+	if res.Scoped {
+		var ok bool
+		if scope, value, ok = scopeOf(q.Get); !ok {
+			writeError(w, http.StatusBadRequest, "E003", "Missing Query Parameter: folder", "exactly one of folder, snippet or device is required")
+			return
+		}
+	}
+
+	offset, limit := 0, 200
+	for _, p := range []struct {
+		name string
+		v    *int
+	}{{"offset", &offset}, {"limit", &limit}} {
+		if v := q.Get(p.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				writeError(w, http.StatusBadRequest, "E003", "Invalid Query Parameter: "+p.name, fmt.Sprintf("%q is not a valid %s", v, p.name))
+				return
+			}
+			*p.v = n
+		}
+	}
+
+	data := []map[string]interface{}{}
+	for _, obj := range s.objects[r.URL.Path] {
+		if scope != "" && obj[scope] != value {
+			continue
+		}
+		if name := q.Get("name"); name != "" && obj["name"] != name {
+			continue
+		}
+		if position := q.Get("position"); position != "" && s.position(obj) != position {
+			continue
+		}
+		data = append(data, obj)
+	}
+
+	if res.List == listArray {
+		writeJSON(w, http.StatusOK, data)
+		return
+	}
+
+	total := len(data)
+	data = data[min(offset, total):min(offset+limit, total)]
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":   data,
+		"offset": offset,
+		"limit":  limit,
+		"total":  total,
+	})
+}
+
+// create implements the create operation of res.
+func (s *Server) create(w http.ResponseWriter, r *http.Request, res *resource) {
+	obj, ok := s.readObject(w, r, res, "")
+	if !ok {
+		return
+	}
+
+	obj["id"] = newID()
+	if position := r.URL.Query().Get("position"); position != "" {
+		s.positions[obj["id"].(string)] = position
+	}
+	s.objects[r.URL.Path] = append(s.objects[r.URL.Path], obj)
+	writeJSON(w, http.StatusCreated, obj)
+}
+
+// position returns the position of obj, "pre" unless it was created in
+// another one.
+func (s *Server) position(obj map[string]interface{}) string {
+	id, _ := obj["id"].(string)
+	if position, ok := s.positions[id]; ok {
+		return position
+	}
+	return "pre"
+}
+
+// get implements the get operation of res.
+func (s *Server) get(w http.ResponseWriter, res *resource, id string) {
+	i := s.find(res, id)
+	if i < 0 {
+		objectNotPresent(w, id)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.objects[res.Base+res.Path][i])
+}
+
+// update implements the update operation of res.
+func (s *Server) update(w http.ResponseWriter, r *http.Request, res *resource, id string) {
+	i := s.find(res, id)
+	if i < 0 {
+		objectNotPresent(w, id)
+		return
+	}
+
+	obj, ok := s.readObject(w, r, res, id)
+	if !ok {
+		return
+	}
+
+	obj["id"] = id
+	s.objects[res.Base+res.Path][i] = obj
+	writeJSON(w, http.StatusOK, obj)
+}
+
+// delete implements the delete operation of res.
+func (s *Server) delete(w http.ResponseWriter, res *resource, id string) {
+	i := s.find(res, id)
+	if i < 0 {
+		objectNotPresent(w, id)
+		return
+	}
+
+	key := res.Base + res.Path
+	obj := s.objects[key][i]
+	if n := s.referrers(key, obj); n != 0 {
+		name, _ := obj["name"].(string)
+		writeError(w, http.StatusConflict, "E018", "Reference Not Zero", fmt.Sprintf("%q is still referenced by %d object(s)", name, n))
+		return
+	}
+	s.objects[key] = append(s.objects[key][:i], s.objects[key][i+1:]...)
+	delete(s.positions, id)
+	writeJSON(w, http.StatusOK, obj)
+}
+
+// referrers returns the number of objects in the container of obj, an object
+// of the collection at path, that reference it as described by package
+// resource.
+func (s *Server) referrers(path string, obj map[string]interface{}) int {
+	var kind string
+	for _, k := range scmresource.All() {
+		if k.Path == path {
+			kind = k.Name
+		}
+	}
+	name, _ := obj["name"].(string)
+	if kind == "" || name == "" {
+		return 0
+	}
+
+	n := 0
+	for _, k := range scmresource.All() {
+		for _, other := range s.objects[k.Path] {
+			if !sameContainer(obj, other) {
+				continue
+			}
+			for _, ref := range k.References(other) {
+				if ref.Name == name && slices.Contains(ref.Kinds, kind) {
+					n++
+					break
+				}
+			}
+		}
+	}
+	return n
+}
+
+// sameContainer returns whether a and b are in the same folder, snippet or
+// device.
+func sameContainer(a, b map[string]interface{}) bool {
+	for _, p := range scopeParams {
+		if a[p] != b[p] {
+			return false
+		}
+	}
+	return true
+}
+
+// find returns the index of the object of res with the given ID, or -1.
+func (s *Server) find(res *resource, id string) int {
+	for i, obj := range s.objects[res.Base+res.Path] {
+		if obj["id"] == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// readObject decodes and validates the object in the body of r, which is
+// stored as the object with the given ID (empty for a new object).
+func (s *Server) readObject(w http.ResponseWriter, r *http.Request, res *resource, id string) (map[string]interface{}, bool) {
+	var obj map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil || obj == nil {
+		writeError(w, http.StatusBadRequest, "E003", "Invalid Object", "the request body must be a JSON object")
+		return nil, false
+	}
+
+	get := func(key string) string {
+		v, _ := obj[key].(string)
+		return v
+	}
+
+	scope, value := "", ""
+	if res.Scoped {
+		var ok bool
+		if scope, value, ok = scopeOf(get); !ok {
+			writeError(w, http.StatusBadRequest, "E003", "Invalid Object", "exactly one of folder, snippet or device is required")
+			return nil, false
+		}
+	}
+
+	if name := get("name"); name != "" {
+		for _, other := range s.objects[res.Base+res.Path] {
+			if other["id"] != id && other["name"] == name && (scope == "" || other[scope] == value) {
+				writeError(w, http.StatusConflict, "E006", "Name Not Unique", fmt.Sprintf("%q is already in use", name))
+				return nil, false
+			}
+		}
+	}
+
+	return obj, true
+}
+
+// scopeOf returns the container given by get, which must name exactly one of
+// folder, snippet or device.
+func scopeOf(get func(string) string) (string, string, bool) {
+	scope, value := "", ""
+	for _, p := range scopeParams {
+		if v := get(p); v != "" {
+			if scope != "" {
+				return "", "", false
+			}
+			scope, value = p, v
+		}
+	}
+	return scope, value, scope != ""
+}
+
+// objectNotPresent reports that there is no object with the given ID.
+func objectNotPresent(w http.ResponseWriter, id string) {
+	writeError(w, http.StatusNotFound, "E005", "Object Not Present", fmt.Sprintf("Failed to find obj-uuid %s", id))
+}
+
+// methodNotSupported reports that the method is not supported by the resource.
+func methodNotSupported(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotImplemented, "E012", "Method Not Supported", fmt.Sprintf("%s is not supported on %s", r.Method, r.URL.Path))
+}
+
+// writeError writes an error in the `_errors` envelope of the SCM API.
+func writeError(w http.ResponseWriter, status int, code, errorType, message string) {
+	w.Header().Set("X-Request-ID", newID())
+	writeJSON(w, status, map[string]interface{}{
+		"_errors": []map[string]interface{}{{
+			"code":    code,
+			"message": errorType,
+			"details": map[string]string{
+				"errorType": errorType,
+				"message":   message,
+			},
+		}},
+		"_request_id": w.Header().Get("X-Request-ID"),
+	})
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	if w.Header().Get("X-Request-ID") == "" {
+		w.Header().Set("X-Request-ID", newID())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// newID returns a random UUID.
+func newID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// clone returns a shallow copy of obj.
+func clone(obj map[string]interface{}) map[string]interface{} {
+	ans := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		ans[k] = v
+	}
+	return ans
+}
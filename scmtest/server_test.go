@@ -0,0 +1,208 @@
+package scmtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scm "github.com/paloaltonetworks/scm-go"
+	"github.com/paloaltonetworks/scm-go/api"
+	scmErrors "github.com/paloaltonetworks/scm-go/errors"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func newClient(t *testing.T, srv *scmtest.Server) *scm.Client {
+	t.Helper()
+
+	c := &scm.Client{AuthFile: srv.ConfigFile(t), SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	return c
+}
+
+func TestServer_CRUD(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := scm.GetObjectsAPIClient(newClient(t, srv)).AddressesAPI
+
+	addr := objects.NewAddresses("", "web")
+	addr.SetFolder("Shared")
+	addr.SetIpNetmask("10.0.0.1/32")
+	created, _, err := client.CreateAddresses(ctx).Addresses(*addr).Execute()
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Id)
+
+	got, _, err := client.GetAddressesByID(ctx, created.Id).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "web", got.Name)
+	assert.Equal(t, "10.0.0.1/32", got.GetIpNetmask())
+
+	got.SetDescription("updated")
+	updated, _, err := client.UpdateAddressesByID(ctx, created.Id).Addresses(*got).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "updated", updated.GetDescription())
+
+	_, err = client.DeleteAddressesByID(ctx, created.Id).Execute()
+	require.NoError(t, err)
+
+	_, _, err = client.GetAddressesByID(ctx, created.Id).Execute()
+	assert.True(t, scmErrors.IsObjectNotPresent(err), "%v", err)
+	_, err = client.DeleteAddressesByID(ctx, created.Id).Execute()
+	assert.True(t, scmErrors.IsNotFound(err), "%v", err)
+}
+
+func TestServer_NameUniqueness(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := scm.GetObjectsAPIClient(newClient(t, srv)).TagsAPI
+
+	tag := objects.NewTags("prod")
+	tag.SetFolder("Shared")
+	_, _, err := client.CreateTags(ctx).Tags(*tag).Execute()
+	require.NoError(t, err)
+
+	_, _, err = client.CreateTags(ctx).Tags(*tag).Execute()
+	assert.True(t, scmErrors.IsNameNotUnique(err), "%v", err)
+	var scmErr scmErrors.ScmError
+	require.ErrorAs(t, err, &scmErr)
+	assert.Equal(t, http.StatusConflict, scmErr.HTTPStatusCode())
+
+	// The same name is fine in another folder.
+	tag.SetFolder("Other")
+	_, _, err = client.CreateTags(ctx).Tags(*tag).Execute()
+	require.NoError(t, err)
+}
+
+func TestServer_ReferenceNotZero(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := scm.GetObjectsAPIClient(newClient(t, srv))
+
+	addr := srv.Add("/config/objects/v1/addresses", map[string]interface{}{"name": "web", "folder": "Shared"})
+	group := srv.Add("/config/objects/v1/address-groups", map[string]interface{}{"name": "servers", "folder": "Shared", "static": []interface{}{"web"}})
+	srv.Add("/config/objects/v1/address-groups", map[string]interface{}{"name": "other", "folder": "Other", "static": []interface{}{"web"}})
+
+	_, err := client.AddressesAPI.DeleteAddressesByID(ctx, addr).Execute()
+	assert.True(t, scmErrors.IsReferenceNotZero(err), "%v", err)
+
+	// Once the group referencing it in its folder is gone, it can be deleted.
+	_, err = client.AddressGroupsAPI.DeleteAddressGroupsByID(ctx, group).Execute()
+	require.NoError(t, err)
+	_, err = client.AddressesAPI.DeleteAddressesByID(ctx, addr).Execute()
+	require.NoError(t, err)
+}
+
+func TestServer_Scoping(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := scm.GetObjectsAPIClient(newClient(t, srv)).AddressesAPI
+
+	_, _, err := client.ListAddresses(ctx).Execute()
+	assert.True(t, scmErrors.IsMissingQueryParameter(err), "%v", err)
+
+	_, _, err = client.ListAddresses(ctx).Folder("Shared").Snippet("default").Execute()
+	assert.True(t, scmErrors.IsBadRequest(err), "%v", err)
+
+	_, _, err = client.CreateAddresses(ctx).Addresses(*objects.NewAddresses("", "no-folder")).Execute()
+	assert.True(t, scmErrors.IsInvalidObject(err), "%v", err)
+
+	srv.Add("/config/objects/v1/addresses", map[string]interface{}{"name": "a", "folder": "Shared"})
+	srv.Add("/config/objects/v1/addresses", map[string]interface{}{"name": "b", "snippet": "default"})
+	list, _, err := client.ListAddresses(ctx).Snippet("default").Execute()
+	require.NoError(t, err)
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, "b", list.Data[0].Name)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	for i := 0; i < 25; i++ {
+		srv.Add("/config/objects/v1/addresses", map[string]interface{}{
+			"name":   fmt.Sprintf("addr-%02d", i),
+			"folder": "Shared",
+		})
+	}
+
+	ctx := context.Background()
+	client := scm.GetObjectsAPIClient(newClient(t, srv)).AddressesAPI
+
+	page, _, err := client.ListAddresses(ctx).Folder("Shared").Offset(20).Limit(10).Execute()
+	require.NoError(t, err)
+	assert.Len(t, page.Data, 5)
+	assert.Equal(t, int32(25), page.GetTotal())
+
+	var names []string
+	for addr, err := range client.ListAddresses(ctx).Folder("Shared").All(api.PageOptions{PageSize: 10}) {
+		require.NoError(t, err)
+		names = append(names, addr.Name)
+	}
+	require.Len(t, names, 25)
+	assert.Equal(t, "addr-24", names[24])
+}
+
+func TestServer_Authentication(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	c := &scm.Client{
+		Host:                 srv.Host(),
+		Protocol:             "http",
+		AuthUrl:              srv.AuthURL(),
+		ClientId:             scmtest.ClientID,
+		ClientSecret:         "wrong",
+		Scope:                scmtest.Scope,
+		SkipLoggingTransport: true,
+	}
+	require.NoError(t, c.Setup())
+	assert.Error(t, c.RefreshJwt(context.Background()))
+
+	resp, err := http.Get(srv.URL + "/config/objects/v1/addresses?folder=Shared")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	var body struct {
+		Errors []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"_errors"`
+		RequestID string `json:"_request_id"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Errors, 1)
+	assert.Equal(t, "E016", body.Errors[0].Code)
+	assert.NotEmpty(t, body.RequestID)
+}
+
+func TestServer_WriteConfig(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "scm-config.json")
+	require.NoError(t, srv.WriteConfig(path))
+
+	c := &scm.Client{AuthFile: path, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	assert.Equal(t, 1, srv.TokenRequests())
+
+	_, err := c.Do(context.Background(), http.MethodGet, "/config/setup/v1/folders", nil, nil, nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,142 @@
+package scm
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/paloaltonetworks/scm-go/generated/config_operations"
+	"github.com/paloaltonetworks/scm-go/generated/config_setup"
+	"github.com/paloaltonetworks/scm-go/generated/deployment_services"
+	"github.com/paloaltonetworks/scm-go/generated/device_settings"
+	"github.com/paloaltonetworks/scm-go/generated/identity_services"
+	"github.com/paloaltonetworks/scm-go/generated/network_services"
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/generated/security_services"
+)
+
+/*
+SDK gives access to every SCM API family from a single configured Client.
+
+Unlike calling the Get*APIClient functions one by one, all of the API clients
+handed out by an SDK share one transport chain (JWT refresh, middleware and
+logging) and therefore one connection pool.  Each API client is only created
+the first time it is requested.
+
+	client := &scm.Client{AuthFile: "scm-config.json"}
+	if err := client.Setup(); err != nil {
+		return err
+	}
+	sdk := scm.NewSDK(client)
+
+	addrs, _, err := sdk.Objects().AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+
+The Client must have been Setup() before NewSDK is invoked.
+*/
+type SDK struct {
+	client     *Client
+	httpClient *http.Client
+
+	configOperationsOnce sync.Once
+	configOperations     *config_operations.APIClient
+
+	configSetupOnce sync.Once
+	configSetup     *config_setup.APIClient
+
+	deploymentServicesOnce sync.Once
+	deploymentServices     *deployment_services.APIClient
+
+	deviceSettingsOnce sync.Once
+	deviceSettings     *device_settings.APIClient
+
+	identityServicesOnce sync.Once
+	identityServices     *identity_services.APIClient
+
+	networkServicesOnce sync.Once
+	networkServices     *network_services.APIClient
+
+	objectsOnce sync.Once
+	objects     *objects.APIClient
+
+	securityServicesOnce sync.Once
+	securityServices     *security_services.APIClient
+}
+
+// NewSDK returns an SDK using the given client.
+//
+// The middleware wrap the shared transport, the first one being the outermost.
+func NewSDK(client *Client, middleware ...Middleware) *SDK {
+	return &SDK{
+		client:     client,
+		httpClient: newAPIHTTPClient(client, middleware...),
+	}
+}
+
+// Client returns the Client the SDK was built from.
+func (s *SDK) Client() *Client { return s.client }
+
+// HTTPClient returns the http.Client shared by all API clients.
+func (s *SDK) HTTPClient() *http.Client { return s.httpClient }
+
+// ConfigOperations returns the config_operations API client.
+func (s *SDK) ConfigOperations() *config_operations.APIClient {
+	s.configOperationsOnce.Do(func() {
+		s.configOperations = newAPIClient(s.client, s.httpClient, config_operations.NewConfiguration, config_operations.NewAPIClient)
+	})
+	return s.configOperations
+}
+
+// ConfigSetup returns the config_setup API client.
+func (s *SDK) ConfigSetup() *config_setup.APIClient {
+	s.configSetupOnce.Do(func() {
+		s.configSetup = newAPIClient(s.client, s.httpClient, config_setup.NewConfiguration, config_setup.NewAPIClient)
+	})
+	return s.configSetup
+}
+
+// DeploymentServices returns the deployment_services API client.
+func (s *SDK) DeploymentServices() *deployment_services.APIClient {
+	s.deploymentServicesOnce.Do(func() {
+		s.deploymentServices = newAPIClient(s.client, s.httpClient, deployment_services.NewConfiguration, deployment_services.NewAPIClient)
+	})
+	return s.deploymentServices
+}
+
+// DeviceSettings returns the device_settings API client.
+func (s *SDK) DeviceSettings() *device_settings.APIClient {
+	s.deviceSettingsOnce.Do(func() {
+		s.deviceSettings = newAPIClient(s.client, s.httpClient, device_settings.NewConfiguration, device_settings.NewAPIClient)
+	})
+	return s.deviceSettings
+}
+
+// IdentityServices returns the identity_services API client.
+func (s *SDK) IdentityServices() *identity_services.APIClient {
+	s.identityServicesOnce.Do(func() {
+		s.identityServices = newAPIClient(s.client, s.httpClient, identity_services.NewConfiguration, identity_services.NewAPIClient)
+	})
+	return s.identityServices
+}
+
+// NetworkServices returns the network_services API client.
+func (s *SDK) NetworkServices() *network_services.APIClient {
+	s.networkServicesOnce.Do(func() {
+		s.networkServices = newAPIClient(s.client, s.httpClient, network_services.NewConfiguration, network_services.NewAPIClient)
+	})
+	return s.networkServices
+}
+
+// Objects returns the objects API client.
+func (s *SDK) Objects() *objects.APIClient {
+	s.objectsOnce.Do(func() {
+		s.objects = newAPIClient(s.client, s.httpClient, objects.NewConfiguration, objects.NewAPIClient)
+	})
+	return s.objects
+}
+
+// SecurityServices returns the security_services API client.
+func (s *SDK) SecurityServices() *security_services.APIClient {
+	s.securityServicesOnce.Do(func() {
+		s.securityServices = newAPIClient(s.client, s.httpClient, security_services.NewConfiguration, security_services.NewAPIClient)
+	})
+	return s.securityServices
+}
@@ -0,0 +1,99 @@
+package scm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSDKClient(t *testing.T, server *httptest.Server) *Client {
+	t.Helper()
+
+	c := &Client{
+		ClientId:              "client-id",
+		ClientSecret:          "client-secret",
+		Scope:                 "tsg_id:1234567890",
+		Host:                  strings.TrimPrefix(server.URL, "https://"),
+		SkipVerifyCertificate: true,
+		SkipLoggingTransport:  true,
+		Jwt:                   "test-jwt",
+		JwtExpiresAt:          time.Now().Add(time.Hour),
+	}
+	require.NoError(t, c.Setup())
+	return c
+}
+
+func TestSDK_LazyClients(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	sdk := NewSDK(newTestSDKClient(t, server))
+
+	assert.Same(t, sdk.Objects(), sdk.Objects())
+	assert.Same(t, sdk.NetworkServices(), sdk.NetworkServices())
+	assert.Same(t, sdk.HTTPClient(), sdk.Objects().GetConfig().HTTPClient)
+	assert.Same(t, sdk.HTTPClient(), sdk.SecurityServices().GetConfig().HTTPClient)
+	assert.Same(t, sdk.HTTPClient(), sdk.ConfigOperations().GetConfig().HTTPClient)
+}
+
+func TestSDK_ConcurrentAccess(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	sdk := NewSDK(newTestSDKClient(t, server))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = sdk.Objects()
+			_ = sdk.IdentityServices()
+		}()
+	}
+	wg.Wait()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestSDK_SharedMiddleware(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.URL.Path+" "+r.Header.Get("Authorization")+" "+strings.Join(r.Header.Values("X-Order"), ","))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[],"limit":200,"offset":0,"total":0}`))
+	}))
+	defer server.Close()
+
+	mark := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req.Header.Add("X-Order", name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	sdk := NewSDK(newTestSDKClient(t, server), mark("first"), mark("second"))
+
+	ctx := context.Background()
+	_, _, err := sdk.Objects().AddressesAPI.ListAddresses(ctx).Execute()
+	require.NoError(t, err)
+	_, _, err = sdk.SecurityServices().AntiSpywareProfilesAPI.ListAntiSpywareProfiles(ctx).Execute()
+	require.NoError(t, err)
+
+	require.Len(t, seen, 2)
+	assert.Equal(t, "/config/objects/v1/addresses Bearer test-jwt first,second", seen[0])
+	assert.Equal(t, "/config/security/v1/anti-spyware-profiles Bearer test-jwt first,second", seen[1])
+}
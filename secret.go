@@ -0,0 +1,162 @@
+package scm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DefaultSecretTimeout is how long resolving the client secret may take when
+// Client.SecretTimeout is not set.
+const DefaultSecretTimeout = 10 * time.Second
+
+// SecretProvider resolves secret references, such as the path of a secret in
+// a vault.
+type SecretProvider interface {
+	Secret(ctx context.Context, ref string) (string, error)
+}
+
+// SecretProviderFunc is a function implementing SecretProvider.
+type SecretProviderFunc func(ctx context.Context, ref string) (string, error)
+
+// Secret calls f.
+func (f SecretProviderFunc) Secret(ctx context.Context, ref string) (string, error) {
+	return f(ctx, ref)
+}
+
+// envSecrets resolves "env:NAME" references to the value of the environment
+// variable NAME.
+var envSecrets = SecretProviderFunc(func(_ context.Context, name string) (string, error) {
+	val, ok := os.LookupEnv(name)
+	if !ok || val == "" {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return val, nil
+})
+
+// resolveClientSecret sets the client secret from the first source set of
+// ClientSecret, ClientSecretFile and ClientSecretCommand.  The sources are
+// ranked by origin: the fields come first, then the SCM_CLIENT_SECRET,
+// SCM_CLIENT_SECRET_FILE and SCM_CLIENT_SECRET_COMMAND environment variables,
+// then the JSON config file, so that a secret file given explicitly is never
+// overridden by a secret written in the config file.  A ClientSecret of the
+// form "scheme:ref" whose scheme is "env" or the one of a SecretProviders
+// entry is resolved by the provider.
+func (c *Client) resolveClientSecret(json_client *Client) error {
+	if c.ClientSecret == "" && c.ClientSecretFile == "" && len(c.ClientSecretCommand) == 0 {
+		env := func(name string) string {
+			if !c.CheckEnvironment {
+				return ""
+			}
+			return os.Getenv(name)
+		}
+		if val := env("SCM_CLIENT_SECRET"); val != "" {
+			c.ClientSecret = val
+		} else if val := env("SCM_CLIENT_SECRET_FILE"); val != "" {
+			c.ClientSecretFile = val
+		} else if val := env("SCM_CLIENT_SECRET_COMMAND"); val != "" {
+			c.ClientSecretCommand = strings.Fields(val)
+		} else {
+			c.ClientSecret = json_client.ClientSecret
+			c.ClientSecretFile = json_client.ClientSecretFile
+			c.ClientSecretCommand = json_client.ClientSecretCommand
+		}
+	}
+
+	timeout := c.SecretTimeout
+	if timeout <= 0 {
+		timeout = DefaultSecretTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	switch {
+	case c.ClientSecret != "":
+		scheme, ref, ok := strings.Cut(c.ClientSecret, ":")
+		provider := c.SecretProviders[scheme]
+		if provider == nil && scheme == "env" {
+			provider = envSecrets
+		}
+		if !ok || provider == nil {
+			return nil
+		}
+		secret, err := provider.Secret(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("resolving the %s client secret: %w", scheme, err)
+		}
+		c.ClientSecret = secret
+	case c.ClientSecretFile != "":
+		b, err := os.ReadFile(c.ClientSecretFile)
+		if err != nil {
+			return fmt.Errorf("reading the client secret: %w", err)
+		}
+		c.ClientSecret = strings.TrimSpace(string(b))
+	case len(c.ClientSecretCommand) != 0:
+		secret, err := runSecretCommand(ctx, c.ClientSecretCommand)
+		if err != nil {
+			return err
+		}
+		c.ClientSecret = secret
+	}
+
+	if c.ClientSecret == "" && c.TokenSource == nil {
+		return fmt.Errorf("ClientSecret must be specified")
+	}
+	return nil
+}
+
+// runSecretCommand runs command and returns its standard output, trimmed.
+// Only its standard error is ever part of the errors.
+func runSecretCommand(ctx context.Context, command []string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); errors.Is(ctxErr, context.DeadlineExceeded) {
+			err = ctxErr
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", fmt.Errorf("running the client secret command %s: %w", command[0], err)
+	}
+	secret := strings.TrimSpace(stdout.String())
+	if secret == "" {
+		return "", fmt.Errorf("the client secret command %s printed nothing", command[0])
+	}
+	return secret, nil
+}
+
+// UnmarshalJSON decodes the client's settings, such as the ones of the JSON
+// config file.  Unlike the other settings, the client secret and JWT are
+// only ever decoded, never encoded, so that they are never written back to a
+// config file or logged.
+func (c *Client) UnmarshalJSON(b []byte) error {
+	type client Client
+	var secrets struct {
+		ClientSecret *string `json:"client_secret"`
+		Jwt          *string `json:"jwt"`
+	}
+	if err := json.Unmarshal(b, (*client)(c)); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, &secrets); err != nil {
+		return err
+	}
+	if secrets.ClientSecret != nil {
+		c.ClientSecret = *secrets.ClientSecret
+	}
+	if secrets.Jwt != nil {
+		c.Jwt = *secrets.Jwt
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup_ClientSecretSources(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("from-file\n"), 0600))
+	t.Setenv("SCM_TEST_SECRET", "from-env")
+
+	vault := SecretProviderFunc(func(_ context.Context, ref string) (string, error) {
+		if ref != "secret/scm" {
+			return "", fmt.Errorf("no secret at %s", ref)
+		}
+		return "from-vault", nil
+	})
+
+	for _, tc := range []struct {
+		name   string
+		client *Client
+		want   string
+	}{
+		{"literal", &Client{ClientSecret: "literal"}, "literal"},
+		{"unknown scheme", &Client{ClientSecret: "pa:ss"}, "pa:ss"},
+		{"env", &Client{ClientSecret: "env:SCM_TEST_SECRET"}, "from-env"},
+		{"file", &Client{ClientSecretFile: secretFile}, "from-file"},
+		{"command", &Client{ClientSecretCommand: []string{"echo", "from-command"}}, "from-command"},
+		{"provider", &Client{ClientSecret: "vault:secret/scm", SecretProviders: map[string]SecretProvider{"vault": vault}}, "from-vault"},
+		{"precedence", &Client{ClientSecret: "literal", ClientSecretFile: secretFile, ClientSecretCommand: []string{"false"}}, "literal"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := tc.client
+			c.ClientId = "client-id"
+			c.Scope = "tsg_id:1234567890"
+			c.SkipLoggingTransport = true
+			require.NoError(t, c.Setup())
+			assert.Equal(t, tc.want, c.ClientSecret)
+		})
+	}
+}
+
+func TestSetup_ClientSecretConfig(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("from-file"), 0600))
+	authFile := writeConfig(t, `{
+		"client_id": "client-id",
+		"client_secret_file": "`+secretFile+`",
+		"scope": "tsg_id:1234567890",
+		"profiles": {
+			"ci": {"client_secret_command": ["echo", "from-command"]}
+		}
+	}`)
+
+	c := &Client{AuthFile: authFile, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "from-file", c.ClientSecret)
+
+	// The command of the profile replaces the file of the default profile.
+	c = &Client{AuthFile: authFile, Profile: "ci", SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "from-command", c.ClientSecret)
+
+	t.Setenv("SCM_CLIENT_SECRET", "env:SCM_TEST_SECRET")
+	t.Setenv("SCM_TEST_SECRET", "from-env")
+	c = &Client{AuthFile: authFile, CheckEnvironment: true, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "from-env", c.ClientSecret)
+}
+
+func TestSetup_ClientSecretOrigin(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("from-file"), 0600))
+	authFile := writeConfig(t, `{
+		"client_id": "client-id",
+		"client_secret": "from-config",
+		"scope": "tsg_id:1234567890",
+		"profiles": {
+			"ci": {"client_secret_file": "`+secretFile+`"}
+		}
+	}`)
+
+	// A secret file given explicitly wins over the secret of the config file.
+	c := &Client{AuthFile: authFile, ClientSecretFile: secretFile, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "from-file", c.ClientSecret)
+
+	// So does one given by the environment.
+	t.Setenv("SCM_CLIENT_SECRET_FILE", secretFile)
+	c = &Client{AuthFile: authFile, CheckEnvironment: true, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "from-file", c.ClientSecret)
+	t.Setenv("SCM_CLIENT_SECRET_FILE", "")
+
+	t.Setenv("SCM_CLIENT_SECRET_COMMAND", "echo from-command")
+	c = &Client{AuthFile: authFile, CheckEnvironment: true, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "from-command", c.ClientSecret)
+
+	// The profile's own secret file wins over the inherited secret.
+	c = &Client{AuthFile: authFile, Profile: "ci", SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, "from-file", c.ClientSecret)
+}
+
+func TestSetup_ClientSecretErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		client *Client
+		err    string
+	}{
+		{"missing", &Client{}, "ClientSecret must be specified"},
+		{"env", &Client{ClientSecret: "env:SCM_TEST_NOT_SET"}, "resolving the env client secret: environment variable SCM_TEST_NOT_SET is not set"},
+		{"file", &Client{ClientSecretFile: filepath.Join(t.TempDir(), "missing")}, "reading the client secret"},
+		{"command", &Client{ClientSecretCommand: []string{"sh", "-c", "echo s3cret; echo denied >&2; exit 1"}}, "running the client secret command sh: exit status 1: denied"},
+		{"empty command", &Client{ClientSecretCommand: []string{"true"}}, "the client secret command true printed nothing"},
+		{"timeout", &Client{ClientSecretCommand: []string{"sleep", "5"}, SecretTimeout: 50 * time.Millisecond}, "running the client secret command sleep: context deadline exceeded"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := tc.client
+			c.ClientId = "client-id"
+			c.Scope = "tsg_id:1234567890"
+			err := c.Setup()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+			assert.NotContains(t, err.Error(), "s3cret")
+		})
+	}
+}
+
+func TestClient_MarshalJSON(t *testing.T) {
+	c := &Client{
+		ClientId:             "client-id",
+		ClientSecret:         "s3cret",
+		Scope:                "tsg_id:1234567890",
+		SkipLoggingTransport: true,
+	}
+	require.NoError(t, c.Setup())
+	c.Jwt = "header.payload.signature"
+
+	b, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "s3cret")
+	assert.NotContains(t, string(b), "client_secret\"")
+	assert.NotContains(t, string(b), "jwt\"")
+	assert.Contains(t, string(b), `"client_id":"client-id"`)
+	assert.Equal(t, "s3cret", c.ClientSecret)
+
+	// The settings read back, without the secret.
+	var back Client
+	require.NoError(t, json.Unmarshal(b, &back))
+	assert.Equal(t, c.ClientId, back.ClientId)
+	assert.Equal(t, c.Scope, back.Scope)
+	assert.Empty(t, back.ClientSecret)
+
+	// Neither are values, map values not being addressable.
+	b, err = json.Marshal(map[string]Client{"c": {ClientId: "client-id", ClientSecret: "s3cret", Jwt: "header.payload.signature"}})
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"client_id":"client-id"`)
+	assert.NotContains(t, string(b), "s3cret")
+	assert.NotContains(t, string(b), "signature")
+}
+
+func TestClient_UnmarshalJSON(t *testing.T) {
+	var c Client
+	require.NoError(t, json.Unmarshal([]byte(`{"client_id": "client-id", "client_secret": "s3cret", "jwt": "header.payload.signature"}`), &c))
+	assert.Equal(t, "client-id", c.ClientId)
+	assert.Equal(t, "s3cret", c.ClientSecret)
+	assert.Equal(t, "header.payload.signature", c.Jwt)
+
+	// Secrets missing from the JSON are kept.
+	require.NoError(t, json.Unmarshal([]byte(`{"scope": "tsg_id:1234567890"}`), &c))
+	assert.Equal(t, "s3cret", c.ClientSecret)
+	assert.Equal(t, "tsg_id:1234567890", c.Scope)
+}
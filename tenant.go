@@ -0,0 +1,218 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTenantConcurrency is the number of tenants operated on at once when
+// TenantOptions.Concurrency is not set.
+const DefaultTenantConcurrency = 8
+
+// TenantFunc operates on one tenant, identified by its TSG ID, through the
+// tenant's SDK.
+type TenantFunc func(ctx context.Context, tsgId string, sdk *SDK) error
+
+// TenantOptions are the options of a TenantManager.
+type TenantOptions struct {
+	// Concurrency is the number of tenants operated on at once, across all
+	// the calls to Do and ForEach.
+	Concurrency int
+
+	// Middleware wrap the transport of every tenant's API clients, the
+	// first one being the outermost.
+	Middleware []Middleware
+}
+
+/*
+TenantManager hands out an SDK per tenant, for service accounts managing
+several tenant service groups (TSGs).
+
+The client of each tenant is created the first time the tenant is used, from
+the settings of the manager's Client with a "tsg_id:<id>" Scope.  All of
+them share the Client's Transport, and so one connection pool, but each one
+requests, caches and refreshes its own JWT, and has its own rate limiter
+built from the Client's RateLimit, if it has one.  Any other setting, such as
+SecretProviders or Middleware, is the Client's.
+
+	client := &scm.Client{AuthFile: "scm-config.json"}
+	if err := client.Setup(); err != nil {
+		return err
+	}
+	tenants := scm.NewTenantManager(client, scm.TenantOptions{Concurrency: 16})
+
+	report := tenants.ForEach(ctx, tsgIds, func(ctx context.Context, tsgId string, sdk *scm.SDK) error {
+		_, _, err := sdk.Objects().TagsAPI.CreateTags(ctx).Tags(*tag).Execute()
+		return err
+	})
+	for _, f := range report.Failures() {
+		log.Printf("tenant %s: %v", f.Item, f.Err)
+	}
+
+The Client must have been Setup() before NewTenantManager is invoked, with a
+ClientId and ClientSecret: a TokenSource only ever gives the JWTs of one
+tenant.
+*/
+type TenantManager struct {
+	client *Client
+	opts   TenantOptions
+	slots  chan struct{}
+
+	mu      sync.Mutex
+	tenants map[string]*SDK
+}
+
+// NewTenantManager returns a TenantManager creating the tenants' clients from
+// client.
+func NewTenantManager(client *Client, opts TenantOptions) *TenantManager {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultTenantConcurrency
+	}
+	return &TenantManager{
+		client:  client,
+		opts:    opts,
+		slots:   make(chan struct{}, opts.Concurrency),
+		tenants: make(map[string]*SDK),
+	}
+}
+
+// SDK returns the SDK of the tenant, creating it if needed.  The TSG ID may
+// be given as a scope, such as "tsg_id:1234567890".
+//
+// Unlike Do and ForEach, SDK does not obtain the tenant's first JWT: call
+// RefreshJwt on the SDK's Client before using it.
+func (m *TenantManager) SDK(tsgId string) (*SDK, error) {
+	tsgId, err := m.tsgId(tsgId)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sdk, ok := m.tenants[tsgId]
+	if !ok {
+		sdk = NewSDK(m.newClient(tsgId), m.opts.Middleware...)
+		m.tenants[tsgId] = sdk
+	}
+	return sdk, nil
+}
+
+// Tenants returns the TSG IDs of the tenants created so far, sorted.
+func (m *TenantManager) Tenants() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ans := make([]string, 0, len(m.tenants))
+	for tsgId := range m.tenants {
+		ans = append(ans, tsgId)
+	}
+	slices.Sort(ans)
+	return ans
+}
+
+// tenantSlotKey is the context key marking the calls of a TenantManager's
+// TenantFunc, which hold one of its slots.
+type tenantSlotKey struct {
+	m *TenantManager
+}
+
+// Do invokes fn on the tenant, once fewer than Concurrency tenants are
+// being operated on and the tenant has a JWT.  It fails without invoking fn
+// if ctx is done first.
+//
+// The calls of Do and ForEach made by fn with the context it is given use
+// the slot of fn rather than waiting for another one, which could never be
+// freed once all of them are held by such functions.
+func (m *TenantManager) Do(ctx context.Context, tsgId string, fn TenantFunc) error {
+	sdk, err := m.SDK(tsgId)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Value(tenantSlotKey{m}) == nil {
+		select {
+		case m.slots <- struct{}{}:
+			defer func() { <-m.slots }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		ctx = context.WithValue(ctx, tenantSlotKey{m}, true)
+	}
+
+	c := sdk.Client()
+	if jwt, _ := c.jwt(); jwt == "" {
+		if err := c.RefreshJwt(ctx); err != nil {
+			return err
+		}
+	}
+	return fn(ctx, c.tenant(), sdk)
+}
+
+// ForEach invokes fn on each of the tenants, Concurrency of them at once,
+// and reports the result of each tenant rather than stopping at the first
+// failure.  The Item of each result is the TSG ID of its tenant.
+//
+// Use Tenants to operate on all the tenants created so far.
+func (m *TenantManager) ForEach(ctx context.Context, tsgIds []string, fn TenantFunc) *BulkReport[string] {
+	return BulkApply(ctx, tsgIds, func(ctx context.Context, tsgId string) (*http.Response, error) {
+		return nil, m.Do(ctx, tsgId, fn)
+	}, BulkOptions[string]{Concurrency: m.opts.Concurrency})
+}
+
+// tsgId returns the TSG ID given as is or as a scope.
+func (m *TenantManager) tsgId(s string) (string, error) {
+	if m.client.ClientId == "" || m.client.ClientSecret == "" {
+		return "", fmt.Errorf("TenantManager requires a Client with a ClientId and ClientSecret")
+	}
+	id := strings.TrimPrefix(strings.TrimSpace(s), "tsg_id:")
+	if id == "" || strings.ContainsAny(id, " \t:") {
+		return "", fmt.Errorf("invalid TSG ID %q", s)
+	}
+	return id, nil
+}
+
+// tenant returns the TSG the client works on, as given by its scope.
+func (c *Client) tenant() string {
+	for _, s := range strings.Fields(c.Scope) {
+		if id, ok := strings.CutPrefix(s, "tsg_id:"); ok {
+			return id
+		}
+	}
+	return c.Scope
+}
+
+// newClient returns the client of the tenant: a copy of the manager's
+// client, with the tenant's Scope, no JWT or TokenSource, and its own
+// HttpClient and rate limiter.
+func (m *TenantManager) newClient(tsgId string) *Client {
+	b := m.client
+	c := &Client{}
+
+	// The unexported fields hold the state of b, such as its JWT refresh.
+	src, dst := reflect.ValueOf(b).Elem(), reflect.ValueOf(c).Elem()
+	for i := 0; i < src.NumField(); i++ {
+		if src.Type().Field(i).IsExported() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+	c.Scope = "tsg_id:" + tsgId
+	c.TokenSource = nil
+	c.Jwt, c.JwtExpiresAt, c.JwtLifetime = "", time.Time{}, 0
+	if c.RateLimit != nil {
+		c.RateLimiter = nil
+	}
+
+	c.setupHttpClient()
+	if b.HttpClient != nil {
+		c.HttpClient.Timeout = b.HttpClient.Timeout
+	}
+	c.apiHttpClient = newAPIHTTPClient(c)
+	return c
+}
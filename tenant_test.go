@@ -0,0 +1,166 @@
+package scm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func TestTenantManager(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	base := newLoggingTestClient(t, srv, &Client{
+		SkipLoggingTransport: true,
+		SecretTimeout:        time.Second,
+		SecretProviders:      map[string]SecretProvider{"vault": SecretProviderFunc(nil)},
+	})
+	m := NewTenantManager(base, TenantOptions{Concurrency: 2})
+
+	var mu sync.Mutex
+	jwts := make(map[string]string)
+	report := m.ForEach(context.Background(), []string{"1", "2", "tsg_id:3", "4"}, func(ctx context.Context, tsgId string, sdk *SDK) error {
+		if tsgId == "3" {
+			return errors.New("tenant suspended")
+		}
+		_, _, err := sdk.Objects().AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+		mu.Lock()
+		defer mu.Unlock()
+		jwts[tsgId], _ = sdk.Client().jwt()
+		return err
+	})
+	assert.Equal(t, 3, report.Succeeded())
+	require.Len(t, report.Failures(), 1)
+	assert.Equal(t, "tsg_id:3", report.Failures()[0].Item)
+	assert.EqualError(t, report.Err(), "item 2: tenant suspended")
+
+	// Each tenant has its own client and JWT, on the shared transport.
+	assert.Equal(t, []string{"1", "2", "3", "4"}, m.Tenants())
+	assert.Equal(t, 4, srv.TokenRequests())
+	assert.Len(t, jwts, 3)
+	assert.NotEqual(t, jwts["1"], jwts["2"])
+
+	sdk, err := m.SDK("tsg_id:1")
+	require.NoError(t, err)
+	again, err := m.SDK("1")
+	require.NoError(t, err)
+	assert.Same(t, sdk, again)
+	assert.Equal(t, "tsg_id:1", sdk.Client().Scope)
+	assert.Same(t, base.Transport, sdk.Client().Transport)
+	assert.Equal(t, time.Second, sdk.Client().SecretTimeout)
+	assert.Contains(t, sdk.Client().SecretProviders, "vault")
+	assert.Empty(t, base.Jwt)
+
+	// The JWTs are reused.
+	require.NoError(t, m.Do(context.Background(), "2", func(ctx context.Context, tsgId string, sdk *SDK) error {
+		_, _, err := sdk.Objects().AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+		return err
+	}))
+	assert.Equal(t, 4, srv.TokenRequests())
+}
+
+func TestTenantManager_Concurrency(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	base := newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true})
+	m := NewTenantManager(base, TenantOptions{Concurrency: 3})
+
+	var active, most atomic.Int32
+	op := func(ctx context.Context, tsgId string, sdk *SDK) error {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			if m := most.Load(); n <= m || most.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+
+	// The bound holds across calls.
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report := m.ForEach(context.Background(), []string{"1", "2", "3", "4", "5", "6"}, op)
+			assert.NoError(t, report.Err())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(3), most.Load())
+
+	// Waiting for a slot stops with the context.
+	block := make(chan struct{})
+	for range 3 {
+		go m.Do(context.Background(), "1", func(ctx context.Context, tsgId string, sdk *SDK) error {
+			<-block
+			return nil
+		})
+	}
+	defer close(block)
+	for len(m.slots) != 3 {
+		time.Sleep(time.Millisecond)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := m.Do(ctx, "2", op)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTenantManager_Nested(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	m := NewTenantManager(newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true}), TenantOptions{Concurrency: 1})
+
+	// fn holds the only slot, which the nested calls use.
+	var tenants []string
+	err := m.Do(context.Background(), "1", func(ctx context.Context, tsgId string, sdk *SDK) error {
+		tenants = append(tenants, tsgId)
+		if err := m.Do(ctx, "2", func(ctx context.Context, tsgId string, sdk *SDK) error {
+			tenants = append(tenants, tsgId)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return m.ForEach(ctx, []string{"3"}, func(ctx context.Context, tsgId string, sdk *SDK) error {
+			tenants = append(tenants, tsgId)
+			return nil
+		}).Err()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, tenants)
+	assert.Empty(t, m.slots)
+}
+
+func TestTenantManager_Errors(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	m := NewTenantManager(newLoggingTestClient(t, srv, &Client{SkipLoggingTransport: true}), TenantOptions{})
+	for _, id := range []string{"", "tsg_id:", "tsg_id:1 tsg_id:2"} {
+		_, err := m.SDK(id)
+		assert.ErrorContains(t, err, "invalid TSG ID", id)
+	}
+
+	c := &Client{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "jwt"})}
+	require.NoError(t, c.Setup())
+	m = NewTenantManager(c, TenantOptions{})
+	err := m.Do(context.Background(), "1", func(ctx context.Context, tsgId string, sdk *SDK) error {
+		t.Error("invoked")
+		return nil
+	})
+	assert.EqualError(t, err, "TenantManager requires a Client with a ClientId and ClientSecret")
+}
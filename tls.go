@@ -0,0 +1,226 @@
+package scm
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+)
+
+// tlsVersions are the values of MinTlsVersion.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// resolveTransportSettings resolves the TLS and proxy settings from their
+// field, the environment and the JSON config file, in this order.
+func (c *Client) resolveTransportSettings(json_client *Client) error {
+	c.resolveString(&c.CaFile, "SCM_CA_FILE", json_client.CaFile)
+	c.resolveString(&c.CaPem, "SCM_CA_PEM", json_client.CaPem)
+	c.resolveString(&c.ClientCertFile, "SCM_CLIENT_CERT_FILE", json_client.ClientCertFile)
+	c.resolveString(&c.ClientKeyFile, "SCM_CLIENT_KEY_FILE", json_client.ClientKeyFile)
+	c.resolveString(&c.MinTlsVersion, "SCM_MIN_TLS_VERSION", json_client.MinTlsVersion)
+	c.resolveString(&c.ProxyUrl, "SCM_PROXY_URL", json_client.ProxyUrl)
+	c.resolveString(&c.NoProxy, "SCM_NO_PROXY", json_client.NoProxy)
+
+	if len(c.CipherSuites) == 0 {
+		if val := os.Getenv("SCM_CIPHER_SUITES"); c.CheckEnvironment && val != "" {
+			c.CipherSuites = splitList(val)
+		} else if len(json_client.CipherSuites) != 0 {
+			c.CipherSuites = json_client.CipherSuites
+		}
+	}
+
+	if (c.ClientCertFile == "") != (c.ClientKeyFile == "") {
+		return fmt.Errorf("ClientCertFile and ClientKeyFile must be specified together")
+	}
+	return nil
+}
+
+// resolveString sets *field, if empty, to the value of the environment
+// variable env, if CheckEnvironment is set, or else to fromFile.
+func (c *Client) resolveString(field *string, env, fromFile string) {
+	if *field != "" {
+		return
+	}
+	if val := os.Getenv(env); c.CheckEnvironment && val != "" {
+		*field = val
+	} else {
+		*field = fromFile
+	}
+}
+
+// newTransport returns the transport of the auth and API requests, honoring
+// the TLS and proxy settings.
+func (c *Client) newTransport() (*http.Transport, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := c.proxy()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Transport{
+		Proxy:           proxy,
+		TLSClientConfig: tlsConfig,
+	}, nil
+}
+
+// tlsConfig returns the TLS configuration of the transport.
+func (c *Client) tlsConfig() (*tls.Config, error) {
+	ans := &tls.Config{
+		InsecureSkipVerify: c.SkipVerifyCertificate,
+	}
+
+	// CA certificates, trusted along with the system ones.
+	if c.CaFile != "" || c.CaPem != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if c.CaFile != "" {
+			b, err := os.ReadFile(c.CaFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading CaFile: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(b) {
+				return nil, fmt.Errorf("no PEM certificates found in CaFile %s", c.CaFile)
+			}
+		}
+		if c.CaPem != "" && !pool.AppendCertsFromPEM([]byte(c.CaPem)) {
+			return nil, fmt.Errorf("no PEM certificates found in CaPem")
+		}
+		ans.RootCAs = pool
+	}
+
+	// Client certificate.
+	if c.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading the client certificate: %w", err)
+		}
+		ans.Certificates = []tls.Certificate{cert}
+	}
+
+	// Protocol policy.
+	if c.MinTlsVersion != "" {
+		v, ok := tlsVersions[strings.TrimPrefix(strings.ToUpper(c.MinTlsVersion), "TLS")]
+		if !ok {
+			return nil, fmt.Errorf("MinTlsVersion should be one of 1.0, 1.1, 1.2 or 1.3, not %q", c.MinTlsVersion)
+		}
+		ans.MinVersion = v
+	}
+	suites := tls.CipherSuites()
+	for _, name := range c.CipherSuites {
+		i := slices.IndexFunc(suites, func(s *tls.CipherSuite) bool { return s.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("unknown or insecure cipher suite %q", name)
+		}
+		ans.CipherSuites = append(ans.CipherSuites, suites[i].ID)
+	}
+
+	return ans, nil
+}
+
+// proxy returns the proxy function of the transport: requests go through
+// ProxyUrl, or the proxy of the environment if not set, unless their host
+// matches NoProxy.
+func (c *Client) proxy() (func(*http.Request) (*url.URL, error), error) {
+	proxy := http.ProxyFromEnvironment
+	if c.ProxyUrl != "" {
+		raw := c.ProxyUrl
+		if !strings.Contains(raw, "://") {
+			raw = "http://" + raw
+		}
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ProxyUrl: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("ProxyUrl should be an http, https or socks5 URL, not %q", c.ProxyUrl)
+		}
+		proxy = http.ProxyURL(u)
+	}
+	if c.NoProxy == "" {
+		return proxy, nil
+	}
+
+	bypass := splitList(c.NoProxy)
+	return func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(bypass, req.URL) {
+			return nil, nil
+		}
+		return proxy(req)
+	}, nil
+}
+
+// bypassProxy returns whether u matches one of the NO_PROXY style patterns:
+// "*", an IP address, a CIDR range, or a domain name matching itself and its
+// subdomains, or only its subdomains with a leading ".", each optionally
+// with a port.
+func bypassProxy(patterns []string, u *url.URL) bool {
+	host, port := u.Hostname(), u.Port()
+	if port == "" {
+		port = map[string]string{"http": "80", "https": "443"}[u.Scheme]
+	}
+	ip := net.ParseIP(host)
+	for _, p := range patterns {
+		if p == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(p); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		pHost, pPort := p, ""
+		if h, pp, err := net.SplitHostPort(p); err == nil {
+			pHost, pPort = h, pp
+		}
+		if pPort != "" && pPort != port {
+			continue
+		}
+		if pIP := net.ParseIP(pHost); pIP != nil {
+			if ip != nil && pIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+
+		pHost = strings.ToLower(pHost)
+		host := strings.ToLower(host)
+		if strings.HasPrefix(pHost, ".") {
+			if strings.HasSuffix(host, pHost) {
+				return true
+			}
+		} else if host == pHost || strings.HasSuffix(host, "."+pHost) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitList splits the comma separated list s, dropping empty entries.
+func splitList(s string) []string {
+	var ans []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			ans = append(ans, v)
+		}
+	}
+	return ans
+}
@@ -0,0 +1,217 @@
+package scm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+// writeClientCert writes a self-signed client certificate and its key to
+// dir, returning their paths and the certificate.
+func writeClientCert(t *testing.T, dir string) (string, string, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "automation"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile, cert
+}
+
+func TestSetup_MutualTLS(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	certFile, keyFile, cert := writeClientCert(t, t.TempDir())
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+
+	tlsSrv := httptest.NewUnstartedServer(srv.Config.Handler)
+	tlsSrv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	tlsSrv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	tlsSrv.StartTLS()
+	defer tlsSrv.Close()
+	caPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsSrv.Certificate().Raw}))
+
+	c := &Client{
+		AuthUrl:              tlsSrv.URL + scmtest.AuthPath,
+		Host:                 strings.TrimPrefix(tlsSrv.URL, "https://"),
+		ClientId:             scmtest.ClientID,
+		ClientSecret:         scmtest.ClientSecret,
+		Scope:                scmtest.Scope,
+		CaPem:                caPem,
+		ClientCertFile:       certFile,
+		ClientKeyFile:        keyFile,
+		MinTlsVersion:        "1.2",
+		SkipLoggingTransport: true,
+	}
+	require.NoError(t, c.Setup())
+	ctx := context.Background()
+	require.NoError(t, c.RefreshJwt(ctx))
+	_, _, err := GetObjectsAPIClient(c).AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+	require.NoError(t, err)
+
+	// The server requires the client certificate, and is only trusted with
+	// the CA certificate.
+	noCert := &Client{ClientId: "client-id", ClientSecret: "client-secret", Scope: scmtest.Scope, CaPem: caPem}
+	require.NoError(t, noCert.Setup())
+	_, err = (&http.Client{Transport: noCert.Transport}).Get(tlsSrv.URL)
+	assert.Error(t, err)
+
+	noCA := &Client{ClientId: "client-id", ClientSecret: "client-secret", Scope: scmtest.Scope, ClientCertFile: certFile, ClientKeyFile: keyFile}
+	require.NoError(t, noCA.Setup())
+	_, err = (&http.Client{Transport: noCA.Transport}).Get(tlsSrv.URL)
+	assert.ErrorContains(t, err, "certificate")
+}
+
+func TestSetup_TLSSettings(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+
+	authFile := writeConfig(t, `{
+		"client_id": "client-id",
+		"client_secret": "client-secret",
+		"scope": "tsg_id:1234567890",
+		"ca_file": "`+caFile+`",
+		"min_tls_version": "1.3",
+		"cipher_suites": ["TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"]
+	}`)
+	t.Setenv("SCM_CIPHER_SUITES", "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384")
+
+	c := &Client{AuthFile: authFile, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, caFile, c.CaFile)
+	cfg := c.Transport.TLSClientConfig
+	assert.Equal(t, uint16(tls.VersionTLS13), cfg.MinVersion)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}, cfg.CipherSuites)
+	resp, err := (&http.Client{Transport: c.Transport}).Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	c = &Client{AuthFile: authFile, CheckEnvironment: true, SkipLoggingTransport: true}
+	require.NoError(t, c.Setup())
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}, c.Transport.TLSClientConfig.CipherSuites)
+}
+
+func TestSetup_TLSErrors(t *testing.T) {
+	dir := t.TempDir()
+	certFile, _, _ := writeClientCert(t, dir)
+	notPem := filepath.Join(dir, "not.pem")
+	require.NoError(t, os.WriteFile(notPem, []byte("not a certificate"), 0600))
+
+	for _, tc := range []struct {
+		name   string
+		client *Client
+		err    string
+	}{
+		{"ca file", &Client{CaFile: filepath.Join(dir, "missing.pem")}, "reading CaFile"},
+		{"ca file content", &Client{CaFile: notPem}, "no PEM certificates found in CaFile"},
+		{"ca pem", &Client{CaPem: "not a certificate"}, "no PEM certificates found in CaPem"},
+		{"cert without key", &Client{ClientCertFile: certFile}, "ClientCertFile and ClientKeyFile must be specified together"},
+		{"key pair", &Client{ClientCertFile: certFile, ClientKeyFile: notPem}, "loading the client certificate"},
+		{"tls version", &Client{MinTlsVersion: "1.4"}, `MinTlsVersion should be one of 1.0, 1.1, 1.2 or 1.3, not "1.4"`},
+		{"insecure cipher suite", &Client{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}}, `unknown or insecure cipher suite "TLS_RSA_WITH_RC4_128_SHA"`},
+		{"proxy", &Client{ProxyUrl: "ftp://proxy.example.com"}, `ProxyUrl should be an http, https or socks5 URL, not "ftp://proxy.example.com"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := tc.client
+			c.ClientId = "client-id"
+			c.ClientSecret = "client-secret"
+			c.Scope = "tsg_id:1234567890"
+			assert.ErrorContains(t, c.Setup(), tc.err)
+		})
+	}
+}
+
+func TestSetup_Proxy(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	// The proxy serves the requests itself, as the scmtest server would.
+	var proxied atomic.Int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied.Add(1)
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	c := &Client{
+		AuthUrl:              "http://auth.example.com" + scmtest.AuthPath,
+		Host:                 "api.example.com",
+		Protocol:             "http",
+		ClientId:             scmtest.ClientID,
+		ClientSecret:         scmtest.ClientSecret,
+		Scope:                scmtest.Scope,
+		ProxyUrl:             strings.TrimPrefix(proxy.URL, "http://"),
+		NoProxy:              "internal.example.com, .corp.example.com, 10.0.0.0/8, 192.168.1.1, build.example.com:8443",
+		SkipLoggingTransport: true,
+	}
+	require.NoError(t, c.Setup())
+	ctx := context.Background()
+	require.NoError(t, c.RefreshJwt(ctx))
+	_, _, err := GetObjectsAPIClient(c).AddressesAPI.ListAddresses(ctx).Folder("Shared").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), proxied.Load())
+
+	for raw, direct := range map[string]bool{
+		"https://api.example.com/":               false,
+		"https://internal.example.com/":          true,
+		"https://eu.internal.example.com/":       true,
+		"https://corp.example.com/":              false,
+		"https://git.corp.example.com/":          true,
+		"https://10.1.2.3/":                      true,
+		"https://192.168.1.1/":                   true,
+		"https://192.168.1.2/":                   false,
+		"https://build.example.com:8443/":        true,
+		"https://build.example.com/":             false,
+		"https://notinternal.example.com/":       false,
+		"https://INTERNAL.example.com/sase/v1/x": true,
+	} {
+		u, err := url.Parse(raw)
+		require.NoError(t, err)
+		got, err := c.Transport.Proxy(&http.Request{URL: u})
+		require.NoError(t, err)
+		assert.Equal(t, direct, got == nil, raw)
+	}
+}
@@ -0,0 +1,199 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/paloaltonetworks/scm-go/api"
+	"golang.org/x/oauth2"
+)
+
+// jwtRefresherRetryInterval is how long the background refresher waits
+// before trying again after a failed refresh.
+const jwtRefresherRetryInterval = 10 * time.Second
+
+// noExpiryRecheckInterval is how long a token without any known expiry is
+// used before the TokenSource is asked for a token again.
+const noExpiryRecheckInterval = 5 * time.Minute
+
+// tokenManager guards the JWT of a Client and makes sure that only one
+// refresh is in flight at any given time.
+type tokenManager struct {
+	mu       sync.RWMutex
+	inflight *tokenRefresh
+}
+
+// tokenRefresh is a refresh in progress that any number of callers may wait on.
+type tokenRefresh struct {
+	done chan struct{}
+	err  error
+}
+
+// refreshJwt refreshes the JWT, joining the in-flight refresh if there is one.
+//
+// If stale is not nil, it is the JWT that the caller found to be unusable: if
+// the client already holds a different JWT, then another caller refreshed it
+// in the meantime and no new refresh is started.
+//
+// The refresh itself is not tied to ctx, so one caller giving up does not
+// fail the refresh for everybody else waiting on it.
+func (c *Client) refreshJwt(ctx context.Context, stale *string) error {
+	c.tokens.mu.Lock()
+	r := c.tokens.inflight
+	if r == nil {
+		if stale != nil && c.Jwt != *stale {
+			c.tokens.mu.Unlock()
+			return nil
+		}
+		r = &tokenRefresh{done: make(chan struct{})}
+		c.tokens.inflight = r
+		go func() {
+			r.err = c.fetchJwt(context.WithoutCancel(ctx))
+			c.tokens.mu.Lock()
+			c.tokens.inflight = nil
+			c.tokens.mu.Unlock()
+			close(r.done)
+		}()
+	} else {
+		c.Log(ctx, "", "RefreshJwt: Another refresh in progress, waiting...")
+	}
+	c.tokens.mu.Unlock()
+
+	select {
+	case <-r.done:
+		api.TraceTokenRefresh(ctx, r.err)
+		return r.err
+	case <-ctx.Done():
+		api.TraceTokenRefresh(ctx, ctx.Err())
+		return ctx.Err()
+	}
+}
+
+// setJwt stores a new JWT valid for lifetime seconds and returns the time at
+// which it is considered expired (60 seconds ahead of the real expiry).
+func (c *Client) setJwt(jwt string, lifetime int64) time.Time {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+
+	c.Jwt = jwt
+	c.JwtLifetime = lifetime
+	c.JwtExpiresAt = time.Now().Add(time.Duration(lifetime)*time.Second - 60*time.Second)
+	return c.JwtExpiresAt
+}
+
+// setJwtToken stores a JWT obtained from a TokenSource.
+//
+// The token is considered expired 60 seconds ahead of its expiry, as the JWTs
+// fetched by the client are.  A token without expiry expires with its "exp"
+// claim if it has one, and is otherwise only used for a few minutes before
+// the TokenSource is asked again.
+func (c *Client) setJwtToken(tok *oauth2.Token) {
+	expiry := tok.Expiry
+	if expiry.IsZero() {
+		if claims, err := ParseJwtClaims(tok.AccessToken); err == nil {
+			expiry = claims.ExpiresAt
+		}
+	}
+
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+
+	c.Jwt = tok.AccessToken
+	if expiry.IsZero() {
+		c.JwtLifetime = 0
+		c.JwtExpiresAt = time.Now().Add(noExpiryRecheckInterval)
+	} else {
+		c.JwtLifetime = int64(time.Until(expiry) / time.Second)
+		c.JwtExpiresAt = expiry.Add(-60 * time.Second)
+	}
+}
+
+// setCachedJwt stores a JWT obtained from a TokenCache.
+func (c *Client) setCachedJwt(jwt CachedJwt) {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+
+	c.Jwt = jwt.Jwt
+	c.JwtLifetime = jwt.Lifetime
+	c.JwtExpiresAt = jwt.ExpiresAt
+}
+
+// cachedJwt returns the current JWT as stored in a TokenCache.
+func (c *Client) cachedJwt() CachedJwt {
+	c.tokens.mu.RLock()
+	defer c.tokens.mu.RUnlock()
+
+	return CachedJwt{
+		Jwt:       c.Jwt,
+		ExpiresAt: c.JwtExpiresAt,
+		Lifetime:  c.JwtLifetime,
+	}
+}
+
+// jwt returns the current JWT and its expiry time.
+func (c *Client) jwt() (string, time.Time) {
+	c.tokens.mu.RLock()
+	defer c.tokens.mu.RUnlock()
+
+	return c.Jwt, c.JwtExpiresAt
+}
+
+// currentJwt returns the JWT to use for a request, obtaining it first if the
+// client has none yet, or refreshing it if it has expired or is about to.
+func (c *Client) currentJwt(ctx context.Context) (string, error) {
+	jwt, expiresAt := c.jwt()
+	switch {
+	case jwt == "":
+		c.Log(ctx, api.LogBasic, "No JWT yet, obtaining one.")
+	case time.Now().Before(expiresAt):
+		return jwt, nil
+	default:
+		c.Log(ctx, api.LogBasic, "JWT expired or near expiry, attempting proactive refresh.")
+	}
+	if err := c.refreshJwt(ctx, &jwt); err != nil {
+		return "", err
+	}
+	jwt, _ = c.jwt()
+	return jwt, nil
+}
+
+// StartJwtRefresher refreshes the JWT in the background shortly before it
+// expires, so that requests never have to wait on a refresh.
+//
+// The refresher runs until ctx is done.  If a refresh fails, it is tried
+// again after a short delay.
+func (c *Client) StartJwtRefresher(ctx context.Context) {
+	go func() {
+		for {
+			wait := jwtRefresherRetryInterval
+			if _, expiresAt := c.jwt(); !expiresAt.IsZero() {
+				wait = time.Until(expiresAt)
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			jwt, expiresAt := c.jwt()
+			if !expiresAt.IsZero() && time.Now().Before(expiresAt) {
+				continue
+			}
+			if err := c.refreshJwt(ctx, &jwt); err != nil && ctx.Err() == nil {
+				c.Log(ctx, api.LogBasic, fmt.Sprintf("Background JWT refresh failed: %v", err))
+				timer := time.NewTimer(jwtRefresherRetryInterval)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,207 @@
+package scm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/paloaltonetworks/scm-go/api"
+)
+
+// CachedJwt is a JWT stored in a TokenCache.
+type CachedJwt struct {
+	Jwt       string    `json:"jwt"`
+	ExpiresAt time.Time `json:"jwt_expires_at"`
+	Lifetime  int64     `json:"jwt_lifetime"`
+}
+
+/*
+TokenCache stores JWTs so that clients using the same credentials, possibly
+in different processes, do not each have to request their own JWT.
+
+When a Client with a TokenCache needs a new JWT, it first looks for a valid
+one in the cache, and only asks the auth server if there is none.  A JWT
+received from the auth server is then put back into the cache.
+
+Keys are built by TokenCacheKey.  Errors returned by a TokenCache are logged
+but never fail a refresh: the client then talks to the auth server directly.
+*/
+type TokenCache interface {
+	// Get returns the JWT cached for key, or nil if there is none.
+	Get(ctx context.Context, key string) (*CachedJwt, error)
+
+	// Put caches the JWT for key.
+	Put(ctx context.Context, key string, jwt CachedJwt) error
+}
+
+// TokenCacheLocker is implemented by token caches able to lock a key, so
+// that only one of the clients sharing the cache requests a new JWT at a
+// time while the others wait for it.
+type TokenCacheLocker interface {
+	// Lock locks key until the returned function is invoked.
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
+
+// TokenCacheKey returns the cache key for the given credentials.
+func TokenCacheKey(clientId, scope, authUrl string) string {
+	sum := sha256.Sum256([]byte(clientId + "\x00" + scope + "\x00" + authUrl))
+	return hex.EncodeToString(sum[:])
+}
+
+// fetchJwtCached obtains a new JWT from the TokenCache if it holds a valid
+// one, otherwise from the auth server, and stores it on the client.
+func (c *Client) fetchJwtCached(ctx context.Context) error {
+	key := TokenCacheKey(c.ClientId, c.Scope, c.AuthUrl)
+
+	if locker, ok := c.TokenCache.(TokenCacheLocker); ok {
+		unlock, err := locker.Lock(ctx, key)
+		if err != nil {
+			c.Log(ctx, api.LogBasic, fmt.Sprintf("RefreshJwt: Failed to lock the token cache: %v", err))
+		} else {
+			defer unlock()
+		}
+	}
+
+	// The current JWT is being replaced because it expired or was rejected,
+	// so it is not reused even if the cache still has it.
+	current, _ := c.jwt()
+	cached, err := c.TokenCache.Get(ctx, key)
+	if err != nil {
+		c.Log(ctx, api.LogBasic, fmt.Sprintf("RefreshJwt: Failed to read the token cache: %v", err))
+	} else if cached != nil && cached.Jwt != "" && cached.Jwt != current && time.Now().Before(cached.ExpiresAt) {
+		c.Log(ctx, "", "RefreshJwt: Using the cached JWT")
+		c.setCachedJwt(*cached)
+		return nil
+	}
+
+	if err = c.requestJwt(ctx); err != nil {
+		return err
+	}
+
+	if err = c.TokenCache.Put(ctx, key, c.cachedJwt()); err != nil {
+		c.Log(ctx, api.LogBasic, fmt.Sprintf("RefreshJwt: Failed to update the token cache: %v", err))
+	}
+	return nil
+}
+
+// FileTokenCache is a TokenCache keeping one file per key in a directory.
+//
+// Files are only readable by the current user and are replaced atomically.
+// Refreshes are serialized between processes with an advisory lock file.
+type FileTokenCache struct {
+	// Dir is the directory holding the cache files.  It is created if
+	// needed.
+	Dir string
+
+	// LockTimeout is how long Lock waits for another process to release a
+	// key.  Defaults to 30 seconds.  Where lock files are not advisory
+	// locks, a lock file older than that was left behind by a crashed
+	// process, and is broken.
+	LockTimeout time.Duration
+}
+
+// NewFileTokenCache returns a FileTokenCache storing its files in dir.
+//
+// If dir is empty, an "scm-go" directory in the user's cache directory is used.
+func NewFileTokenCache(dir string) (*FileTokenCache, error) {
+	if dir == "" {
+		base, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(base, "scm-go")
+	}
+
+	return &FileTokenCache{Dir: dir}, nil
+}
+
+// Get implements TokenCache.
+func (f *FileTokenCache) Get(ctx context.Context, key string) (*CachedJwt, error) {
+	b, err := os.ReadFile(f.path(key, ".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var jwt CachedJwt
+	if err = json.Unmarshal(b, &jwt); err != nil {
+		return nil, err
+	}
+	return &jwt, nil
+}
+
+// Put implements TokenCache.
+func (f *FileTokenCache) Put(ctx context.Context, key string, jwt CachedJwt) error {
+	b, err := json.Marshal(jwt)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(f.Dir, 0700); err != nil {
+		return err
+	}
+
+	// CreateTemp creates the file with 0600 permissions.
+	tmp, err := os.CreateTemp(f.Dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.path(key, ".json"))
+}
+
+// Lock implements TokenCacheLocker.
+func (f *FileTokenCache) Lock(ctx context.Context, key string) (func(), error) {
+	if err := os.MkdirAll(f.Dir, 0700); err != nil {
+		return nil, err
+	}
+
+	timeout := f.LockTimeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	path := f.path(key, ".lock")
+	for {
+		unlock, ok, err := tryLockFile(path, timeout)
+		if err != nil {
+			return nil, err
+		} else if ok {
+			return unlock, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for %s: %w", path, ctx.Err())
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+// path returns the path of the cache file for key with the given extension.
+func (f *FileTokenCache) path(key, ext string) string {
+	return filepath.Join(f.Dir, key+ext)
+}
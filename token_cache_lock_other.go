@@ -0,0 +1,39 @@
+//go:build !unix
+
+package scm
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// tryLockFile takes a lock on the file at path without waiting.
+//
+// Without advisory locks, the lock is held by creating the file, holding the
+// PID of its owner, and released by removing it.  A lock older than stale
+// was left behind by a crashed process: it is broken and taken over.
+func tryLockFile(path string, stale time.Duration) (func(), bool, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if errors.Is(err, fs.ErrExist) {
+		info, err := os.Stat(path)
+		if err != nil || time.Since(info.ModTime()) <= stale {
+			return nil, false, nil
+		}
+		// Removing fails while the owner still has the file open.
+		if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, false, nil
+		}
+		return tryLockFile(path, stale)
+	} else if err != nil {
+		return nil, false, err
+	}
+	fmt.Fprintf(f, "%d\n", os.Getpid())
+
+	return func() {
+		f.Close()
+		os.Remove(path)
+	}, true, nil
+}
@@ -0,0 +1,35 @@
+//go:build unix
+
+package scm
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"time"
+)
+
+// tryLockFile takes an advisory lock on the file at path without waiting.
+//
+// The lock is released by the returned function, or by the OS if the
+// process exits, so it is never stale.
+func tryLockFile(path string, _ time.Duration) (func(), bool, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		f.Close()
+		return nil, false, nil
+	} else if err != nil {
+		f.Close()
+		return nil, false, err
+	}
+
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, true, nil
+}
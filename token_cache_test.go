@@ -0,0 +1,129 @@
+package scm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCacheKey(t *testing.T) {
+	key := TokenCacheKey("id", "tsg_id:1", "https://auth")
+	assert.Equal(t, key, TokenCacheKey("id", "tsg_id:1", "https://auth"))
+	assert.NotEqual(t, key, TokenCacheKey("id", "tsg_id:2", "https://auth"))
+	assert.NotEqual(t, key, TokenCacheKey("other", "tsg_id:1", "https://auth"))
+	assert.NotEqual(t, key, TokenCacheKey("id", "tsg_id:1", "https://other"))
+}
+
+func TestFileTokenCache_GetPut(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	cache, err := NewFileTokenCache(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	got, err := cache.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	want := CachedJwt{Jwt: "jwt", ExpiresAt: time.Now().Add(time.Hour).Round(0), Lifetime: 900}
+	require.NoError(t, cache.Put(ctx, "key", want))
+
+	got, err = cache.Get(ctx, "key")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, want.Jwt, got.Jwt)
+	assert.True(t, want.ExpiresAt.Equal(got.ExpiresAt))
+	assert.Equal(t, want.Lifetime, got.Lifetime)
+
+	info, err := os.Stat(filepath.Join(dir, "key.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestFileTokenCache_LockTimeout(t *testing.T) {
+	cache := &FileTokenCache{Dir: t.TempDir(), LockTimeout: 50 * time.Millisecond}
+
+	unlock, err := cache.Lock(context.Background(), "key")
+	require.NoError(t, err)
+
+	_, err = cache.Lock(context.Background(), "key")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+	unlock, err = cache.Lock(context.Background(), "key")
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestFileTokenCache_StaleLock(t *testing.T) {
+	cache := &FileTokenCache{Dir: t.TempDir(), LockTimeout: time.Second}
+
+	// A crashed process left its lock file behind.
+	path := cache.path("key", ".lock")
+	require.NoError(t, os.WriteFile(path, []byte("4242\n"), 0600))
+	old := time.Now().Add(-time.Minute)
+	require.NoError(t, os.Chtimes(path, old, old))
+
+	start := time.Now()
+	unlock, err := cache.Lock(context.Background(), "key")
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), cache.LockTimeout/2)
+	unlock()
+}
+
+func TestTokenCache_SharedBetweenClients(t *testing.T) {
+	var authCalls int32
+	cache := &FileTokenCache{Dir: t.TempDir()}
+
+	// Every client has its own token manager, like separate processes.
+	clients := make([]*Client, 20)
+	for i := range clients {
+		clients[i] = newTokenTestClient(t, 20*time.Millisecond, &authCalls)
+		clients[i].AuthUrl = clients[0].AuthUrl
+		clients[i].Host = clients[0].Host
+		clients[i].TokenCache = cache
+		require.NoError(t, clients[i].Setup())
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.RefreshJwt(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&authCalls))
+	for _, c := range clients {
+		jwt, _ := c.jwt()
+		assert.Equal(t, "jwt-1", jwt)
+	}
+}
+
+func TestTokenCache_RejectedJwtNotReused(t *testing.T) {
+	var authCalls int32
+	c := newTokenTestClient(t, 0, &authCalls)
+	c.TokenCache = &FileTokenCache{Dir: t.TempDir()}
+
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	jwt, _ := c.jwt()
+	require.Equal(t, "jwt-1", jwt)
+
+	// Refreshing again means jwt-1 was rejected, so the auth server is asked.
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	jwt, _ = c.jwt()
+	assert.Equal(t, "jwt-2", jwt)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&authCalls))
+}
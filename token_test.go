@@ -0,0 +1,159 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTokenTestClient returns a client whose auth server hands out "jwt-1",
+// "jwt-2", ... after the given delay, and an API server that only accepts
+// the most recent JWT.
+func newTokenTestClient(t *testing.T, delay time.Duration, authCalls *int32) *Client {
+	t.Helper()
+
+	var current atomic.Value
+	current.Store("")
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(authCalls, 1)
+		time.Sleep(delay)
+		jwt := fmt.Sprintf("jwt-%d", n)
+		current.Store(jwt)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":%q,"expires_in":900}`, jwt)
+	}))
+	t.Cleanup(auth.Close)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+current.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[],"limit":200,"offset":0,"total":0}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := &Client{
+		ClientId:              "client-id",
+		ClientSecret:          "client-secret",
+		Scope:                 "tsg_id:1234567890",
+		Host:                  strings.TrimPrefix(server.URL, "https://"),
+		AuthUrl:               auth.URL,
+		SkipVerifyCertificate: true,
+		SkipLoggingTransport:  true,
+		Logging:               "quiet",
+	}
+	require.NoError(t, c.Setup())
+	return c
+}
+
+func TestRefreshJwt_SingleFlight(t *testing.T) {
+	var authCalls int32
+	c := newTokenTestClient(t, 50*time.Millisecond, &authCalls)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, c.RefreshJwt(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&authCalls))
+	jwt, _ := c.jwt()
+	assert.Equal(t, "jwt-1", jwt)
+}
+
+func TestRefreshJwt_WaitHonorsContext(t *testing.T) {
+	var authCalls int32
+	c := newTokenTestClient(t, 200*time.Millisecond, &authCalls)
+
+	go c.RefreshJwt(context.Background())
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.RefreshJwt(ctx), context.DeadlineExceeded)
+
+	// The refresh itself is not canceled for everybody else.
+	require.NoError(t, c.RefreshJwt(context.Background()))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&authCalls))
+}
+
+func TestJwt_ConcurrentRequestsShareOneRefresh(t *testing.T) {
+	var authCalls int32
+	c := newTokenTestClient(t, 20*time.Millisecond, &authCalls)
+	require.NoError(t, c.RefreshJwt(context.Background()))
+
+	// Expire the token so every request wants a refresh.
+	c.setJwt("jwt-1", 0)
+	apiClient := GetObjectsAPIClient(c)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ctx := context.Background()
+			if i%2 == 0 {
+				_, err := c.Do(ctx, http.MethodGet, "/config/objects/v1/addresses", nil, nil, nil)
+				assert.NoError(t, err)
+			} else {
+				_, _, err := apiClient.AddressesAPI.ListAddresses(ctx).Execute()
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&authCalls))
+}
+
+func TestDo_RefreshesOnceOnUnauthorized(t *testing.T) {
+	var authCalls int32
+	c := newTokenTestClient(t, 20*time.Millisecond, &authCalls)
+	require.NoError(t, c.RefreshJwt(context.Background()))
+
+	// The server now rejects the cached token, which has not expired yet.
+	c.setJwt("revoked", 900)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Do(context.Background(), http.MethodGet, "/config/objects/v1/addresses", nil, nil, nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&authCalls))
+}
+
+func TestStartJwtRefresher(t *testing.T) {
+	var authCalls int32
+	c := newTokenTestClient(t, 0, &authCalls)
+	c.setJwt("old", 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.StartJwtRefresher(ctx)
+
+	require.Eventually(t, func() bool {
+		jwt, _ := c.jwt()
+		return jwt == "jwt-1"
+	}, 2*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,351 @@
+// Command validategen adds a Validate method to the models of each generated
+// API client, checking the constraints of their schema in the client's
+// OpenAPI spec.
+//
+// Usage:
+//
+//	go run ./internal/validategen -generated ../generated
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// oneOf lists the mutually exclusive fields of the schemas, by package and
+// schema name, as the specs do not describe them.  The folder, snippet and
+// device of the schemas having all three are exclusive as well.
+var oneOf = map[string][]group{
+	"objects/addresses":         {{[]string{"ip_netmask", "ip_range", "ip_wildcard", "fqdn"}, true}},
+	"objects/address-groups":    {{[]string{"static", "dynamic"}, true}},
+	"objects/services_protocol": {{[]string{"tcp", "udp"}, true}},
+}
+
+var containers = []string{"folder", "snippet", "device"}
+
+type group struct {
+	Fields   []string
+	Required bool
+}
+
+type schema struct {
+	Ref        string             `yaml:"$ref"`
+	Type       string             `yaml:"type"`
+	Properties map[string]*schema `yaml:"properties"`
+	Required   []string           `yaml:"required"`
+	Items      *schema            `yaml:"items"`
+	ReadOnly   bool               `yaml:"readOnly"`
+
+	Pattern   string        `yaml:"pattern"`
+	MinLength int           `yaml:"minLength"`
+	MaxLength int           `yaml:"maxLength"`
+	Enum      []interface{} `yaml:"enum"`
+	Minimum   *float64      `yaml:"minimum"`
+	Maximum   *float64      `yaml:"maximum"`
+	MinItems  int           `yaml:"minItems"`
+	MaxItems  int           `yaml:"maxItems"`
+}
+
+type spec struct {
+	Components struct {
+		Schemas map[string]*schema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+func main() {
+	generated := flag.String("generated", "../generated", "directory holding the generated API clients")
+	flag.Parse()
+
+	dirs, err := filepath.Glob(filepath.Join(*generated, "*", "api", "openapi.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, file := range dirs {
+		dir := filepath.Dir(filepath.Dir(file))
+		if err := generate(dir, file); err != nil {
+			log.Fatalf("%s: %s", dir, err)
+		}
+	}
+}
+
+// generate writes validate_gen.go in the package in dir from its spec file.
+func generate(dir, file string) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var s spec
+	if err = yaml.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	out := filepath.Join(dir, "validate_gen.go")
+	pkg, structs, err := parsePackage(dir, out)
+	if err != nil {
+		return err
+	}
+
+	schemas := s.Components.Schemas
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by validategen from api/openapi.yaml. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/paloaltonetworks/scm-go/validate\"\n\n")
+
+	// Schemas whose names only differ by punctuation share their model; keep
+	// the one the model was generated from.
+	models := make(map[string]string)
+	for _, name := range names {
+		if schemas[name].Properties == nil {
+			continue
+		}
+		typ := goName(name)
+		fields, ok := structs[typ]
+		if !ok {
+			log.Printf("%s: no model %s for schema %s", dir, typ, name)
+			continue
+		}
+		if prev, ok := models[typ]; ok && slices.Equal(properties(schemas[prev]), fields) {
+			continue
+		}
+		models[typ] = name
+	}
+
+	for _, name := range names {
+		sc := schemas[name]
+		typ := goName(name)
+		if models[typ] != name {
+			continue
+		}
+
+		v := lowerFirst(typ) + "Schema"
+		fmt.Fprintf(&buf, "var %s = validate.Schema{\n", v)
+		fmt.Fprintf(&buf, "Fields: map[string]validate.Rule{\n")
+		for _, prop := range properties(sc) {
+			p := sc.Properties[prop]
+			r := rule(schemas, p, slices.Contains(sc.Required, prop) && !p.ReadOnly, name+"."+prop)
+			if r != "" {
+				fmt.Fprintf(&buf, "%q: {%s},\n", prop, r)
+			}
+		}
+		fmt.Fprintf(&buf, "},\n")
+
+		groups := oneOf[filepath.Base(dir)+"/"+name]
+		if hasAll(sc.Properties, containers) {
+			groups = append(groups, group{Fields: containers})
+		}
+		if len(groups) != 0 {
+			fmt.Fprintf(&buf, "OneOf: []validate.OneOf{\n")
+			for _, g := range groups {
+				if g.Required {
+					fmt.Fprintf(&buf, "{Fields: %#v, Required: true},\n", g.Fields)
+				} else {
+					fmt.Fprintf(&buf, "{Fields: %#v},\n", g.Fields)
+				}
+			}
+			fmt.Fprintf(&buf, "},\n")
+		}
+		fmt.Fprintf(&buf, "}\n\n")
+
+		fmt.Fprintf(&buf, "// Validate checks o against the constraints of the %s schema.\n", name)
+		fmt.Fprintf(&buf, "// It returns validate.Errors, or nil if o is valid.\n")
+		fmt.Fprintf(&buf, "func (o %s) Validate() error {\n", typ)
+		fmt.Fprintf(&buf, "return %s.Validate(o)\n", v)
+		fmt.Fprintf(&buf, "}\n\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, src, 0644)
+}
+
+// rule returns the fields of the validate.Rule literal of the property p of
+// a schema, where is the property for the warnings.
+func rule(schemas map[string]*schema, p *schema, required bool, where string) string {
+	var fields []string
+	if required {
+		fields = append(fields, "Required: true")
+	}
+
+	p = resolve(schemas, p, where)
+	if p == nil || p.Properties != nil {
+		// Objects are validated by their own Validate method.
+		return strings.Join(fields, ", ")
+	}
+	if p.Pattern != "" {
+		if _, err := regexp.Compile(p.Pattern); err != nil {
+			log.Printf("%s: skipping pattern: %s", where, err)
+		} else {
+			fields = append(fields, "Pattern: "+quote(p.Pattern))
+		}
+	}
+	if p.MinLength != 0 {
+		fields = append(fields, fmt.Sprintf("MinLength: %d", p.MinLength))
+	}
+	if p.MaxLength != 0 {
+		fields = append(fields, fmt.Sprintf("MaxLength: %d", p.MaxLength))
+	}
+	if len(p.Enum) != 0 {
+		values := make([]string, 0, len(p.Enum))
+		for _, v := range p.Enum {
+			values = append(values, strconv.Quote(fmt.Sprint(v)))
+		}
+		fields = append(fields, "Enum: []string{"+strings.Join(values, ", ")+"}")
+	}
+	if p.Minimum != nil {
+		fields = append(fields, "Minimum: validate.Bound("+strconv.FormatFloat(*p.Minimum, 'g', -1, 64)+")")
+	}
+	if p.Maximum != nil {
+		fields = append(fields, "Maximum: validate.Bound("+strconv.FormatFloat(*p.Maximum, 'g', -1, 64)+")")
+	}
+	if p.MinItems != 0 {
+		fields = append(fields, fmt.Sprintf("MinItems: %d", p.MinItems))
+	}
+	if p.MaxItems != 0 {
+		fields = append(fields, fmt.Sprintf("MaxItems: %d", p.MaxItems))
+	}
+	if p.Items != nil {
+		if items := rule(schemas, p.Items, false, where+"[]"); items != "" {
+			fields = append(fields, "Items: &validate.Rule{"+items+"}")
+		}
+	}
+	return strings.Join(fields, ", ")
+}
+
+// resolve returns the schema p refers to, or p.  Some specs refer to
+// schemas they do not define, in which case it returns nil.
+func resolve(schemas map[string]*schema, p *schema, where string) *schema {
+	for p.Ref != "" {
+		ref, ok := schemas[strings.TrimPrefix(p.Ref, "#/components/schemas/")]
+		if !ok {
+			log.Printf("%s: unknown schema %s", where, p.Ref)
+			return nil
+		}
+		p = ref
+	}
+	return p
+}
+
+// quote returns s as a Go string literal, raw if possible.
+func quote(s string) string {
+	if !strings.Contains(s, "`") && strconv.CanBackquote(s) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+// parsePackage returns the name of the package in dir and the JSON names of
+// the fields of its struct types, ignoring the file skip.
+func parsePackage(dir, skip string) (string, map[string][]string, error) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var pkg string
+	structs := make(map[string][]string)
+	for _, file := range files {
+		if file == skip || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		pkg = f.Name.Name
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				fields := []string{}
+				for _, f := range st.Fields.List {
+					if f.Tag == nil {
+						continue
+					}
+					tag, _ := strconv.Unquote(f.Tag.Value)
+					name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+					if name != "" && name != "-" {
+						fields = append(fields, name)
+					}
+				}
+				slices.Sort(fields)
+				structs[ts.Name.Name] = fields
+			}
+		}
+	}
+	return pkg, structs, nil
+}
+
+// goName returns the name OpenAPI Generator gives to the model of a schema,
+// such as AddressGroupsDynamic for address_groups_dynamic.
+func goName(schema string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range schema {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			upper = true
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// properties returns the sorted names of the properties of sc.
+func properties(sc *schema) []string {
+	ans := make([]string, 0, len(sc.Properties))
+	for prop := range sc.Properties {
+		ans = append(ans, prop)
+	}
+	slices.Sort(ans)
+	return ans
+}
+
+// hasAll returns whether props has all of names.
+func hasAll(props map[string]*schema, names []string) bool {
+	for _, name := range names {
+		if _, ok := props[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
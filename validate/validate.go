@@ -0,0 +1,354 @@
+/*
+Package validate checks the models of the generated API clients against the
+constraints of their OpenAPI schemas, so that invalid input fails before it
+is sent rather than with a 400 from the API.
+
+Every generated model has a Validate method built on this package:
+
+	a := objects.NewAddresses("", "web")
+	a.SetIpNetmask("10.0.0.1/32")
+	a.SetFqdn("web.example.com")
+	a.SetTag(make([]string, 65))
+	err := a.Validate()
+	// tag: 65 items, more than the maximum of 64; fqdn: cannot be set along with ip_netmask
+
+The errors are Errors, a list of *FieldError naming the JSON path of the
+offending field, such as "dynamic.filter" or "members[3]".
+
+Setting scm.Client.ValidateRequests validates request bodies automatically,
+both in the API clients and in scm.Client.Do.
+*/
+package validate
+
+//go:generate go run ./internal/validategen -generated ../generated
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Validator is implemented by the generated models.
+type Validator interface {
+	Validate() error
+}
+
+// FieldError is a constraint a field does not satisfy.
+type FieldError struct {
+	// Field is the JSON path of the field, such as "tag[3]" or
+	// "dynamic.filter".  It is empty for the constraints of a whole object.
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Errors is the list of the constraints a value does not satisfy.
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the field errors, for errors.As.
+func (e Errors) Unwrap() []error {
+	ans := make([]error, 0, len(e))
+	for _, fe := range e {
+		ans = append(ans, fe)
+	}
+	return ans
+}
+
+// Rule is the constraints of a field.  Zero values mean no constraint,
+// except for Minimum and Maximum which are set when not nil.
+type Rule struct {
+	// Required fields must be set.  Empty strings are not set.
+	Required bool
+
+	Pattern   string
+	MinLength int
+	MaxLength int
+	Enum      []string
+
+	Minimum *float64
+	Maximum *float64
+
+	MinItems int
+	MaxItems int
+	// Items is the rule of the items of an array.
+	Items *Rule
+}
+
+// OneOf is a group of mutually exclusive fields.
+type OneOf struct {
+	Fields []string
+
+	// Required groups must have one of their fields set.
+	Required bool
+}
+
+// Schema is the constraints of an object.
+type Schema struct {
+	// Fields are the rules of the fields, by JSON name.
+	Fields map[string]Rule
+	OneOf  []OneOf
+}
+
+// Value validates v if it is a Validator, or a non nil pointer to one, and
+// returns nil otherwise.
+func Value(v interface{}) error {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	if m, ok := v.(Validator); ok {
+		return m.Validate()
+	}
+	return nil
+}
+
+// Bound returns a pointer to v, for Rule.Minimum and Rule.Maximum.
+func Bound(v float64) *float64 {
+	return &v
+}
+
+// Validate checks v, a struct or a pointer to a struct, against s.  Fields
+// holding models are validated with their Validate method.
+//
+// It returns Errors, or nil if v is valid.
+func (s *Schema) Validate(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot validate %T", v)
+	}
+
+	var errs Errors
+	fields := jsonFields(rv.Type())
+	// Fields without rules may still hold models.
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	for name := range s.Fields {
+		if _, ok := fields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		rule := s.Fields[name]
+		var fv reflect.Value
+		if i, ok := fields[name]; ok {
+			fv = fieldValue(rv.Field(i))
+		}
+		if !fv.IsValid() {
+			if rule.Required {
+				errs = append(errs, &FieldError{Field: name, Message: "is required"})
+			}
+			continue
+		}
+		errs = rule.check(errs, name, fv)
+	}
+
+	for _, group := range s.OneOf {
+		var set []string
+		for _, name := range group.Fields {
+			if i, ok := fields[name]; ok && fieldValue(rv.Field(i)).IsValid() {
+				set = append(set, name)
+			}
+		}
+		switch {
+		case len(set) == 0 && group.Required:
+			errs = append(errs, &FieldError{Message: "one of " + strings.Join(group.Fields, ", ") + " is required"})
+		case len(set) > 1:
+			for _, name := range set[1:] {
+				errs = append(errs, &FieldError{Field: name, Message: "cannot be set along with " + set[0]})
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// check appends to errs the errors of value v of the field at path.
+func (r *Rule) check(errs Errors, path string, v reflect.Value) Errors {
+	add := func(format string, args ...interface{}) {
+		errs = append(errs, &FieldError{Field: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if m, ok := v.Interface().(Validator); ok && v.Kind() == reflect.Struct {
+		return appendNested(errs, path, m.Validate())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		s := v.String()
+		if n := utf8.RuneCountInString(s); r.MaxLength != 0 && n > r.MaxLength {
+			add("length %d is more than the maximum of %d", n, r.MaxLength)
+		} else if n < r.MinLength {
+			add("length %d is less than the minimum of %d", n, r.MinLength)
+		}
+		if r.Pattern != "" && !pattern(r.Pattern).MatchString(s) {
+			add("%q does not match %s", s, r.Pattern)
+		}
+		if len(r.Enum) != 0 && !slices.Contains(r.Enum, s) {
+			add("%q is not one of %s", s, strings.Join(r.Enum, ", "))
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		var f float64
+		switch {
+		case v.CanInt():
+			f = float64(v.Int())
+		case v.CanUint():
+			f = float64(v.Uint())
+		default:
+			f = v.Float()
+		}
+		s := strconv.FormatFloat(f, 'f', -1, 64)
+		if r.Minimum != nil && f < *r.Minimum {
+			add("%s is less than the minimum of %v", s, *r.Minimum)
+		}
+		if r.Maximum != nil && f > *r.Maximum {
+			add("%s is more than the maximum of %v", s, *r.Maximum)
+		}
+		if len(r.Enum) != 0 && !slices.Contains(r.Enum, s) {
+			add("%s is not one of %s", s, strings.Join(r.Enum, ", "))
+		}
+
+	case reflect.Slice, reflect.Array:
+		n := v.Len()
+		if r.MaxItems != 0 && n > r.MaxItems {
+			add("%d items, more than the maximum of %d", n, r.MaxItems)
+		} else if n < r.MinItems {
+			add("%d items, less than the minimum of %d", n, r.MinItems)
+		}
+		item := r.Items
+		if item == nil {
+			item = &Rule{}
+		}
+		for i := range n {
+			if iv := fieldValue(v.Index(i)); iv.IsValid() {
+				errs = item.check(errs, fmt.Sprintf("%s[%d]", path, i), iv)
+			}
+		}
+	}
+	return errs
+}
+
+// appendNested appends to errs the error of validating the model of the
+// field at path.
+func appendNested(errs Errors, path string, err error) Errors {
+	if err == nil {
+		return errs
+	}
+	var nested Errors
+	if !errors.As(err, &nested) {
+		return append(errs, &FieldError{Field: path, Message: err.Error()})
+	}
+	for _, fe := range nested {
+		field := path
+		switch {
+		case fe.Field == "":
+		case strings.HasPrefix(fe.Field, "["):
+			field += fe.Field
+		default:
+			field += "." + fe.Field
+		}
+		errs = append(errs, &FieldError{Field: field, Message: fe.Message})
+	}
+	return errs
+}
+
+// fieldValue returns the value held by v, dereferencing pointers and the
+// Nullable types of the generated packages, or the zero Value if v is not
+// set.
+func fieldValue(v reflect.Value) reflect.Value {
+	for {
+		switch v.Kind() {
+		case reflect.Pointer, reflect.Interface:
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+			continue
+		case reflect.Slice, reflect.Map:
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+		case reflect.String:
+			if v.Len() == 0 {
+				return reflect.Value{}
+			}
+		case reflect.Struct:
+			// NullableString and the like.
+			if isSet := v.MethodByName("IsSet"); isSet.IsValid() && v.MethodByName("Get").IsValid() {
+				if !isSet.Call(nil)[0].Bool() {
+					return reflect.Value{}
+				}
+				v = v.MethodByName("Get").Call(nil)[0]
+				continue
+			}
+		}
+		return v
+	}
+}
+
+var fieldsByType sync.Map // reflect.Type -> map[string]int
+
+// jsonFields returns the indexes of the fields of struct type t, by JSON
+// name.
+func jsonFields(t reflect.Type) map[string]int {
+	if m, ok := fieldsByType.Load(t); ok {
+		return m.(map[string]int)
+	}
+	m := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.IsExported() && name != "" && name != "-" {
+			m[name] = i
+		}
+	}
+	fieldsByType.Store(t, m)
+	return m
+}
+
+var patterns sync.Map // string -> *regexp.Regexp
+
+// pattern returns the compiled regular expression expr.  Patterns are
+// compiled on first use, as most are never needed.
+func pattern(expr string) *regexp.Regexp {
+	if re, ok := patterns.Load(expr); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(expr)
+	patterns.Store(expr, re)
+	return re
+}
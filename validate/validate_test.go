@@ -0,0 +1,95 @@
+package validate_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/paloaltonetworks/scm-go/generated/objects"
+	"github.com/paloaltonetworks/scm-go/validate"
+)
+
+func fields(t *testing.T, err error) []string {
+	t.Helper()
+
+	var errs validate.Errors
+	require.True(t, errors.As(err, &errs), "%v", err)
+	var ans []string
+	for _, fe := range errs {
+		ans = append(ans, fe.Error())
+	}
+	return ans
+}
+
+func TestValidate(t *testing.T) {
+	a := objects.NewAddresses("", "web")
+	a.SetIpNetmask("10.0.0.1/32")
+	a.SetFolder("Texas")
+	assert.NoError(t, a.Validate())
+
+	// Pointers validate as well.
+	var v validate.Validator = a
+	assert.NoError(t, v.Validate())
+
+	a.SetName(strings.Repeat("a", 64))
+	a.SetFolder("Tex/as")
+	a.SetTag([]string{"prod", strings.Repeat("t", 128)})
+	assert.Equal(t, []string{
+		"folder: \"Tex/as\" does not match ^[a-zA-Z\\d\\-_\\. ]+$",
+		"name: length 64 is more than the maximum of 63",
+		"tag[1]: length 128 is more than the maximum of 127",
+	}, fields(t, a.Validate()))
+
+	tag := objects.NewTags("prod")
+	tag.SetColor("Pink")
+	assert.Equal(t, []string{`color: "Pink" is not one of Red, Green, Blue, Yellow, Copper, Orange, Purple, Gray, Light Green, Cyan, Light Gray, Blue Gray, Lime, Black, Gold, Brown, Olive, Maroon, Red-Orange, Yellow-Orange, Forest Green, Turquoise Blue, Azure Blue, Cerulean Blue, Midnight Blue, Medium Blue, Cobalt Blue, Violet Blue, Blue Violet, Medium Violet, Medium Rose, Lavender, Orchid, Thistle, Peach, Salmon, Magenta, Red Violet, Mahogany, Burnt Sienna, Chestnut`}, fields(t, tag.Validate()))
+
+	var fe *validate.FieldError
+	require.True(t, errors.As(tag.Validate(), &fe))
+	assert.Equal(t, "color", fe.Field)
+}
+
+func TestValidate_Required(t *testing.T) {
+	g := objects.NewServiceGroups("", nil, "")
+	assert.Equal(t, []string{"members: is required", "name: is required"}, fields(t, g.Validate()))
+
+	// The ID is read only.
+	g = objects.NewServiceGroups("", []string{"web"}, "web")
+	assert.NoError(t, g.Validate())
+}
+
+func TestValidate_OneOf(t *testing.T) {
+	a := objects.NewAddresses("", "web")
+	assert.Equal(t, []string{"one of ip_netmask, ip_range, ip_wildcard, fqdn is required"}, fields(t, a.Validate()))
+
+	a.SetIpNetmask("10.0.0.1/32")
+	a.SetFqdn("web.example.com")
+	a.SetIpRange("10.0.0.1-10.0.0.4")
+	a.SetFolder("Texas")
+	a.SetSnippet("web")
+	assert.Equal(t, []string{
+		"ip_range: cannot be set along with ip_netmask",
+		"fqdn: cannot be set along with ip_netmask",
+		"snippet: cannot be set along with folder",
+	}, fields(t, a.Validate()))
+}
+
+func TestValidate_Nested(t *testing.T) {
+	g := objects.NewAddressGroups("", "servers")
+	g.SetDynamic(objects.AddressGroupsDynamic{Filter: strings.Repeat("f", 2048)})
+	assert.Equal(t, []string{"dynamic.filter: length 2048 is more than the maximum of 2047"}, fields(t, g.Validate()))
+
+	s := objects.NewServices("web")
+	s.SetProtocol(objects.ServicesProtocol{Tcp: objects.NewServicesProtocolTcp("")})
+	assert.Equal(t, []string{"protocol.tcp.port: is required"}, fields(t, s.Validate()))
+}
+
+func TestValue(t *testing.T) {
+	var a *objects.Addresses
+	assert.NoError(t, validate.Value(a))
+	assert.NoError(t, validate.Value(map[string]interface{}{"name": ""}))
+	assert.Error(t, validate.Value(objects.NewAddresses("", "web")))
+}
@@ -0,0 +1,210 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// verifyFolder is the folder of the read calls of Verify.
+const verifyFolder = "Shared"
+
+// Access is the access of the client to an API family, as found by Verify.
+type Access int
+
+// Known accesses.
+const (
+	// AccessUnknown is the access to a family whose read call failed
+	// without telling, such as with a 5xx or a network error.
+	AccessUnknown Access = iota
+
+	// AccessGranted is the access to a family whose read call succeeded.
+	AccessGranted
+
+	// AccessDenied is the access to a family whose read call was rejected
+	// with a 401 or 403.
+	AccessDenied
+)
+
+// String returns the name of the access.
+func (a Access) String() string {
+	switch a {
+	case AccessGranted:
+		return "granted"
+	case AccessDenied:
+		return "denied"
+	}
+	return "unknown"
+}
+
+// accessOf returns the access found by a read call answered with the given
+// status code, 0 if it got no response.
+func accessOf(statusCode int) Access {
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return AccessGranted
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return AccessDenied
+	}
+	return AccessUnknown
+}
+
+// FamilyAccess is the access of the client to an API family, as found by
+// Verify.
+type FamilyAccess struct {
+	// Family is the API family, named after its package, such as "objects".
+	Family string
+
+	// Access is AccessGranted if the read call of the family got a 2xx,
+	// AccessDenied if it got a 401 or 403, and AccessUnknown otherwise.
+	Access Access
+
+	// StatusCode and Err are the ones of the read call, Err being nil on
+	// success.
+	StatusCode int
+	Err        error
+}
+
+// VerifyReport is the result of Verify.
+type VerifyReport struct {
+	// Claims are the claims of the client's JWT.
+	Claims *JwtClaims
+
+	// Families holds the access to each API family, sorted by family.
+	Families []FamilyAccess
+}
+
+// Authorized returns the API families the client has access to.
+func (r *VerifyReport) Authorized() []string {
+	return r.families(AccessGranted)
+}
+
+// Unauthorized returns the API families the client has no access to.
+func (r *VerifyReport) Unauthorized() []string {
+	return r.families(AccessDenied)
+}
+
+// Unknown returns the API families whose access could not be checked.
+func (r *VerifyReport) Unknown() []string {
+	return r.families(AccessUnknown)
+}
+
+// families returns the API families with the given access.
+func (r *VerifyReport) families(access Access) []string {
+	var ans []string
+	for _, f := range r.Families {
+		if f.Access == access {
+			ans = append(ans, f.Family)
+		}
+	}
+	return ans
+}
+
+// verifyProbe is the read call checking the access to an API family.
+type verifyProbe struct {
+	family string
+	call   func(ctx context.Context, sdk *SDK) (*http.Response, error)
+}
+
+// verifyProbes are the read calls of Verify, one per API family sorted by
+// family, each reading at most one object but for device_settings.
+var verifyProbes = []verifyProbe{
+	{"config_operations", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		_, resp, err := sdk.ConfigOperations().ConfigVersionsAPI.ListConfigVersions(ctx).Limit(1).Execute()
+		return resp, err
+	}},
+	{"config_setup", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		_, resp, err := sdk.ConfigSetup().FoldersAPI.ListFolders(ctx).Limit(1).Execute()
+		return resp, err
+	}},
+	{"deployment_services", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		_, resp, err := sdk.DeploymentServices().InternalDNSServersAPI.ListInternalDNSServers(ctx).Limit(1).Execute()
+		return resp, err
+	}},
+	{"device_settings", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		// No list of the family takes a limit: list the authentication
+		// settings of the folder, of which there are few.
+		_, resp, err := sdk.DeviceSettings().AuthenticationSettingsAPI.ListAuthenticationSettings(ctx).Folder(verifyFolder).Execute()
+		return resp, err
+	}},
+	{"identity_services", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		_, resp, err := sdk.IdentityServices().AuthenticationProfilesAPI.ListAuthenticationProfiles(ctx).Folder(verifyFolder).Limit(1).Execute()
+		return resp, err
+	}},
+	{"network_services", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		_, resp, err := sdk.NetworkServices().IKECryptoProfilesAPI.ListIKECryptoProfiles(ctx).Folder(verifyFolder).Limit(1).Execute()
+		return resp, err
+	}},
+	{"objects", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		_, resp, err := sdk.Objects().AddressesAPI.ListAddresses(ctx).Folder(verifyFolder).Limit(1).Execute()
+		return resp, err
+	}},
+	{"security_services", func(ctx context.Context, sdk *SDK) (*http.Response, error) {
+		_, resp, err := sdk.SecurityServices().AntiSpywareProfilesAPI.ListAntiSpywareProfiles(ctx).Folder(verifyFolder).Limit(1).Execute()
+		return resp, err
+	}},
+}
+
+/*
+Verify checks the client's credentials before any real work: it obtains a
+JWT, checks that it was issued for the client's Scope, and makes a cheap read
+call to each API family to report the ones the service account has access to.
+
+	report, err := client.Verify(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("%s on TSG %s can use %v", report.Claims.Subject, report.Claims.TsgId, report.Authorized())
+
+Verify fails if no JWT can be obtained, if it cannot be decoded, or if it is
+for another scope.  Families rejecting the read call are reported, not
+errors.  The read calls list objects of the "Shared" folder: a family is
+authorized if its call gets a 2xx, and unauthorized if it gets a 401 or 403.
+The access to the others, such as those answering with a 5xx, is unknown.
+*/
+func (c *Client) Verify(ctx context.Context) (*VerifyReport, error) {
+	if _, err := c.currentJwt(ctx); err != nil {
+		return nil, err
+	}
+
+	claims, err := c.JwtClaims()
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkScope(claims); err != nil {
+		return nil, err
+	}
+
+	sdk := NewSDK(c)
+	bulk := BulkApply(ctx, verifyProbes, func(ctx context.Context, p verifyProbe) (*http.Response, error) {
+		return p.call(ctx, sdk)
+	}, BulkOptions[verifyProbe]{Concurrency: len(verifyProbes)})
+
+	report := &VerifyReport{Claims: claims}
+	for _, res := range bulk.Results {
+		report.Families = append(report.Families, FamilyAccess{
+			Family:     res.Item.family,
+			Access:     accessOf(res.StatusCode),
+			StatusCode: res.StatusCode,
+			Err:        res.Err,
+		})
+	}
+	return report, nil
+}
+
+// checkScope returns an error unless the JWT with the given claims was issued
+// for the client's Scope.
+func (c *Client) checkScope(claims *JwtClaims) error {
+	for _, want := range strings.Fields(c.Scope) {
+		if id, ok := strings.CutPrefix(want, "tsg_id:"); ok {
+			if claims.TsgId != id {
+				return fmt.Errorf("JWT is for TSG %q instead of %q", claims.TsgId, id)
+			}
+		} else if !slices.Contains(claims.Scopes, want) {
+			return fmt.Errorf("JWT lacks the %q scope", want)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package scm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/paloaltonetworks/scm-go/scmtest"
+)
+
+func TestClient_Verify(t *testing.T) {
+	srv := scmtest.NewServer()
+	defer srv.Close()
+
+	// The service account has no access to the security and deployment
+	// services, and the network services are down.
+	var mu sync.Mutex
+	var requests []*http.Request
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != scmtest.AuthPath {
+			mu.Lock()
+			requests = append(requests, r)
+			mu.Unlock()
+		}
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/config/security/"):
+			w.WriteHeader(http.StatusForbidden)
+		case strings.HasPrefix(r.URL.Path, "/config/deployment/"):
+			w.WriteHeader(http.StatusUnauthorized)
+		case strings.HasPrefix(r.URL.Path, "/config/network/"):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case r.URL.Path == "/config/operations/v1/config-versions":
+			// Not served by scmtest.
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"data":[],"limit":1,"offset":0,"total":0}`))
+		default:
+			srv.Config.Handler.ServeHTTP(w, r)
+		}
+	}))
+	defer gateway.Close()
+
+	c := &Client{
+		Host:                 strings.TrimPrefix(gateway.URL, "http://"),
+		Protocol:             "http",
+		AuthUrl:              gateway.URL + scmtest.AuthPath,
+		ClientId:             scmtest.ClientID,
+		ClientSecret:         scmtest.ClientSecret,
+		Scope:                scmtest.Scope,
+		SkipLoggingTransport: true,
+		RetryPolicy:          RetryPolicy{MaxAttempts: 1},
+	}
+	require.NoError(t, c.Setup())
+
+	report, err := c.Verify(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", report.Claims.TsgId)
+	assert.Equal(t, scmtest.ClientID, report.Claims.Subject)
+	assert.Equal(t, []string{"config_operations", "config_setup", "device_settings", "identity_services", "objects"}, report.Authorized())
+	assert.Equal(t, []string{"deployment_services", "security_services"}, report.Unauthorized())
+	assert.Equal(t, []string{"network_services"}, report.Unknown())
+	require.Len(t, report.Families, 8)
+	assert.Equal(t, "security_services", report.Families[7].Family)
+	assert.Equal(t, AccessDenied, report.Families[7].Access)
+	assert.Equal(t, http.StatusForbidden, report.Families[7].StatusCode)
+	assert.Error(t, report.Families[7].Err)
+	assert.NoError(t, report.Families[6].Err)
+
+	// The access to families answering with another error is unknown.
+	assert.Equal(t, "network_services", report.Families[5].Family)
+	assert.Equal(t, AccessUnknown, report.Families[5].Access)
+	assert.Equal(t, http.StatusServiceUnavailable, report.Families[5].StatusCode)
+
+	// No probe but the one of the device settings reads more than one
+	// object.
+	require.Len(t, requests, 8)
+	for _, r := range requests {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if !strings.HasPrefix(r.URL.Path, "/config/device/") {
+			assert.Equal(t, "1", r.URL.Query().Get("limit"), r.URL.String())
+		}
+	}
+
+	// The JWT is reused.
+	_, err = c.Verify(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, srv.TokenRequests())
+}
+
+func TestClient_VerifyScope(t *testing.T) {
+	jwt := testJwt(`{"sub": "automation", "scope": "tsg_id:1111111111"}`)
+	for scope, msg := range map[string]string{
+		"tsg_id:2222222222":         `JWT is for TSG "1111111111" instead of "2222222222"`,
+		"tsg_id:1111111111 profile": `JWT lacks the "profile" scope`,
+	} {
+		c := &Client{
+			Scope:       scope,
+			TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: jwt}),
+		}
+		require.NoError(t, c.Setup())
+		_, err := c.Verify(context.Background())
+		assert.EqualError(t, err, msg)
+	}
+
+	c := &Client{TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "opaque"})}
+	require.NoError(t, c.Setup())
+	_, err := c.Verify(context.Background())
+	assert.ErrorContains(t, err, "malformed JWT")
+}